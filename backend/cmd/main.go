@@ -14,8 +14,41 @@ import (
 	"time"
 
 	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/internal/token"
+	authrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/auth"
+	automodrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/automod"
+	awardrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/award"
+	commentsrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/comments"
+	customemojirepo "github.com/glowfi/voxpopuli/backend/pkg/repo/custom_emoji"
+	moderationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/moderation"
 	postrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post"
+	postflairrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post_flair"
+	relationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/relation"
+	reportrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/report"
+	rulerepo "github.com/glowfi/voxpopuli/backend/pkg/repo/rule"
+	savedrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/saved"
+	searchrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/search"
+	trophyrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/trophy"
+	userrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user"
+	userflairrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user_flair"
+	voterepo "github.com/glowfi/voxpopuli/backend/pkg/repo/vote"
+	voxrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/voxsphere"
+	authsvc "github.com/glowfi/voxpopuli/backend/pkg/service/auth"
+	automodsvc "github.com/glowfi/voxpopuli/backend/pkg/service/automod"
+	awardsvc "github.com/glowfi/voxpopuli/backend/pkg/service/award"
+	commentsvc "github.com/glowfi/voxpopuli/backend/pkg/service/comment"
+	customemojisvc "github.com/glowfi/voxpopuli/backend/pkg/service/custom_emoji"
+	moderationsvc "github.com/glowfi/voxpopuli/backend/pkg/service/moderation"
 	postsvc "github.com/glowfi/voxpopuli/backend/pkg/service/post"
+	postflairsvc "github.com/glowfi/voxpopuli/backend/pkg/service/post_flair"
+	reportsvc "github.com/glowfi/voxpopuli/backend/pkg/service/report"
+	savedsvc "github.com/glowfi/voxpopuli/backend/pkg/service/saved"
+	searchsvc "github.com/glowfi/voxpopuli/backend/pkg/service/search"
+	trophysvc "github.com/glowfi/voxpopuli/backend/pkg/service/trophy"
+	usersvc "github.com/glowfi/voxpopuli/backend/pkg/service/user"
+	userflairsvc "github.com/glowfi/voxpopuli/backend/pkg/service/user_flair"
+	votesvc "github.com/glowfi/voxpopuli/backend/pkg/service/vote"
+	voxsvc "github.com/glowfi/voxpopuli/backend/pkg/service/voxsphere"
 	transport "github.com/glowfi/voxpopuli/backend/pkg/transport"
 	"github.com/joho/godotenv"
 	"github.com/oklog/run"
@@ -58,12 +91,68 @@ func main() {
 		}
 	}()
 
+	// Setup token signing
+	authSecret := os.Getenv("AUTH_SECRET")
+	if len(authSecret) < 32 {
+		logger.Fatal().Msg("AUTH_SECRET must be at least 32 bytes long")
+	}
+	signer := token.NewSigner([]byte(authSecret))
+
 	// Initialize repo and services
+	voxRepo := voxrepo.NewRepo(db)
 	postRepo := postrepo.NewRepo(db)
-	postSvc := postsvc.NewService(postRepo)
+	moderationRepo := moderationrepo.NewRepo(db)
+	moderationSvc := moderationsvc.NewService(moderationRepo)
+	automodRepo := automodrepo.NewRepo(db)
+	if err := automodRepo.EnsureAutoModerator(ctx); err != nil {
+		logger.Fatal().Err(err).Msg("failed to add the automod user")
+	}
+	automodSvc := automodsvc.NewService(automodRepo, moderationRepo)
+	customEmojiRepo := customemojirepo.NewRepo(db)
+	customEmojiSvc := customemojisvc.NewService(customEmojiRepo, moderationRepo)
+	postSvc := postsvc.NewService(postRepo, voxRepo, moderationRepo, automodSvc, customEmojiRepo)
+	commentRepo := commentsrepo.NewRepo(db)
+	commentSvc := commentsvc.NewService(commentRepo, moderationRepo, automodSvc, customEmojiRepo)
+	ruleRepo := rulerepo.NewRepo(db)
+	voxSvc := voxsvc.NewService(voxRepo, ruleRepo)
+	userRepo := userrepo.NewRepo(db)
+	userSvc := usersvc.NewService(userRepo)
+	searchRepo := searchrepo.NewRepo(db)
+	searchSvc := searchsvc.NewService(searchRepo)
+	voteRepo := voterepo.NewRepo(db)
+	voteSvc := votesvc.NewService(voteRepo)
+	authRepo := authrepo.NewRepo(db)
+	authSvc := authsvc.NewService(authRepo, userRepo, signer)
+	reportRepo := reportrepo.NewRepo(db)
+	reportSvc := reportsvc.NewService(reportRepo, moderationRepo)
+	postFlairRepo := postflairrepo.NewRepo(db)
+	postFlairSvc := postflairsvc.NewService(postFlairRepo, postRepo, moderationRepo)
+	userFlairRepo := userflairrepo.NewRepo(db)
+	userFlairSvc := userflairsvc.NewService(userFlairRepo, moderationRepo, voxRepo)
+	awardRepo := awardrepo.NewRepo(db)
+	awardSvc := awardsvc.NewService(awardRepo)
+	trophyRepo := trophyrepo.NewRepo(db)
+	relationRepo := relationrepo.NewRepo(db)
+	trophySvc := trophysvc.NewService(trophyRepo, relationRepo)
+	savedRepo := savedrepo.NewRepo(db)
+	savedSvc := savedsvc.NewService(savedRepo, postRepo, commentRepo)
 
 	services := transport.Services{
-		Post: postSvc,
+		Post:        postSvc,
+		Comment:     commentSvc,
+		Voxsphere:   voxSvc,
+		User:        userSvc,
+		Search:      searchSvc,
+		Vote:        voteSvc,
+		Auth:        authSvc,
+		Moderation:  moderationSvc,
+		Report:      reportSvc,
+		Automod:     automodSvc,
+		PostFlair:   postFlairSvc,
+		UserFlair:   userFlairSvc,
+		CustomEmoji: customEmojiSvc,
+		Award:       awardSvc,
+		Saved:       savedSvc,
 	}
 
 	// Create a new transportServer
@@ -87,6 +176,7 @@ func main() {
 	middlewareStack := middleware.CreateStack(
 		middleware.Logging,
 		middleware.CORS(corsOptions),
+		middleware.Authentication(authSvc),
 	)
 	rootRouter.Handle("/api/", middlewareStack(httpHandler))
 
@@ -118,6 +208,32 @@ func main() {
 		}
 	})
 
+	// grant earned trophies on startup and every hour after
+	rg.Add(func() error {
+		evaluateTrophies := func() {
+			granted, err := trophySvc.EvaluateUsers(ctx)
+			if err != nil {
+				logger.Err(err).Msgf("trophy evaluation failed after granting %d trophies", granted)
+				return
+			}
+			logger.Info().Msgf("granted %d trophies", granted)
+		}
+
+		evaluateTrophies()
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+				evaluateTrophies()
+			}
+		}
+	}, func(error) {
+		shutdownFunc()
+	})
+
 	// graceful shutdown
 	quitC := make(chan os.Signal, 1)
 	rg.Add(func() error {
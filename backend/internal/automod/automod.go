@@ -0,0 +1,292 @@
+// Package automod screens new posts and comments against the rules the
+// moderators of a voxsphere configure. It only decides what should happen to
+// a submission; carrying the decision out is left to the caller.
+package automod
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxRules         = 100
+	maxPatternLength = 1000
+	maxReplyLength   = 10000
+)
+
+var ErrInvalidConfig = errors.New("invalid automod config")
+
+// Target is the kind of submission a rule screens.
+type Target string
+
+const (
+	TargetPost    Target = "post"
+	TargetComment Target = "comment"
+)
+
+// Action is what happens to a submission matching a rule.
+type Action string
+
+const (
+	// ActionRemove removes the submission.
+	ActionRemove Action = "remove"
+	// ActionFilter hides the submission until a moderator approves it from
+	// the report queue.
+	ActionFilter Action = "filter"
+	// ActionFlair sets a post flair of the voxsphere on the post.
+	ActionFlair Action = "flair"
+	// ActionReply replies to the submission.
+	ActionReply Action = "reply"
+)
+
+// Rule matches a submission when every condition it sets holds. Title and
+// Body are regular expressions, Domains matches the links of a post by host
+// or parent domain, AccountAgeBelowDays matches authors whose account is
+// younger than that many days and KarmaBelow authors with less karma. Targets
+// limits the rule to posts or comments. It defaults to posts for rules on the
+// title, domains or flair of a post, and to both otherwise.
+type Rule struct {
+	Name                string    `json:"name"`
+	Targets             []Target  `json:"targets,omitempty"`
+	Title               string    `json:"title,omitempty"`
+	Body                string    `json:"body,omitempty"`
+	Domains             []string  `json:"domains,omitempty"`
+	AccountAgeBelowDays int       `json:"account_age_below_days,omitempty"`
+	KarmaBelow          *int      `json:"karma_below,omitempty"`
+	Action              Action    `json:"action"`
+	FlairID             uuid.UUID `json:"flair_id"`
+	Reply               string    `json:"reply,omitempty"`
+}
+
+// Config is the automod configuration of a voxsphere. Rules are evaluated in
+// order.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Subject is a submission to screen along with the standing of its author.
+// Title and Links are only set for posts.
+type Subject struct {
+	Target          Target
+	Title           string
+	Body            string
+	Links           []string
+	AuthorCreatedAt time.Time
+	AuthorKarma     int
+}
+
+// Verdict is the outcome of screening a subject. Remove wins over Filter, and
+// the first matching flair rule sets FlairID. Rules names every matching rule.
+type Verdict struct {
+	Remove  bool
+	Filter  bool
+	FlairID uuid.UUID
+	Replies []string
+	Rules   []string
+}
+
+// Matched reports whether any rule matched the subject.
+func (v Verdict) Matched() bool {
+	return len(v.Rules) != 0
+}
+
+// Engine evaluates the compiled rules of a config.
+type Engine struct {
+	rules []rule
+}
+
+type rule struct {
+	Rule
+	targets map[Target]bool
+	title   *regexp.Regexp
+	body    *regexp.Regexp
+	domains []string
+}
+
+// Parse compiles the json config in data, failing with ErrInvalidConfig when
+// it cannot be used.
+func Parse(data []byte) (*Engine, error) {
+	var config Config
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+	return New(config)
+}
+
+// New compiles config, failing with ErrInvalidConfig when a rule cannot be
+// used.
+func New(config Config) (*Engine, error) {
+	if len(config.Rules) > maxRules {
+		return nil, fmt.Errorf("%w: at most %d rules are allowed", ErrInvalidConfig, maxRules)
+	}
+
+	e := &Engine{rules: make([]rule, 0, len(config.Rules))}
+	for i, r := range config.Rules {
+		compiled, err := compile(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: rule %d: %v", ErrInvalidConfig, i+1, err)
+		}
+		e.rules = append(e.rules, compiled)
+	}
+	return e, nil
+}
+
+func compile(r Rule) (rule, error) {
+	compiled := rule{Rule: r, targets: make(map[Target]bool)}
+
+	if len(strings.TrimSpace(r.Name)) == 0 {
+		return rule{}, errors.New("name is required")
+	}
+
+	for _, target := range r.Targets {
+		if target != TargetPost && target != TargetComment {
+			return rule{}, fmt.Errorf("unknown target %q", target)
+		}
+		compiled.targets[target] = true
+	}
+	if len(compiled.targets) == 0 {
+		compiled.targets[TargetPost] = true
+		compiled.targets[TargetComment] = len(r.Title) == 0 && len(r.Domains) == 0 && r.Action != ActionFlair
+	}
+
+	var err error
+	if compiled.title, err = compilePattern(r.Title); err != nil {
+		return rule{}, fmt.Errorf("title: %v", err)
+	}
+	if compiled.title != nil && compiled.targets[TargetComment] {
+		return rule{}, errors.New("title only applies to posts")
+	}
+	if compiled.body, err = compilePattern(r.Body); err != nil {
+		return rule{}, fmt.Errorf("body: %v", err)
+	}
+
+	for _, domain := range r.Domains {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www.")
+		if len(domain) == 0 || strings.ContainsAny(domain, "/: ") {
+			return rule{}, fmt.Errorf("invalid domain %q", domain)
+		}
+		compiled.domains = append(compiled.domains, domain)
+	}
+	if len(compiled.domains) != 0 && compiled.targets[TargetComment] {
+		return rule{}, errors.New("domains only apply to posts")
+	}
+
+	if r.AccountAgeBelowDays < 0 {
+		return rule{}, errors.New("account age must not be negative")
+	}
+
+	if compiled.title == nil && compiled.body == nil && len(compiled.domains) == 0 &&
+		r.AccountAgeBelowDays == 0 && r.KarmaBelow == nil {
+		return rule{}, errors.New("at least one condition is required")
+	}
+
+	switch r.Action {
+	case ActionRemove, ActionFilter:
+	case ActionFlair:
+		if r.FlairID == uuid.Nil {
+			return rule{}, errors.New("flair action needs a flair_id")
+		}
+		if compiled.targets[TargetComment] {
+			return rule{}, errors.New("flair action only applies to posts")
+		}
+	case ActionReply:
+		if len(strings.TrimSpace(r.Reply)) == 0 || len(r.Reply) > maxReplyLength {
+			return rule{}, fmt.Errorf("reply action needs a reply of at most %d characters", maxReplyLength)
+		}
+	default:
+		return rule{}, fmt.Errorf("unknown action %q", r.Action)
+	}
+
+	return compiled, nil
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) == 0 {
+		return nil, nil
+	}
+	if len(pattern) > maxPatternLength {
+		return nil, fmt.Errorf("pattern must be at most %d characters long", maxPatternLength)
+	}
+	return regexp.Compile(pattern)
+}
+
+// Evaluate screens subject against every rule, as of now.
+func (e *Engine) Evaluate(subject Subject, now time.Time) Verdict {
+	var verdict Verdict
+	for _, r := range e.rules {
+		if !r.matches(subject, now) {
+			continue
+		}
+
+		verdict.Rules = append(verdict.Rules, r.Name)
+		switch r.Action {
+		case ActionRemove:
+			verdict.Remove = true
+		case ActionFilter:
+			verdict.Filter = true
+		case ActionFlair:
+			if verdict.FlairID == uuid.Nil {
+				verdict.FlairID = r.FlairID
+			}
+		case ActionReply:
+			verdict.Replies = append(verdict.Replies, r.Reply)
+		}
+	}
+	if verdict.Remove {
+		verdict.Filter = false
+	}
+	return verdict
+}
+
+func (r rule) matches(subject Subject, now time.Time) bool {
+	if !r.targets[subject.Target] {
+		return false
+	}
+	if r.title != nil && !r.title.MatchString(subject.Title) {
+		return false
+	}
+	if r.body != nil && !r.body.MatchString(subject.Body) {
+		return false
+	}
+	if len(r.domains) != 0 && !r.matchesDomain(subject.Links) {
+		return false
+	}
+	if r.AccountAgeBelowDays != 0 {
+		age := now.Sub(subject.AuthorCreatedAt)
+		if age >= time.Duration(r.AccountAgeBelowDays)*24*time.Hour {
+			return false
+		}
+	}
+	if r.KarmaBelow != nil && subject.AuthorKarma >= *r.KarmaBelow {
+		return false
+	}
+	return true
+}
+
+// matchesDomain reports whether any of links points to one of the domains of
+// the rule or a subdomain of one.
+func (r rule) matchesDomain(links []string) bool {
+	for _, link := range links {
+		u, err := url.Parse(link)
+		if err != nil {
+			continue
+		}
+		host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+		for _, domain := range r.domains {
+			if host == domain || strings.HasSuffix(host, "."+domain) {
+				return true
+			}
+		}
+	}
+	return false
+}
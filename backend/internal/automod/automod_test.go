@@ -0,0 +1,271 @@
+package automod_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/automod"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now     = time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC)
+	flairID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr error
+	}{
+		{
+			name:    "not json :NEG",
+			config:  `rules: []`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "unknown field :NEG",
+			config:  `{"rules": [{"name": "spam", "body": "spam", "action": "remove", "score": 1}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "missing name :NEG",
+			config:  `{"rules": [{"body": "spam", "action": "remove"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "no condition :NEG",
+			config:  `{"rules": [{"name": "everything", "action": "remove"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "invalid regex :NEG",
+			config:  `{"rules": [{"name": "spam", "body": "(spam", "action": "remove"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "unknown action :NEG",
+			config:  `{"rules": [{"name": "spam", "body": "spam", "action": "ban"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "unknown target :NEG",
+			config:  `{"rules": [{"name": "spam", "targets": ["user"], "body": "spam", "action": "remove"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "title rule on comments :NEG",
+			config:  `{"rules": [{"name": "spam", "targets": ["comment"], "title": "spam", "action": "remove"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "invalid domain :NEG",
+			config:  `{"rules": [{"name": "spam", "domains": ["https://spam.com"], "action": "remove"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "flair without flair id :NEG",
+			config:  `{"rules": [{"name": "question", "title": "\\?$", "action": "flair"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "reply without reply :NEG",
+			config:  `{"rules": [{"name": "welcome", "account_age_below_days": 1, "action": "reply"}]}`,
+			wantErr: automod.ErrInvalidConfig,
+		},
+		{
+			name:    "no rules :POS",
+			config:  `{"rules": []}`,
+			wantErr: nil,
+		},
+		{
+			name: "every action :POS",
+			config: `
+            {
+              "rules": [
+                {"name": "spam", "body": "(?i)buy now", "action": "remove"},
+                {"name": "new accounts", "account_age_below_days": 7, "karma_below": 10, "action": "filter"},
+                {"name": "question", "title": "\\?$", "action": "flair", "flair_id": "00000000-0000-0000-0000-000000000001"},
+                {"name": "link rules", "domains": ["example.com"], "action": "reply", "reply": "read the rules"}
+              ]
+            }
+            `,
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotEngine, gotErr := automod.Parse([]byte(tt.config))
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr == nil {
+				assert.NotNil(t, gotEngine, "expect an engine")
+			}
+		})
+	}
+}
+
+func TestEngine_Evaluate(t *testing.T) {
+	karmaBelow := 10
+
+	config := automod.Config{
+		Rules: []automod.Rule{
+			{
+				Name:   "spam",
+				Body:   "(?i)buy now",
+				Action: automod.ActionRemove,
+			},
+			{
+				Name:                "new accounts",
+				AccountAgeBelowDays: 7,
+				KarmaBelow:          &karmaBelow,
+				Action:              automod.ActionFilter,
+			},
+			{
+				Name:    "question",
+				Title:   `\?$`,
+				Action:  automod.ActionFlair,
+				FlairID: flairID,
+			},
+			{
+				Name:    "shorteners",
+				Domains: []string{"bit.ly"},
+				Action:  automod.ActionReply,
+				Reply:   "no link shorteners",
+			},
+			{
+				Name:    "comment welcome",
+				Targets: []automod.Target{automod.TargetComment},
+				Body:    "(?i)^hello",
+				Action:  automod.ActionReply,
+				Reply:   "welcome",
+			},
+		},
+	}
+	engine, err := automod.New(config)
+	if err != nil {
+		t.Fatal("failed to compile config:", err)
+	}
+
+	oldAccount := now.AddDate(-1, 0, 0)
+	newAccount := now.AddDate(0, 0, -1)
+
+	tests := []struct {
+		name        string
+		subject     automod.Subject
+		wantVerdict automod.Verdict
+	}{
+		{
+			name: "no rule matches :POS",
+			subject: automod.Subject{
+				Target:          automod.TargetPost,
+				Title:           "a post",
+				Body:            "some text",
+				AuthorCreatedAt: oldAccount,
+				AuthorKarma:     100,
+			},
+			wantVerdict: automod.Verdict{},
+		},
+		{
+			name: "body regex :POS",
+			subject: automod.Subject{
+				Target:          automod.TargetComment,
+				Body:            "BUY NOW cheap",
+				AuthorCreatedAt: oldAccount,
+				AuthorKarma:     100,
+			},
+			wantVerdict: automod.Verdict{Remove: true, Rules: []string{"spam"}},
+		},
+		{
+			name: "new account with karma :POS",
+			subject: automod.Subject{
+				Target:          automod.TargetPost,
+				Title:           "a post",
+				AuthorCreatedAt: newAccount,
+				AuthorKarma:     100,
+			},
+			wantVerdict: automod.Verdict{},
+		},
+		{
+			name: "new account without karma :POS",
+			subject: automod.Subject{
+				Target:          automod.TargetPost,
+				Title:           "a post",
+				AuthorCreatedAt: newAccount,
+				AuthorKarma:     -5,
+			},
+			wantVerdict: automod.Verdict{Filter: true, Rules: []string{"new accounts"}},
+		},
+		{
+			name: "remove wins over filter :POS",
+			subject: automod.Subject{
+				Target:          automod.TargetPost,
+				Title:           "a post",
+				Body:            "buy now",
+				AuthorCreatedAt: newAccount,
+			},
+			wantVerdict: automod.Verdict{Remove: true, Rules: []string{"spam", "new accounts"}},
+		},
+		{
+			name: "title regex sets flair :POS",
+			subject: automod.Subject{
+				Target:          automod.TargetPost,
+				Title:           "what is this?",
+				AuthorCreatedAt: oldAccount,
+				AuthorKarma:     100,
+			},
+			wantVerdict: automod.Verdict{FlairID: flairID, Rules: []string{"question"}},
+		},
+		{
+			name: "subdomain of a link domain :POS",
+			subject: automod.Subject{
+				Target:          automod.TargetPost,
+				Title:           "a link",
+				Links:           []string{"https://www.eu.bit.ly/foo"},
+				AuthorCreatedAt: oldAccount,
+				AuthorKarma:     100,
+			},
+			wantVerdict: automod.Verdict{Replies: []string{"no link shorteners"}, Rules: []string{"shorteners"}},
+		},
+		{
+			name: "domain suffix of another domain :NEG",
+			subject: automod.Subject{
+				Target:          automod.TargetPost,
+				Title:           "a link",
+				Links:           []string{"https://notbit.ly/foo"},
+				AuthorCreatedAt: oldAccount,
+				AuthorKarma:     100,
+			},
+			wantVerdict: automod.Verdict{},
+		},
+		{
+			name: "comment only rule on a post :NEG",
+			subject: automod.Subject{
+				Target:          automod.TargetPost,
+				Title:           "a post",
+				Body:            "hello there",
+				AuthorCreatedAt: oldAccount,
+				AuthorKarma:     100,
+			},
+			wantVerdict: automod.Verdict{},
+		},
+		{
+			name: "comment only rule on a comment :POS",
+			subject: automod.Subject{
+				Target:          automod.TargetComment,
+				Body:            "hello there",
+				AuthorCreatedAt: oldAccount,
+				AuthorKarma:     100,
+			},
+			wantVerdict: automod.Verdict{Replies: []string{"welcome"}, Rules: []string{"comment welcome"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotVerdict := engine.Evaluate(tt.subject, now)
+			assert.Equal(t, tt.wantVerdict, gotVerdict, "expect verdict to match")
+			assert.Equal(t, len(tt.wantVerdict.Rules) != 0, gotVerdict.Matched(), "expect matched to match")
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Package flairtext holds what the repos showing post and user flairs share:
+// the query of the richtext of a flair and the rendering of its emojis.
+package flairtext
+
+import (
+	"fmt"
+
+	"github.com/glowfi/voxpopuli/backend/internal/helper"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+)
+
+var (
+	// PostQuery selects the richtext of the post flair aliased as pf.
+	PostQuery = richtext("post_flair", "pf")
+	// UserQuery selects the richtext of the user flair aliased as uf.
+	UserQuery = richtext("user_flair", "uf")
+)
+
+// richtext returns a subquery merging the descriptions, emojis and custom
+// emojis of the flair of kind aliased as alias into a JSON array ordered by
+// order index. Standard emojis are selected as their stored code points, to
+// be replaced by RenderEmojis.
+func richtext(kind, alias string) string {
+	return fmt.Sprintf(`(
+            SELECT
+              JSON_AGG(richtext ORDER BY richtext.order_index)
+            FROM
+              (
+                SELECT
+                  'text' AS type,
+                  fd.order_index,
+                  fd.description AS text,
+                  NULL::TEXT AS url
+                FROM
+                  %[1]s_descriptions fd
+                WHERE
+                  fd.%[1]s_id = %[2]s.id
+                UNION ALL
+                SELECT
+                  'emoji' AS type,
+                  fe.order_index,
+                  e.title AS text,
+                  NULL::TEXT AS url
+                FROM
+                  %[1]s_emojis fe
+                  JOIN emojis e ON e.id = fe.emoji_id
+                WHERE
+                  fe.%[1]s_id = %[2]s.id
+                UNION ALL
+                SELECT
+                  'custom_emoji' AS type,
+                  fce.order_index,
+                  ce.title AS text,
+                  ce.url AS url
+                FROM
+                  %[1]s_custom_emojis fce
+                  JOIN custom_emojis ce ON ce.id = fce.custom_emoji_id
+                WHERE
+                  fce.%[1]s_id = %[2]s.id
+              ) richtext
+          )`, kind, alias)
+}
+
+// RenderEmojis replaces the stored code points of standard emojis with the
+// emojis themselves. Code points that fail to parse are left untouched.
+func RenderEmojis(richtext []models.FlairRichtext) {
+	for i := range richtext {
+		if richtext[i].Type != models.FlairRichtextTypeEmoji {
+			continue
+		}
+		if emoji, err := helper.EmojiFromCodePoint(richtext[i].Text); err == nil {
+			richtext[i].Text = emoji
+		}
+	}
+}
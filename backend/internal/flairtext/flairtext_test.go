@@ -0,0 +1,85 @@
+package flairtext_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/flairtext"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderEmojis(t *testing.T) {
+	url := "https://example.com/ce1.png"
+
+	tests := []struct {
+		name         string
+		richtext     []models.FlairRichtext
+		wantRichtext []models.FlairRichtext
+	}{
+		{
+			name:         "no richtext :POS",
+			richtext:     nil,
+			wantRichtext: nil,
+		},
+		{
+			name: "standard emojis :POS",
+			richtext: []models.FlairRichtext{
+				{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "1f600"},
+				{Type: models.FlairRichtextTypeEmoji, OrderIndex: 1, Text: "1f600"},
+				{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 2, Text: ":ce1:", Url: &url},
+			},
+			wantRichtext: []models.FlairRichtext{
+				{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "1f600"},
+				{Type: models.FlairRichtextTypeEmoji, OrderIndex: 1, Text: "😀"},
+				{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 2, Text: ":ce1:", Url: &url},
+			},
+		},
+		{
+			name: "invalid code point :NEG",
+			richtext: []models.FlairRichtext{
+				{Type: models.FlairRichtextTypeEmoji, OrderIndex: 0, Text: "zz"},
+			},
+			wantRichtext: []models.FlairRichtext{
+				{Type: models.FlairRichtextTypeEmoji, OrderIndex: 0, Text: "zz"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flairtext.RenderEmojis(tt.richtext)
+
+			assert.Equal(t, tt.wantRichtext, tt.richtext, "expect richtext to match")
+		})
+	}
+}
+
+func TestQueries(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantTable string
+		wantAlias string
+	}{
+		{
+			name:      "post flair :POS",
+			query:     flairtext.PostQuery,
+			wantTable: "post_flair_custom_emojis",
+			wantAlias: "fce.post_flair_id = pf.id",
+		},
+		{
+			name:      "user flair :POS",
+			query:     flairtext.UserQuery,
+			wantTable: "user_flair_custom_emojis",
+			wantAlias: "fce.user_flair_id = uf.id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Contains(t, tt.query, tt.wantTable, "expect flair tables to be queried")
+			assert.Contains(t, tt.query, tt.wantAlias, "expect flair alias to be joined")
+			assert.NotContains(t, tt.query, "%!", "expect query to be fully formatted")
+			assert.True(t, strings.HasPrefix(tt.query, "("), "expect query to be a subquery")
+		})
+	}
+}
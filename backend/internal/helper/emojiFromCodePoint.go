@@ -0,0 +1,26 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EmojiFromCodePoint converts a dash separated, lower cased code point
+// sequence such as "1f44d-1f3fb" back into the emoji it represents.
+func EmojiFromCodePoint(codePoint string) (string, error) {
+	if len(codePoint) == 0 {
+		return "", fmt.Errorf("empty code point")
+	}
+
+	var emoji strings.Builder
+	for _, part := range strings.Split(codePoint, "-") {
+		value, err := strconv.ParseUint(part, 16, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid code point %q: %w", part, err)
+		}
+		emoji.WriteRune(rune(value))
+	}
+
+	return emoji.String(), nil
+}
@@ -0,0 +1,73 @@
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EmojiFromCodePoint(t *testing.T) {
+	type args struct {
+		codePoint string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantEmoji string
+		wantErr   bool
+	}{
+		{
+			name: "single code point :POS",
+			args: args{
+				codePoint: "1f600",
+			},
+			wantEmoji: "😀",
+			wantErr:   false,
+		},
+		{
+			name: "multiple code points :POS",
+			args: args{
+				codePoint: "1f44d-1f3fb",
+			},
+			wantEmoji: "👍🏻",
+			wantErr:   false,
+		},
+		{
+			name: "zero width joiner sequence :POS",
+			args: args{
+				codePoint: "1f468-200d-1f4bb",
+			},
+			wantEmoji: "👨‍💻",
+			wantErr:   false,
+		},
+		{
+			name: "empty code point :NEG",
+			args: args{
+				codePoint: "",
+			},
+			wantEmoji: "",
+			wantErr:   true,
+		},
+		{
+			name: "invalid code point :NEG",
+			args: args{
+				codePoint: "1f600-zz",
+			},
+			wantEmoji: "",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotEmoji, gotErr := helper.EmojiFromCodePoint(tt.args.codePoint)
+
+			if tt.wantErr {
+				assert.Error(t, gotErr, "expect error")
+			} else {
+				assert.NoError(t, gotErr, "expect no error")
+			}
+			assert.Equal(t, tt.wantEmoji, gotEmoji, "expect emoji to match")
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Package karma holds how the karma of a user is counted, so that profiles,
+// automod and trophies agree on it: the ups of the posts of the user plus the
+// score of the comments of the user.
+package karma
+
+// PostQuery returns a subquery summing the ups of the posts of the user whose
+// ID is the SQL expression userID.
+func PostQuery(userID string) string {
+	return `(SELECT COALESCE(SUM(p.ups), 0) FROM posts p WHERE p.author_id = ` + userID + `)`
+}
+
+// CommentQuery returns a subquery summing the score of the comments of the
+// user whose ID is the SQL expression userID.
+func CommentQuery(userID string) string {
+	return `(SELECT COALESCE(SUM(c.score), 0) FROM comments c WHERE c.author_id = ` + userID + `)`
+}
+
+// Query returns an expression of the total karma of the user whose ID is the
+// SQL expression userID.
+func Query(userID string) string {
+	return `(` + PostQuery(userID) + ` + ` + CommentQuery(userID) + `)`
+}
@@ -0,0 +1,45 @@
+package karma_test
+
+import (
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/karma"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		wantParts []string
+	}{
+		{
+			name:   "column user :POS",
+			userID: "u.id",
+			wantParts: []string{
+				"SUM(p.ups)",
+				"p.author_id = u.id",
+				"SUM(c.score)",
+				"c.author_id = u.id",
+			},
+		},
+		{
+			name:   "placeholder user :POS",
+			userID: "?0",
+			wantParts: []string{
+				"p.author_id = ?0",
+				"c.author_id = ?0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := karma.Query(tt.userID)
+
+			for _, part := range tt.wantParts {
+				assert.Contains(t, query, part, "expect karma query to contain part")
+			}
+			assert.NotContains(t, query, "SUM(c.ups)", "expect comment karma to use score")
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/glowfi/voxpopuli/backend/internal/token"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+)
+
+// Authenticator returns the user an access token was issued to.
+type Authenticator interface {
+	Authenticate(ctx context.Context, accessToken string) (models.User, error)
+}
+
+type userContextKey struct{}
+
+// ContextWithUser returns a copy of ctx carrying user as the authenticated
+// user.
+func ContextWithUser(ctx context.Context, user models.User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFromContext returns the authenticated user carried by ctx.
+func UserFromContext(ctx context.Context) (models.User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(models.User)
+	return user, ok
+}
+
+// Authentication returns a middleware putting the user of the bearer token of
+// a request into its context. Requests without an Authorization header pass
+// through anonymously, requests with an invalid token are rejected.
+func Authentication(authenticator Authenticator) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization := r.Header.Get("Authorization")
+			if len(authorization) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			accessToken, ok := strings.CutPrefix(authorization, "Bearer ")
+			if !ok {
+				writeUnauthorized(w, "add a valid bearer token")
+				return
+			}
+
+			user, err := authenticator.Authenticate(r.Context(), accessToken)
+			if err != nil {
+				if errors.Is(err, token.ErrInvalidToken) {
+					writeUnauthorized(w, "invalid access token")
+					return
+				}
+				writeError(w, http.StatusInternalServerError, "failed to authenticate")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ContextWithUser(r.Context(), user)))
+		})
+	}
+}
+
+// RequireAuthentication rejects requests without an authenticated user.
+func RequireAuthentication(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := UserFromContext(r.Context()); !ok {
+			writeUnauthorized(w, "login required")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeUnauthorized(w http.ResponseWriter, errMsg string) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	writeError(w, http.StatusUnauthorized, errMsg)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := struct {
+		Messages []string `json:"errors"`
+	}{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
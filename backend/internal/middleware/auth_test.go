@@ -0,0 +1,116 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/internal/token"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type authenticatorFunc func(ctx context.Context, accessToken string) (models.User, error)
+
+func (f authenticatorFunc) Authenticate(ctx context.Context, accessToken string) (models.User, error) {
+	return f(ctx, accessToken)
+}
+
+func TestAuthentication(t *testing.T) {
+	user := models.User{
+		ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Name: "John Doe",
+	}
+	authenticator := authenticatorFunc(func(_ context.Context, accessToken string) (models.User, error) {
+		switch accessToken {
+		case "valid":
+			return user, nil
+		case "broken":
+			return models.User{}, errors.New("some error")
+		default:
+			return models.User{}, token.ErrInvalidToken
+		}
+	})
+
+	tests := []struct {
+		name           string
+		authorization  string
+		wantStatusCode int
+		wantUser       bool
+	}{
+		{
+			name:           "no authorization header :POS",
+			authorization:  "",
+			wantStatusCode: http.StatusOK,
+			wantUser:       false,
+		},
+		{
+			name:           "valid bearer token :POS",
+			authorization:  "Bearer valid",
+			wantStatusCode: http.StatusOK,
+			wantUser:       true,
+		},
+		{
+			name:           "not a bearer token :NEG",
+			authorization:  "Basic valid",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid bearer token :NEG",
+			authorization:  "Bearer invalid",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "authenticator failure :NEG",
+			authorization:  "Bearer broken",
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUser models.User
+			var gotOK bool
+			handler := middleware.Authentication(authenticator)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUser, gotOK = middleware.UserFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			request := httptest.NewRequest("GET", "/", nil)
+			if len(tt.authorization) != 0 {
+				request.Header.Set("Authorization", tt.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantUser, gotOK, "expect user to be in context")
+			if tt.wantUser {
+				assert.Equal(t, user, gotUser, "expect user to match")
+			}
+		})
+	}
+}
+
+func TestRequireAuthentication(t *testing.T) {
+	handler := middleware.RequireAuthentication(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Run("anonymous request :NEG", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/", nil))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Result().StatusCode, "expect status code to match")
+	})
+
+	t.Run("authenticated request :POS", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "/", nil)
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), models.User{Name: "John Doe"}))
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "expect status code to match")
+	})
+}
@@ -0,0 +1,71 @@
+package render
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	xhtml "golang.org/x/net/html"
+)
+
+// shortcodePattern matches a :shortcode: the way custom emoji titles are
+// stored, colons included.
+var shortcodePattern = regexp.MustCompile(`:[\w+-]+:`)
+
+// CustomEmojis replaces the :shortcode: of every emoji of emojis found in the
+// text of rendered with the image of that emoji. Code is left as written, and
+// so are shortcodes no emoji is titled with.
+func CustomEmojis(rendered string, emojis []models.CustomEmoji) string {
+	if len(emojis) == 0 || !strings.Contains(rendered, ":") {
+		return rendered
+	}
+
+	urls := make(map[string]string, len(emojis))
+	for _, emoji := range emojis {
+		urls[emoji.Title] = emoji.Url
+	}
+
+	var (
+		b      strings.Builder
+		inCode int
+	)
+	z := xhtml.NewTokenizer(strings.NewReader(rendered))
+	for {
+		tt := z.Next()
+		if tt == xhtml.ErrorToken {
+			// the input is read from memory, so the only error is its end
+			break
+		}
+		raw := string(z.Raw())
+
+		switch tt {
+		case xhtml.StartTagToken, xhtml.EndTagToken:
+			if name, _ := z.TagName(); string(name) == "code" || string(name) == "pre" {
+				if tt == xhtml.StartTagToken {
+					inCode++
+				} else if inCode > 0 {
+					inCode--
+				}
+			}
+		case xhtml.TextToken:
+			if inCode == 0 {
+				raw = shortcodePattern.ReplaceAllStringFunc(raw, func(shortcode string) string {
+					url, ok := urls[shortcode]
+					if !ok {
+						return shortcode
+					}
+					return emojiImage(shortcode, url)
+				})
+			}
+		}
+		b.WriteString(raw)
+	}
+	return b.String()
+}
+
+func emojiImage(shortcode, url string) string {
+	return `<img class="custom-emoji" src="` + html.EscapeString(url) +
+		`" alt="` + html.EscapeString(shortcode) +
+		`" title="` + html.EscapeString(shortcode) + `">`
+}
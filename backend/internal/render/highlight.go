@@ -0,0 +1,47 @@
+package render
+
+import (
+	"html"
+	"strings"
+)
+
+// Markers set around the matches of a search snippet in place of html, so the
+// snippet can be escaped before the matches are highlighted.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// Highlight returns the html of a plain text search snippet whose matches are
+// set between HighlightStart and HighlightStop. The text is escaped and every
+// match is wrapped in a mark element. Stray markers found in the text are
+// dropped, so the marks are always balanced.
+func Highlight(snippet string) string {
+	var (
+		b    strings.Builder
+		open bool
+	)
+	for {
+		i := strings.IndexAny(snippet, HighlightStart+HighlightStop)
+		if i == -1 {
+			break
+		}
+		b.WriteString(html.EscapeString(snippet[:i]))
+
+		switch marker := snippet[i : i+1]; {
+		case marker == HighlightStart && !open:
+			b.WriteString("<mark>")
+			open = true
+		case marker == HighlightStop && open:
+			b.WriteString("</mark>")
+			open = false
+		}
+		snippet = snippet[i+1:]
+	}
+	b.WriteString(html.EscapeString(snippet))
+
+	if open {
+		b.WriteString("</mark>")
+	}
+	return b.String()
+}
@@ -0,0 +1,66 @@
+// Package render turns the markdown written by users into html that is safe
+// to show in the browser.
+package render
+
+import (
+	"bytes"
+	"html"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// md renders commonmark with strikethrough, tables and bare links. Raw html in
+// the markdown is dropped rather than passed through.
+var md = goldmark.New(
+	goldmark.WithExtensions(
+		extension.Strikethrough,
+		extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		extension.Linkify,
+	),
+)
+
+// policy is the allowlist the rendered html is cleaned with. Links may only
+// point to http, https or mailto urls and are marked nofollow, and nothing
+// that runs code or changes styling is let through.
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	p.AllowElements(
+		"p", "br", "hr",
+		"h1", "h2", "h3", "h4", "h5", "h6",
+		"em", "strong", "del", "code", "pre", "blockquote",
+		"ul", "ol", "li",
+		"table", "thead", "tbody", "tr", "th", "td",
+	)
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("align").Matching(bluemonday.CellAlign).OnElements("th", "td")
+	p.AllowAttrs("class").Matching(bluemonday.SpaceSeparatedTokens).OnElements("code")
+
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.RequireParseableURLs(true)
+	p.RequireNoFollowOnLinks(true)
+
+	return p
+}
+
+// Markdown returns the sanitized html of the markdown text, or an empty string
+// for empty text.
+func Markdown(text string) string {
+	if len(strings.TrimSpace(text)) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(text), &buf); err != nil {
+		// converting to an in memory buffer does not fail in practice, fall
+		// back to the escaped text all the same
+		return "<p>" + html.EscapeString(text) + "</p>"
+	}
+	return strings.TrimSpace(policy.Sanitize(buf.String()))
+}
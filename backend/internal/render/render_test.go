@@ -0,0 +1,239 @@
+package render_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/render"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		wantHtml string
+	}{
+		{
+			name:     "empty text :POS",
+			text:     "  \n ",
+			wantHtml: "",
+		},
+		{
+			name:     "paragraph :POS",
+			text:     "hello world",
+			wantHtml: "<p>hello world</p>",
+		},
+		{
+			name:     "emphasis and strikethrough :POS",
+			text:     "~~del~~ **bold** *italic* `code`",
+			wantHtml: "<p><del>del</del> <strong>bold</strong> <em>italic</em> <code>code</code></p>",
+		},
+		{
+			name:     "ordered list with start :POS",
+			text:     "3. a\n4. b",
+			wantHtml: "<ol start=\"3\">\n<li>a</li>\n<li>b</li>\n</ol>",
+		},
+		{
+			name:     "fenced code :POS",
+			text:     "```go\nfmt.Println(\"<b>\")\n```",
+			wantHtml: "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;b&gt;&#34;)\n</code></pre>",
+		},
+		{
+			name:     "table :POS",
+			text:     "| a | b |\n|:-|-:|\n| 1 | 2 |",
+			wantHtml: "<table>\n<thead>\n<tr>\n<th align=\"left\">a</th>\n<th align=\"right\">b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td align=\"left\">1</td>\n<td align=\"right\">2</td>\n</tr>\n</tbody>\n</table>",
+		},
+		{
+			name:     "link is nofollow :POS",
+			text:     "[voxpopuli](https://example.com/a?b=c)",
+			wantHtml: "<p><a href=\"https://example.com/a?b=c\" rel=\"nofollow\">voxpopuli</a></p>",
+		},
+		{
+			name:     "bare link is nofollow :POS",
+			text:     "see https://example.com",
+			wantHtml: "<p>see <a href=\"https://example.com\" rel=\"nofollow\">https://example.com</a></p>",
+		},
+		{
+			name:     "escaped entities stay escaped :POS",
+			text:     "&lt;script&gt;alert(1)&lt;/script&gt;",
+			wantHtml: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>",
+		},
+		{
+			name:     "script tag :NEG",
+			text:     "<script>alert(1)</script>",
+			wantHtml: "",
+		},
+		{
+			name:     "inline script tag :NEG",
+			text:     "hi <script>alert(1)</script>",
+			wantHtml: "<p>hi alert(1)</p>",
+		},
+		{
+			name:     "style tag :NEG",
+			text:     "<style>body { display: none }</style>",
+			wantHtml: "",
+		},
+		{
+			name:     "iframe :NEG",
+			text:     "<iframe src=\"https://example.com\"></iframe>",
+			wantHtml: "",
+		},
+		{
+			name:     "event handler attribute :NEG",
+			text:     "text <img src=x onerror=alert(1)>",
+			wantHtml: "<p>text </p>",
+		},
+		{
+			name:     "raw anchor :NEG",
+			text:     "<a href=\"https://example.com\" onclick=\"alert(1)\">x</a>",
+			wantHtml: "<p>x</p>",
+		},
+		{
+			name:     "javascript link :NEG",
+			text:     "[x](javascript:alert(1))",
+			wantHtml: "<p>x</p>",
+		},
+		{
+			name:     "mixed case javascript link :NEG",
+			text:     "[x](JaVaScRiPt:alert(1))",
+			wantHtml: "<p>x</p>",
+		},
+		{
+			name:     "data link :NEG",
+			text:     "[x](data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==)",
+			wantHtml: "<p>x</p>",
+		},
+		{
+			name:     "vbscript link :NEG",
+			text:     "[x](vbscript:msgbox(1))",
+			wantHtml: "<p>x</p>",
+		},
+		{
+			name:     "attribute breakout in link title :NEG",
+			text:     "[x](https://example.com \"a\\\" onmouseover=\\\"alert(1)\")",
+			wantHtml: "<p><a href=\"https://example.com\" rel=\"nofollow\">x</a></p>",
+		},
+		{
+			name:     "image :NEG",
+			text:     "![pixel](https://example.com/pixel.png)",
+			wantHtml: "<p></p>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotHtml := render.Markdown(tt.text)
+			assert.Equal(t, tt.wantHtml, gotHtml, "expect html to match")
+		})
+	}
+}
+
+func TestMarkdown_NoScript(t *testing.T) {
+	payloads := []string{
+		"<scr<script>ipt>alert(1)</script>",
+		"<svg onload=alert(1)>",
+		"<body onload=alert(1)>",
+		"<div style=\"background:url(javascript:alert(1))\">x</div>",
+		"[x](&#106;avascript:alert(1))",
+		"[x]( javascript:alert(1) )",
+		"<javascript:alert(1)>",
+		"<a href='javascript:alert(1)'>x</a>",
+		"<form action=\"https://example.com\"><input></form>",
+		"<object data=\"https://example.com\"></object>",
+		"`<script>` <script>alert(1)</script>",
+	}
+	for _, payload := range payloads {
+		t.Run(payload, func(t *testing.T) {
+			gotHtml := strings.ToLower(render.Markdown(payload))
+
+			for _, unsafe := range []string{"<script", "<svg", "<body", "<div", "<form", "<input", "<object", "<style", "href=\"javascript", " on", "style="} {
+				assert.NotContains(t, gotHtml, unsafe, "expect html to be sanitized")
+			}
+		})
+	}
+}
+
+func TestCustomEmojis(t *testing.T) {
+	emojis := []models.CustomEmoji{
+		{Title: ":party:", Url: "https://example.com/party.png"},
+		{Title: ":cat_jam:", Url: "https://example.com/cat.png?a=1&b=2"},
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		emojis   []models.CustomEmoji
+		wantHtml string
+	}{
+		{
+			name:     "no emojis :POS",
+			text:     "time to :party:",
+			emojis:   nil,
+			wantHtml: "<p>time to :party:</p>",
+		},
+		{
+			name:     "known shortcodes :POS",
+			text:     "**time** to :party::cat_jam:",
+			emojis:   emojis,
+			wantHtml: `<p><strong>time</strong> to <img class="custom-emoji" src="https://example.com/party.png" alt=":party:" title=":party:"><img class="custom-emoji" src="https://example.com/cat.png?a=1&amp;b=2" alt=":cat_jam:" title=":cat_jam:"></p>`,
+		},
+		{
+			name:     "unknown shortcode :POS",
+			text:     "at 10:30 :dance:",
+			emojis:   emojis,
+			wantHtml: "<p>at 10:30 :dance:</p>",
+		},
+		{
+			name:     "shortcode in code :POS",
+			text:     "`:party:`\n\n```\n:party:\n```",
+			emojis:   emojis,
+			wantHtml: "<p><code>:party:</code></p>\n<pre><code>:party:\n</code></pre>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotHtml := render.CustomEmojis(render.Markdown(tt.text), tt.emojis)
+			assert.Equal(t, tt.wantHtml, gotHtml, "expect html to match")
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		snippet  string
+		wantHtml string
+	}{
+		{
+			name:     "no matches :POS",
+			snippet:  "plain text",
+			wantHtml: "plain text",
+		},
+		{
+			name:     "matches are marked :POS",
+			snippet:  render.HighlightStart + "Generics" + render.HighlightStop + " make " + render.HighlightStart + "containers" + render.HighlightStop,
+			wantHtml: "<mark>Generics</mark> make <mark>containers</mark>",
+		},
+		{
+			name:     "script is escaped :POS",
+			snippet:  "<script>alert(1)</script> " + render.HighlightStart + "gadgets" + render.HighlightStop,
+			wantHtml: "&lt;script&gt;alert(1)&lt;/script&gt; <mark>gadgets</mark>",
+		},
+		{
+			name:     "mark tags in text are escaped :POS",
+			snippet:  "<mark onclick=\"x\">gadgets</mark>",
+			wantHtml: "&lt;mark onclick=&#34;x&#34;&gt;gadgets&lt;/mark&gt;",
+		},
+		{
+			name:     "stray markers are balanced :POS",
+			snippet:  render.HighlightStop + "a" + render.HighlightStart + "b" + render.HighlightStart + "c",
+			wantHtml: "a<mark>bc</mark>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantHtml, render.Highlight(tt.snippet), "expect html to match")
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/glowfi/voxpopuli/backend/internal/helper"
 	"github.com/glowfi/voxpopuli/backend/internal/threadsafe"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	automodrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/automod"
 	awardsrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/award"
 	commentsrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/comments"
 	customemojirepo "github.com/glowfi/voxpopuli/backend/pkg/repo/custom_emoji"
@@ -97,6 +98,10 @@ func connectPostgres(user, password, address, dbName string) (*bun.DB, error) {
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.User)(nil)).Exec(context.Background()); err != nil {
 		fmt.Println("truncate table failed:", err)
 	}
+	// the coin ledger has no foreign keys, so it is not truncated along with users
+	if _, err := db.NewTruncateTable().Model((*models.CoinTransaction)(nil)).Exec(context.Background()); err != nil {
+		fmt.Println("truncate table failed:", err)
+	}
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.Trophy)(nil)).Exec(context.Background()); err != nil {
 		fmt.Println("truncate table failed:", err)
 	}
@@ -324,6 +329,7 @@ func insertAwards(ctx context.Context, db *bun.DB, filename string) error {
 				ID:        uuid.New(),
 				Title:     award.Title,
 				ImageLink: award.ImageLink,
+				Price:     models.DefaultAwardPrice,
 			}
 			awardsCI.ResC <- newAward
 			wg.Done()
@@ -392,6 +398,11 @@ func insertUsers(ctx context.Context, db *bun.DB, usersFilename string) error {
 	go func() {
 		for _, user := range usersJson {
 			userID := uuid.New()
+			// a scraped automod account becomes the automod user, as the
+			// name of the latter is reserved
+			if user.Name == models.AutoModeratorName {
+				userID = models.AutoModeratorID
+			}
 
 			newUser := models.User{
 				ID:                userID,
@@ -835,6 +846,8 @@ func insertPostMedias(
 
 	var links []models.Link
 
+	var multiMedias []models.MultiMedia
+
 	mediaRepo := mediasrepo.NewRepo(db)
 
 	for postID, postMediaContent := range postMediasMap.Load() {
@@ -1030,7 +1043,7 @@ func insertPostMedias(
 			if err != nil {
 				return fmt.Errorf("Error casting to multiple media content for post id %v %v", postID, err)
 			}
-			for _, content := range multipleContent {
+			for orderIndex, content := range multipleContent {
 				switch r := content.(type) {
 				case map[string]interface{}:
 					mediaType, found := r["_type"]
@@ -1049,6 +1062,13 @@ func insertPostMedias(
 							MediaID: postMediaID,
 						}
 						images = append(images, newImage)
+						multiMedias = append(multiMedias, models.MultiMedia{
+							ID:         uuid.New(),
+							MediaID:    postMediaID,
+							ChildID:    ImageID,
+							ChildType:  models.MediaTypeImage,
+							OrderIndex: int32(orderIndex),
+						})
 
 						var imageResolutions []ImageMultiResolution
 						if err := mapToStruct(resolutions, &imageResolutions); err != nil {
@@ -1079,6 +1099,13 @@ func insertPostMedias(
 							MediaID: postMediaID,
 						}
 						gifs = append(gifs, newGif)
+						multiMedias = append(multiMedias, models.MultiMedia{
+							ID:         uuid.New(),
+							MediaID:    postMediaID,
+							ChildID:    gifID,
+							ChildType:  models.MediaTypeGif,
+							OrderIndex: int32(orderIndex),
+						})
 
 						var gifResolutions []ImageMultiResolution
 						if err := mapToStruct(resolutions, &gifResolutions); err != nil {
@@ -1103,8 +1130,9 @@ func insertPostMedias(
 						if err := mapToStruct(content, &video); err != nil {
 							return err
 						}
+						videoID := uuid.New()
 						newVideo := models.Video{
-							ID:            uuid.New(),
+							ID:            videoID,
 							MediaID:       postMediaID,
 							Url:           video.HLSURL,
 							Height:        int32(video.Y),
@@ -1114,6 +1142,13 @@ func insertPostMedias(
 							UpdatedAt:     time.Now(),
 						}
 						videos = append(videos, newVideo)
+						multiMedias = append(multiMedias, models.MultiMedia{
+							ID:         uuid.New(),
+							MediaID:    postMediaID,
+							ChildID:    videoID,
+							ChildType:  models.MediaTypeVideo,
+							OrderIndex: int32(orderIndex),
+						})
 					} else {
 						return fmt.Errorf("Unknown mediaType for multiple media content")
 					}
@@ -1338,6 +1373,29 @@ func insertPostMedias(
 
 	wg.Wait()
 
+	multiMediasCI := NewConcurrentInserter(len(multiMedias), func(multiMedias []models.MultiMedia) error {
+		if _, err := mediaRepo.AddMultiMedias(ctx, multiMedias...); err != nil {
+			return err
+		}
+
+		return nil
+	}, 100*time.Millisecond, 500)
+
+	wg.Add(len(multiMedias))
+
+	go func() {
+		for _, multiMedia := range multiMedias {
+			multiMediasCI.ResC <- multiMedia
+			wg.Done()
+		}
+	}()
+
+	if err := multiMediasCI.Serve(ctx); err != nil {
+		return err
+	}
+
+	wg.Wait()
+
 	return nil
 }
 
@@ -2521,6 +2579,11 @@ func Run() {
 		panic(err)
 	}
 
+	// add the automod user back unless it was scraped
+	if err := automodrepo.NewRepo(db).EnsureAutoModerator(ctx); err != nil {
+		panic(err)
+	}
+
 	// insert voxspheres
 	if err := insertVoxspheres(ctx, db, fileMap["subreddits_json"]); err != nil {
 		panic(err)
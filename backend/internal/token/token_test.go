@@ -0,0 +1,98 @@
+package token_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	signer := token.NewSigner([]byte("secret"))
+	claims := token.Claims{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Subject:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Kind:      token.KindAccess,
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}
+	signed, err := signer.Sign(claims)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	expiredClaims := claims
+	expiredClaims.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+	expired, err := signer.Sign(expiredClaims)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	parts := strings.Split(signed, ".")
+	tampered := parts[0] + "." + parts[1] + "x." + parts[2]
+
+	type args struct {
+		token string
+		kind  token.Kind
+	}
+	tests := []struct {
+		name       string
+		signer     *token.Signer
+		args       args
+		wantClaims token.Claims
+		wantErr    error
+	}{
+		{
+			name:       "valid token :POS",
+			signer:     signer,
+			args:       args{token: signed, kind: token.KindAccess},
+			wantClaims: claims,
+			wantErr:    nil,
+		},
+		{
+			name:       "wrong kind :NEG",
+			signer:     signer,
+			args:       args{token: signed, kind: token.KindRefresh},
+			wantClaims: token.Claims{},
+			wantErr:    token.ErrInvalidToken,
+		},
+		{
+			name:       "wrong secret :NEG",
+			signer:     token.NewSigner([]byte("other secret")),
+			args:       args{token: signed, kind: token.KindAccess},
+			wantClaims: token.Claims{},
+			wantErr:    token.ErrInvalidToken,
+		},
+		{
+			name:       "tampered payload :NEG",
+			signer:     signer,
+			args:       args{token: tampered, kind: token.KindAccess},
+			wantClaims: token.Claims{},
+			wantErr:    token.ErrInvalidToken,
+		},
+		{
+			name:       "malformed token :NEG",
+			signer:     signer,
+			args:       args{token: "foo.bar", kind: token.KindAccess},
+			wantClaims: token.Claims{},
+			wantErr:    token.ErrInvalidToken,
+		},
+		{
+			name:       "expired token :NEG",
+			signer:     signer,
+			args:       args{token: expired, kind: token.KindAccess},
+			wantClaims: token.Claims{},
+			wantErr:    token.ErrExpiredToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotClaims, gotErr := tt.signer.Verify(tt.args.token, tt.args.kind)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantClaims, gotClaims, "expect claims to match")
+		})
+	}
+}
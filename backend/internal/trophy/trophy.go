@@ -0,0 +1,86 @@
+// Package trophy decides which trophies users have earned from the criteria
+// set on each trophy. Granting the trophies is left to the caller.
+package trophy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidCriteria = errors.New("invalid trophy criteria")
+
+// Criteria is earned by a user when every condition it sets holds.
+// AccountAgeDays is earned by accounts at least that many days old,
+// KarmaAtLeast by users with at least that much karma, FirstPost by users
+// who posted at least once, TopPostInVoxsphere by users who wrote the most
+// upvoted post of a voxsphere and AwardsReceivedAtLeast by users whose posts
+// and comments received at least that many awards.
+type Criteria struct {
+	AccountAgeDays        int  `json:"account_age_days,omitempty"`
+	KarmaAtLeast          *int `json:"karma_at_least,omitempty"`
+	FirstPost             bool `json:"first_post,omitempty"`
+	TopPostInVoxsphere    bool `json:"top_post_in_voxsphere,omitempty"`
+	AwardsReceivedAtLeast int  `json:"awards_received_at_least,omitempty"`
+}
+
+// Achievements is what a user has done so far.
+type Achievements struct {
+	CreatedAt      time.Time
+	Karma          int
+	NumPosts       int
+	NumTopPosts    int
+	AwardsReceived int
+}
+
+// Parse decodes the json criteria in data, failing with ErrInvalidCriteria
+// when they cannot be used.
+func Parse(data []byte) (Criteria, error) {
+	var criteria Criteria
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&criteria); err != nil {
+		return Criteria{}, fmt.Errorf("%w: %v", ErrInvalidCriteria, err)
+	}
+	if err := criteria.Validate(); err != nil {
+		return Criteria{}, err
+	}
+	return criteria, nil
+}
+
+// Validate fails with ErrInvalidCriteria when c sets no condition, since
+// every user would earn it, or sets a negative threshold.
+func (c Criteria) Validate() error {
+	if c.AccountAgeDays < 0 || c.AwardsReceivedAtLeast < 0 {
+		return fmt.Errorf("%w: thresholds must not be negative", ErrInvalidCriteria)
+	}
+	if c.AccountAgeDays == 0 && c.KarmaAtLeast == nil && !c.FirstPost && !c.TopPostInVoxsphere && c.AwardsReceivedAtLeast == 0 {
+		return fmt.Errorf("%w: at least one condition is required", ErrInvalidCriteria)
+	}
+	return nil
+}
+
+// Earned reports whether achievements meet every condition of c, as of now.
+func (c Criteria) Earned(achievements Achievements, now time.Time) bool {
+	if c.AccountAgeDays != 0 {
+		age := now.Sub(achievements.CreatedAt)
+		if age < time.Duration(c.AccountAgeDays)*24*time.Hour {
+			return false
+		}
+	}
+	if c.KarmaAtLeast != nil && achievements.Karma < *c.KarmaAtLeast {
+		return false
+	}
+	if c.FirstPost && achievements.NumPosts == 0 {
+		return false
+	}
+	if c.TopPostInVoxsphere && achievements.NumTopPosts == 0 {
+		return false
+	}
+	if achievements.AwardsReceived < c.AwardsReceivedAtLeast {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package trophy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/trophy"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria string
+		wantErr  error
+	}{
+		{
+			name:     "not json :NEG",
+			criteria: `first_post: true`,
+			wantErr:  trophy.ErrInvalidCriteria,
+		},
+		{
+			name:     "unknown field :NEG",
+			criteria: `{"first_comment": true}`,
+			wantErr:  trophy.ErrInvalidCriteria,
+		},
+		{
+			name:     "no condition :NEG",
+			criteria: `{}`,
+			wantErr:  trophy.ErrInvalidCriteria,
+		},
+		{
+			name:     "negative threshold :NEG",
+			criteria: `{"account_age_days": -1}`,
+			wantErr:  trophy.ErrInvalidCriteria,
+		},
+		{
+			name:     "zero karma :POS",
+			criteria: `{"karma_at_least": 0}`,
+		},
+		{
+			name:     "every condition :POS",
+			criteria: `{"account_age_days": 365, "karma_at_least": 100, "first_post": true, "top_post_in_voxsphere": true, "awards_received_at_least": 10}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, gotErr := trophy.Parse([]byte(tt.criteria))
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+		})
+	}
+}
+
+func TestCriteria_Earned(t *testing.T) {
+	veteran := trophy.Achievements{
+		CreatedAt:      now.AddDate(-2, 0, 0),
+		Karma:          1000,
+		NumPosts:       20,
+		NumTopPosts:    1,
+		AwardsReceived: 15,
+	}
+	newcomer := trophy.Achievements{
+		CreatedAt: now.Add(-time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		criteria     string
+		achievements trophy.Achievements
+		wantEarned   bool
+	}{
+		{
+			name:         "one-year club :POS",
+			criteria:     `{"account_age_days": 365}`,
+			achievements: veteran,
+			wantEarned:   true,
+		},
+		{
+			name:         "one-year club :NEG",
+			criteria:     `{"account_age_days": 365}`,
+			achievements: newcomer,
+			wantEarned:   false,
+		},
+		{
+			name:         "karma threshold :NEG",
+			criteria:     `{"karma_at_least": 1001}`,
+			achievements: veteran,
+			wantEarned:   false,
+		},
+		{
+			name:         "first post :NEG",
+			criteria:     `{"first_post": true}`,
+			achievements: newcomer,
+			wantEarned:   false,
+		},
+		{
+			name:         "top post :NEG",
+			criteria:     `{"top_post_in_voxsphere": true}`,
+			achievements: newcomer,
+			wantEarned:   false,
+		},
+		{
+			name:         "awards received :NEG",
+			criteria:     `{"awards_received_at_least": 16}`,
+			achievements: veteran,
+			wantEarned:   false,
+		},
+		{
+			name:         "every condition :POS",
+			criteria:     `{"account_age_days": 365, "karma_at_least": 100, "first_post": true, "top_post_in_voxsphere": true, "awards_received_at_least": 10}`,
+			achievements: veteran,
+			wantEarned:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := trophy.Parse([]byte(tt.criteria))
+			assert.NoError(t, err, "expect criteria to parse")
+
+			gotEarned := criteria.Earned(tt.achievements, now)
+			assert.Equal(t, tt.wantEarned, gotEarned, "expect earned to match")
+		})
+	}
+}
@@ -0,0 +1,98 @@
+-- +goose Up
+
+-- The price in coins users pay to give an award.
+ALTER TABLE awards
+    ADD COLUMN price INTEGER NOT NULL DEFAULT 100,
+    ADD CONSTRAINT chk_awards_price CHECK (price >= 0);
+
+-- The coins a user holds. It always equals the sum of the transactions of the
+-- user, and is kept alongside them so spending can be checked and applied in
+-- a single update.
+CREATE TABLE coin_balances (
+    user_id UUID PRIMARY KEY,
+    balance BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT chk_coin_balances_balance CHECK (balance >= 0)
+);
+
+CREATE TRIGGER set_timestamp
+BEFORE UPDATE ON coin_balances
+FOR EACH ROW
+EXECUTE PROCEDURE fn_auto_update_updated_at_timestamp();
+
+-- An award given by a user to a post or a comment. idempotency_key is the
+-- token the client sent with the grant, so a retried grant is applied once.
+CREATE TABLE award_grants (
+    id UUID PRIMARY KEY,
+    award_id UUID NOT NULL,
+    giver_id UUID NOT NULL,
+    post_id UUID,
+    comment_id UUID,
+    price INTEGER NOT NULL,
+    idempotency_key VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uk_award_grants_giver_id_idempotency_key UNIQUE (giver_id, idempotency_key),
+    CONSTRAINT fk_award_id FOREIGN KEY(award_id) REFERENCES awards(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_giver_id FOREIGN KEY(giver_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_post_id FOREIGN KEY(post_id) REFERENCES posts(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_comment_id FOREIGN KEY(comment_id) REFERENCES comments(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT chk_award_grants_target CHECK ((post_id IS NULL) <> (comment_id IS NULL))
+);
+
+-- The append-only ledger of the coins of users. amount is positive for coins
+-- received and negative for coins spent. Neither user_id nor award_grant_id
+-- has a foreign key, so no cascade deletes a transaction and the ledger keeps
+-- those of deleted users, posts and comments. created_at takes the clock time,
+-- so the transactions of a single database transaction keep their order.
+CREATE TABLE coin_transactions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    amount BIGINT NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    award_grant_id UUID,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT clock_timestamp()
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION fn_reject_coin_transaction_change()
+RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'coin transactions are append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER reject_update
+BEFORE UPDATE ON coin_transactions
+FOR EACH ROW
+EXECUTE PROCEDURE fn_reject_coin_transaction_change();
+
+CREATE TRIGGER reject_delete
+BEFORE DELETE ON coin_transactions
+FOR EACH ROW
+EXECUTE PROCEDURE fn_reject_coin_transaction_change();
+
+CREATE INDEX idx_award_grants_post_id ON award_grants (post_id) WHERE post_id IS NOT NULL;
+CREATE INDEX idx_award_grants_comment_id ON award_grants (comment_id) WHERE comment_id IS NOT NULL;
+CREATE INDEX idx_coin_transactions_user_id_created_at ON coin_transactions (user_id, created_at DESC);
+
+-- +goose Down
+
+DROP INDEX idx_coin_transactions_user_id_created_at;
+DROP INDEX idx_award_grants_comment_id;
+DROP INDEX idx_award_grants_post_id;
+
+DROP TRIGGER reject_delete ON coin_transactions;
+DROP TRIGGER reject_update ON coin_transactions;
+DROP FUNCTION fn_reject_coin_transaction_change();
+
+DROP TABLE coin_transactions CASCADE;
+DROP TABLE award_grants CASCADE;
+
+DROP TRIGGER set_timestamp ON coin_balances;
+DROP TABLE coin_balances CASCADE;
+
+ALTER TABLE awards
+    DROP CONSTRAINT chk_awards_price,
+    DROP COLUMN price;
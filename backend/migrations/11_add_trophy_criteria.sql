@@ -0,0 +1,20 @@
+-- +goose Up
+
+-- The criteria a user meets to be granted a trophy automatically, as read by
+-- the trophy engine. Trophies without criteria are only granted by hand.
+CREATE TABLE trophy_criteria (
+    trophy_id UUID PRIMARY KEY,
+    criteria JSONB NOT NULL,
+    updated_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_trophy_id FOREIGN KEY(trophy_id) REFERENCES trophies(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TRIGGER set_timestamp
+BEFORE UPDATE ON trophy_criteria
+FOR EACH ROW
+EXECUTE PROCEDURE fn_auto_update_updated_at_timestamp();
+
+-- +goose Down
+
+DROP TRIGGER set_timestamp ON trophy_criteria;
+DROP TABLE trophy_criteria CASCADE;
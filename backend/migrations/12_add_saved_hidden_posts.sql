@@ -0,0 +1,43 @@
+-- +goose Up
+
+-- The posts a user bookmarked.
+CREATE TABLE saved_posts (
+    user_id UUID NOT NULL,
+    post_id UUID NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, post_id),
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_post_id FOREIGN KEY(post_id) REFERENCES posts(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- The comments a user bookmarked.
+CREATE TABLE saved_comments (
+    user_id UUID NOT NULL,
+    comment_id UUID NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, comment_id),
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_comment_id FOREIGN KEY(comment_id) REFERENCES comments(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- The posts a user no longer wants to see in their feeds.
+CREATE TABLE hidden_posts (
+    user_id UUID NOT NULL,
+    post_id UUID NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, post_id),
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_post_id FOREIGN KEY(post_id) REFERENCES posts(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX idx_saved_posts_user_id_created_at ON saved_posts (user_id, created_at DESC);
+CREATE INDEX idx_saved_comments_user_id_created_at ON saved_comments (user_id, created_at DESC);
+
+-- +goose Down
+
+DROP INDEX idx_saved_comments_user_id_created_at;
+DROP INDEX idx_saved_posts_user_id_created_at;
+
+DROP TABLE hidden_posts CASCADE;
+DROP TABLE saved_comments CASCADE;
+DROP TABLE saved_posts CASCADE;
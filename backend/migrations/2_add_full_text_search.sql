@@ -0,0 +1,49 @@
+-- +goose Up
+
+-- Full text search documents, kept in sync by postgres on every write.
+-- Titles and names weigh more than bodies and descriptions when ranking.
+ALTER TABLE posts ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english'::regconfig, COALESCE(title, '')), 'A') ||
+    setweight(to_tsvector('english'::regconfig, COALESCE(text, '')), 'B')
+) STORED;
+
+ALTER TABLE comments ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('english'::regconfig, COALESCE(body, ''))
+) STORED;
+
+ALTER TABLE voxspheres ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple'::regconfig, REPLACE(title, '/', ' ')), 'A') ||
+    setweight(to_tsvector('english'::regconfig, COALESCE(public_description, '')), 'B')
+) STORED;
+
+ALTER TABLE users ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple'::regconfig, name), 'A') ||
+    setweight(to_tsvector('english'::regconfig, COALESCE(public_description, '')), 'B')
+) STORED;
+
+CREATE INDEX idx_posts_search_vector ON posts USING GIN (search_vector);
+CREATE INDEX idx_comments_search_vector ON comments USING GIN (search_vector);
+CREATE INDEX idx_voxspheres_search_vector ON voxspheres USING GIN (search_vector);
+CREATE INDEX idx_users_search_vector ON users USING GIN (search_vector);
+
+-- The B-tree indexes only serve equality and prefix lookups, search goes
+-- through the GIN indexes above.
+DROP INDEX idx_posts_title;
+DROP INDEX idx_voxspheres_public_description;
+DROP INDEX idx_users_public_description;
+
+-- +goose Down
+
+CREATE INDEX idx_posts_title ON posts (title);
+CREATE INDEX idx_voxspheres_public_description ON voxspheres (public_description);
+CREATE INDEX idx_users_public_description ON users (public_description);
+
+DROP INDEX idx_posts_search_vector;
+DROP INDEX idx_comments_search_vector;
+DROP INDEX idx_voxspheres_search_vector;
+DROP INDEX idx_users_search_vector;
+
+ALTER TABLE posts DROP COLUMN search_vector;
+ALTER TABLE comments DROP COLUMN search_vector;
+ALTER TABLE voxspheres DROP COLUMN search_vector;
+ALTER TABLE users DROP COLUMN search_vector;
@@ -0,0 +1,20 @@
+-- +goose Up
+
+-- The children of a multi post media, in the order they are shown. A child is
+-- an image, gif, gallery, video or link row of the multi post media, told
+-- apart by child_type.
+CREATE TABLE multi_medias (
+    id UUID PRIMARY KEY,
+    media_id UUID NOT NULL,
+    child_id UUID NOT NULL,
+    child_type media_type NOT NULL,
+    order_index INTEGER NOT NULL,
+    CONSTRAINT fk_media_id FOREIGN KEY(media_id) REFERENCES post_medias(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT uq_multi_medias_child_id UNIQUE (child_id),
+    CONSTRAINT uq_multi_medias_order_index UNIQUE (media_id, order_index),
+    CONSTRAINT chk_multi_medias_child_type CHECK (child_type NOT IN ('multi', 'text'))
+);
+
+-- +goose Down
+
+DROP TABLE multi_medias CASCADE;
@@ -0,0 +1,38 @@
+-- +goose Up
+
+-- The vote of a user on a post or comment. direction is 1 for an upvote and
+-- -1 for a downvote, a cleared vote has no row.
+CREATE TABLE post_votes (
+    user_id UUID NOT NULL,
+    post_id UUID NOT NULL,
+    direction SMALLINT NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, post_id),
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_post_id FOREIGN KEY(post_id) REFERENCES posts(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT chk_post_votes_direction CHECK (direction IN (-1, 1))
+);
+
+CREATE TABLE comment_votes (
+    user_id UUID NOT NULL,
+    comment_id UUID NOT NULL,
+    direction SMALLINT NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, comment_id),
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_comment_id FOREIGN KEY(comment_id) REFERENCES comments(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT chk_comment_votes_direction CHECK (direction IN (-1, 1))
+);
+
+CREATE INDEX idx_post_votes_post_id ON post_votes (post_id);
+CREATE INDEX idx_comment_votes_comment_id ON comment_votes (comment_id);
+
+-- +goose Down
+
+DROP INDEX idx_comment_votes_comment_id;
+DROP INDEX idx_post_votes_post_id;
+
+DROP TABLE comment_votes CASCADE;
+DROP TABLE post_votes CASCADE;
@@ -0,0 +1,31 @@
+-- +goose Up
+
+-- The login credentials of a user, kept apart from users so that a password
+-- hash is never selected along with a profile.
+CREATE TABLE user_credentials (
+    user_id UUID PRIMARY KEY,
+    email VARCHAR(320) NOT NULL,
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- The refresh tokens handed out at login. A refresh token can be used until it
+-- expires or is revoked by a refresh or a logout.
+CREATE TABLE refresh_tokens (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    expires_at TIMESTAMP(6) NOT NULL,
+    revoked_at TIMESTAMP(6),
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE UNIQUE INDEX uq_user_credentials_email ON user_credentials (LOWER(email));
+CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+
+-- +goose Down
+
+DROP TABLE refresh_tokens CASCADE;
+DROP TABLE user_credentials CASCADE;
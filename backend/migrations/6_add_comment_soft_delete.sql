@@ -0,0 +1,28 @@
+-- +goose Up
+
+-- Replies whose parent is gone, or which were stored with the zero uuid as
+-- their parent, become top level comments so that the parent constraint can
+-- be restored.
+UPDATE
+    comments c
+SET
+    parent_comment_id = NULL
+WHERE
+    c.parent_comment_id IS NOT NULL
+    AND NOT EXISTS (SELECT 1 FROM comments p WHERE p.id = c.parent_comment_id);
+
+ALTER TABLE comments
+    ADD CONSTRAINT fk_parent_comment_id FOREIGN KEY(parent_comment_id) REFERENCES comments(id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- A deleted comment keeps its row, and so its replies, with the body and
+-- author hidden.
+ALTER TABLE comments
+    ADD COLUMN deleted_at TIMESTAMP(6);
+
+-- +goose Down
+
+ALTER TABLE comments
+    DROP COLUMN deleted_at;
+
+ALTER TABLE comments
+    DROP CONSTRAINT fk_parent_comment_id;
@@ -0,0 +1,55 @@
+-- +goose Up
+
+-- A removed post or comment is hidden by a moderator until it is approved
+-- again. A locked post takes no new comments, and a voxsphere pins at most two
+-- posts.
+ALTER TABLE posts
+    ADD COLUMN removed_at TIMESTAMP(6),
+    ADD COLUMN locked BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN pinned_at TIMESTAMP(6);
+
+ALTER TABLE comments
+    ADD COLUMN removed_at TIMESTAMP(6);
+
+-- The users banned from a voxsphere. A ban without an expiry is permanent.
+CREATE TABLE voxsphere_bans (
+    voxsphere_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP(6),
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (voxsphere_id, user_id),
+    CONSTRAINT fk_voxsphere_id FOREIGN KEY(voxsphere_id) REFERENCES voxspheres(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- Every action a moderator takes in a voxsphere. target_id is the post,
+-- comment or user the action was taken on.
+CREATE TABLE mod_logs (
+    id UUID PRIMARY KEY,
+    voxsphere_id UUID NOT NULL,
+    moderator_id UUID NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    target_id UUID NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at_unix BIGINT NOT NULL,
+    CONSTRAINT fk_voxsphere_id FOREIGN KEY(voxsphere_id) REFERENCES voxspheres(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_moderator_id FOREIGN KEY(moderator_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX idx_posts_voxsphere_id_pinned_at ON posts (voxsphere_id) WHERE pinned_at IS NOT NULL;
+CREATE INDEX idx_mod_logs_voxsphere_id_created_at ON mod_logs (voxsphere_id, created_at DESC);
+
+-- +goose Down
+
+DROP TABLE mod_logs CASCADE;
+DROP TABLE voxsphere_bans CASCADE;
+
+ALTER TABLE comments
+    DROP COLUMN removed_at;
+
+ALTER TABLE posts
+    DROP COLUMN pinned_at,
+    DROP COLUMN locked,
+    DROP COLUMN removed_at;
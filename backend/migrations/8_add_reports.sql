@@ -0,0 +1,34 @@
+-- +goose Up
+
+-- A report flags a post or a comment as breaking a rule of its voxsphere, or
+-- for a free text reason. A report stays open until a moderator acts on its
+-- target, and mod_log_id then points at the action taken.
+CREATE TABLE reports (
+    id UUID PRIMARY KEY,
+    voxsphere_id UUID NOT NULL,
+    reporter_id UUID NOT NULL,
+    post_id UUID,
+    comment_id UUID,
+    rule_id UUID,
+    reason TEXT NOT NULL DEFAULT '',
+    resolved_at TIMESTAMP(6),
+    mod_log_id UUID,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at_unix BIGINT NOT NULL,
+    CONSTRAINT ck_report_target CHECK ((post_id IS NULL) <> (comment_id IS NULL)),
+    CONSTRAINT fk_voxsphere_id FOREIGN KEY(voxsphere_id) REFERENCES voxspheres(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_reporter_id FOREIGN KEY(reporter_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_post_id FOREIGN KEY(post_id) REFERENCES posts(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_comment_id FOREIGN KEY(comment_id) REFERENCES comments(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_rule_id FOREIGN KEY(rule_id) REFERENCES rules(id) ON DELETE SET NULL ON UPDATE CASCADE,
+    CONSTRAINT fk_mod_log_id FOREIGN KEY(mod_log_id) REFERENCES mod_logs(id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+-- A user has at most one open report on a post or comment.
+CREATE UNIQUE INDEX uq_reports_reporter_id_post_id ON reports (reporter_id, post_id) WHERE resolved_at IS NULL AND post_id IS NOT NULL;
+CREATE UNIQUE INDEX uq_reports_reporter_id_comment_id ON reports (reporter_id, comment_id) WHERE resolved_at IS NULL AND comment_id IS NOT NULL;
+CREATE INDEX idx_reports_voxsphere_id_open ON reports (voxsphere_id) WHERE resolved_at IS NULL;
+
+-- +goose Down
+
+DROP TABLE reports CASCADE;
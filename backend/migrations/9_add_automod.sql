@@ -0,0 +1,25 @@
+-- +goose Up
+
+-- The automod rules of a voxsphere, as the json config its moderators wrote.
+CREATE TABLE automod_configs (
+    voxsphere_id UUID PRIMARY KEY,
+    config JSONB NOT NULL,
+    updated_by UUID NOT NULL,
+    updated_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_voxsphere_id FOREIGN KEY(voxsphere_id) REFERENCES voxspheres(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_updated_by FOREIGN KEY(updated_by) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- Automod files the reports of the posts and comments it filters without a
+-- reporter. The user it acts and replies as is added by the server at start.
+ALTER TABLE reports
+    ALTER COLUMN reporter_id DROP NOT NULL;
+
+-- +goose Down
+
+DELETE FROM reports WHERE reporter_id IS NULL;
+
+ALTER TABLE reports
+    ALTER COLUMN reporter_id SET NOT NULL;
+
+DROP TABLE automod_configs CASCADE;
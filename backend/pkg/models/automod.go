@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// AutoModeratorID is the user automod acts and replies as, named
+// AutoModeratorName.
+var AutoModeratorID = uuid.MustParse("00000000-0000-0000-0000-0000000a0702")
+
+const AutoModeratorName = "AutoModerator"
+
+// AutomodConfig holds the automod rules of a voxsphere as written by the
+// moderator of UpdatedBy.
+type AutomodConfig struct {
+	bun.BaseModel `bun:"table:automod_configs"`
+	VoxsphereID   uuid.UUID       `json:"voxsphere_id"`
+	Config        json.RawMessage `json:"config" bun:"type:jsonb"`
+	UpdatedBy     uuid.UUID       `json:"updated_by" bun:",nullzero"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// AuthorStanding is what automod knows of the author of a submission. Karma
+// is the sum of the ups of the posts and the score of the comments of the
+// author.
+type AuthorStanding struct {
+	CreatedAt time.Time
+	Karma     int
+}
+
+// AutomodVerdict is what automod does to the post or comment of TargetID.
+// Filtered targets are removed and reported with Details as the reason, and
+// Replies are made as AutoModeratorID.
+type AutomodVerdict struct {
+	VoxsphereID uuid.UUID
+	TargetType  ReportTargetType
+	TargetID    uuid.UUID
+	Remove      bool
+	Filter      bool
+	Details     string
+	FlairID     uuid.UUID
+	Replies     []Comment
+}
@@ -1,9 +1,78 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// DefaultAwardPrice is the price in coins of an award that was not given one.
+const DefaultAwardPrice = 100
 
 type Award struct {
 	ID        uuid.UUID `json:"id"`
 	Title     string    `json:"title"`
 	ImageLink string    `json:"image_link"`
+	Price     int32     `json:"price"`
+}
+
+// AwardCount is how many times an award was given to a post.
+type AwardCount struct {
+	Award
+	Count int32 `json:"count"`
+}
+
+// AwardGrant is an award given by a user to either a post or a comment.
+// IdempotencyKey is the token the client sent with the grant, so a retried
+// grant is applied once.
+type AwardGrant struct {
+	bun.BaseModel  `bun:"table:award_grants"`
+	ID             uuid.UUID `json:"id"`
+	AwardID        uuid.UUID `json:"award_id"`
+	GiverID        uuid.UUID `json:"giver_id"`
+	PostID         uuid.UUID `json:"post_id" bun:",nullzero"`
+	CommentID      uuid.UUID `json:"comment_id" bun:",nullzero"`
+	Price          int32     `json:"price"`
+	IdempotencyKey string    `json:"-"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// AwardGrantSubmission is an award a user asks to give.
+type AwardGrantSubmission struct {
+	AwardID uuid.UUID `json:"award_id"`
+}
+
+type CoinTransactionReason string
+
+const (
+	CoinTransactionReasonWelcome    CoinTransactionReason = "welcome"
+	CoinTransactionReasonAwardGrant CoinTransactionReason = "award_grant"
+)
+
+// CoinBalance is the coins a user holds, the sum of their transactions.
+type CoinBalance struct {
+	bun.BaseModel `bun:"table:coin_balances"`
+	UserID        uuid.UUID `json:"user_id"`
+	Balance       int64     `json:"balance"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// CoinTransaction is an entry of the coin ledger of a user. Amount is
+// positive for coins received and negative for coins spent.
+type CoinTransaction struct {
+	bun.BaseModel `bun:"table:coin_transactions"`
+	ID            uuid.UUID             `json:"id"`
+	UserID        uuid.UUID             `json:"user_id"`
+	Amount        int64                 `json:"amount"`
+	Reason        CoinTransactionReason `json:"reason"`
+	AwardGrantID  uuid.UUID             `json:"award_grant_id" bun:",nullzero"`
+	CreatedAt     time.Time             `json:"created_at"`
+}
+
+// CoinWallet is the coin balance of a user along with their latest
+// transactions.
+type CoinWallet struct {
+	Balance      int64             `json:"balance"`
+	Transactions []CoinTransaction `json:"transactions"`
 }
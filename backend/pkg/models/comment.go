@@ -9,7 +9,7 @@ import (
 type Comment struct {
 	ID              uuid.UUID `json:"id"`
 	AuthorID        uuid.UUID `json:"author_id"`
-	ParentCommentID uuid.UUID `json:"parent_comment_id"`
+	ParentCommentID uuid.UUID `json:"parent_comment_id" bun:",nullzero"`
 	PostID          uuid.UUID `json:"post_id"`
 	Body            string    `json:"body"`
 	BodyHtml        string    `json:"body_html"`
@@ -19,3 +19,68 @@ type Comment struct {
 	CreatedAtUnix   int64     `json:"created_at_unix"`
 	UpdatedAt       time.Time `json:"updated_at"`
 }
+
+// CommentSubmission is a comment submitted by a user on a post. A submission
+// with a ParentCommentID is a reply to that comment.
+type CommentSubmission struct {
+	ParentCommentID uuid.UUID `json:"parent_comment_id"`
+	Body            string    `json:"body"`
+}
+
+type CommentAuthor struct {
+	Comment
+	Author      string             `json:"author"`
+	AuthorFlair *UserFlairRendered `json:"author_flair"`
+}
+
+type UserComment struct {
+	CommentAuthor
+	PostTitle   string    `json:"post_title"`
+	VoxsphereID uuid.UUID `json:"voxsphere_id"`
+	Voxsphere   string    `json:"voxsphere"`
+}
+
+type CommentThread struct {
+	ID              uuid.UUID          `json:"id"`
+	Author          string             `json:"author"`
+	AuthorID        uuid.UUID          `json:"author_id"`
+	AuthorFlair     *UserFlairRendered `json:"author_flair"`
+	ParentCommentID uuid.UUID          `json:"parent_comment_id"`
+	PostID          uuid.UUID          `json:"post_id"`
+	Body            string             `json:"body"`
+	BodyHtml        string             `json:"body_html"`
+	Ups             int32              `json:"ups"`
+	Score           int32              `json:"score"`
+	Depth           int32              `json:"depth"`
+	Replies         []CommentThread    `json:"replies"`
+	More            *CommentMore       `json:"more"`
+	CreatedAt       time.Time          `json:"created_at"`
+	CreatedAtUnix   int64              `json:"created_at_unix"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+// CommentMore is a "load more" stub standing in for replies that were
+// truncated from a comment tree.
+type CommentMore struct {
+	ParentCommentID uuid.UUID   `json:"parent_comment_id"`
+	Depth           int32       `json:"depth"`
+	Count           int32       `json:"count"`
+	Children        []uuid.UUID `json:"children"`
+}
+
+// CommentNode is a comment reached by walking a comment tree down from its
+// root, Depth levels below it. Comments cut off by the bounds of the walk are
+// not Shown; they only carry their ID, their parent and the number of their
+// Descendants.
+type CommentNode struct {
+	CommentAuthor
+	Depth       int32 `json:"depth"`
+	Shown       bool  `json:"shown"`
+	Descendants int32 `json:"descendants"`
+}
+
+type CommentTree struct {
+	PostID   uuid.UUID       `json:"post_id"`
+	Comments []CommentThread `json:"comments"`
+	More     *CommentMore    `json:"more"`
+}
@@ -8,3 +8,16 @@ type CustomEmoji struct {
 	Url         string    `json:"url"`
 	Title       string    `json:"title"`
 }
+
+// CustomEmojiSubmission is a custom emoji a moderator uploads to their
+// voxsphere. Its title is the shortcode the emoji is written as, with or
+// without the surrounding colons.
+type CustomEmojiSubmission struct {
+	Title string `json:"title"`
+	Url   string `json:"url"`
+}
+
+// CustomEmojiRename is the new title of a custom emoji.
+type CustomEmojiRename struct {
+	Title string `json:"title"`
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -101,3 +104,167 @@ type Link struct {
 	CreatedAtUnix int64           `json:"created_at_unix"`
 	UpdatedAt     time.Time       `json:"updated_at"`
 }
+
+// MultiMedia is a child of a multi post media. ChildID points to the image,
+// gif, gallery, video or link of ChildType the child stands for, and Medias
+// holds that child the way a post of ChildType holds its medias.
+type MultiMedia struct {
+	bun.BaseModel `bun:"table:multi_medias"`
+	ID            uuid.UUID `json:"id"`
+	MediaID       uuid.UUID `json:"media_id"`
+	ChildID       uuid.UUID `json:"child_id"`
+	ChildType     MediaType `json:"child_type"`
+	OrderIndex    int32     `json:"order_index"`
+	Medias        Medias    `json:"medias" bun:",scanonly"`
+}
+
+// ErrUnknownMediaType is returned when a media in a JSON encoded Medias holds
+// a media_type that has no Media type.
+var ErrUnknownMediaType = errors.New("unknown media type")
+
+// Media is a single media of a post as served in a feed. Image, gif and
+// gallery posts list their metadatas, video and link posts list their videos
+// and links, and multi posts list their children in order.
+type Media interface {
+	MediaType() MediaType
+}
+
+func (ImageMetadata) MediaType() MediaType   { return MediaTypeImage }
+func (GifMetadata) MediaType() MediaType     { return MediaTypeGif }
+func (GalleryMetadata) MediaType() MediaType { return MediaTypeGallery }
+func (Video) MediaType() MediaType           { return MediaTypeVideo }
+func (Link) MediaType() MediaType            { return MediaTypeLink }
+func (MultiMedia) MediaType() MediaType      { return MediaTypeMulti }
+
+// Medias is a list of medias of mixed types. Its JSON encoding tags every
+// media with a media_type field, which is what decoding uses to pick the
+// concrete type of each media back.
+type Medias []Media
+
+type mediaTag struct {
+	Type MediaType `json:"media_type"`
+}
+
+func (m Medias) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	raws := make([]json.RawMessage, 0, len(m))
+	for _, media := range m {
+		raw, err := marshalMedia(media)
+		if err != nil {
+			return nil, err
+		}
+		raws = append(raws, raw)
+	}
+	return json.Marshal(raws)
+}
+
+func marshalMedia(media Media) ([]byte, error) {
+	tag := mediaTag{Type: media.MediaType()}
+
+	switch media := media.(type) {
+	case ImageMetadata:
+		return json.Marshal(struct {
+			mediaTag
+			ImageMetadata
+		}{tag, media})
+	case GifMetadata:
+		return json.Marshal(struct {
+			mediaTag
+			GifMetadata
+		}{tag, media})
+	case GalleryMetadata:
+		return json.Marshal(struct {
+			mediaTag
+			GalleryMetadata
+		}{tag, media})
+	case Video:
+		return json.Marshal(struct {
+			mediaTag
+			Video
+		}{tag, media})
+	case Link:
+		return json.Marshal(struct {
+			mediaTag
+			Link
+		}{tag, media})
+	case MultiMedia:
+		return json.Marshal(struct {
+			mediaTag
+			MultiMedia
+		}{tag, media})
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnknownMediaType, media)
+	}
+}
+
+func (m *Medias) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+	if raws == nil {
+		*m = nil
+		return nil
+	}
+
+	medias := make(Medias, 0, len(raws))
+	for _, raw := range raws {
+		media, err := unmarshalMedia(raw)
+		if err != nil {
+			return err
+		}
+		medias = append(medias, media)
+	}
+	*m = medias
+	return nil
+}
+
+func unmarshalMedia(data []byte) (Media, error) {
+	var tag mediaTag
+	if err := json.Unmarshal(data, &tag); err != nil {
+		return nil, err
+	}
+
+	switch tag.Type {
+	case MediaTypeImage:
+		return unmarshalMediaAs[ImageMetadata](data)
+	case MediaTypeGif:
+		return unmarshalMediaAs[GifMetadata](data)
+	case MediaTypeGallery:
+		return unmarshalMediaAs[GalleryMetadata](data)
+	case MediaTypeVideo:
+		return unmarshalMediaAs[Video](data)
+	case MediaTypeLink:
+		return unmarshalMediaAs[Link](data)
+	case MediaTypeMulti:
+		return unmarshalMediaAs[MultiMedia](data)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMediaType, tag.Type)
+	}
+}
+
+func unmarshalMediaAs[T Media](data []byte) (Media, error) {
+	var media T
+	if err := json.Unmarshal(data, &media); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// Scan decodes the JSON aggregated medias of a feed query.
+func (m *Medias) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case []byte:
+		return m.UnmarshalJSON(src)
+	case string:
+		return m.UnmarshalJSON([]byte(src))
+	default:
+		return fmt.Errorf("cannot scan %T into medias", src)
+	}
+}
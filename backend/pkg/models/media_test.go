@@ -0,0 +1,213 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedias_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC)
+
+	imageMetadata := models.ImageMetadata{
+		ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ImageID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Height:        1080,
+		Width:         1920,
+		Url:           "https://example.com/image1.jpg",
+		CreatedAt:     createdAt,
+		CreatedAtUnix: 1725091100,
+		UpdatedAt:     createdAt,
+	}
+	gifMetadata := models.GifMetadata{
+		ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		GifID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Height:        720,
+		Width:         1280,
+		Url:           "https://example.com/gif1.gif",
+		CreatedAt:     createdAt,
+		CreatedAtUnix: 1725091100,
+		UpdatedAt:     createdAt,
+	}
+	galleryMetadata := models.GalleryMetadata{
+		ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		GalleryID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		OrderIndex:    1,
+		Height:        1080,
+		Width:         1920,
+		Url:           "https://example.com/gallery11.jpg",
+		CreatedAt:     createdAt,
+		CreatedAtUnix: 1725091100,
+		UpdatedAt:     createdAt,
+	}
+	video := models.Video{
+		ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		Url:           "https://example.com/video.mp4",
+		Height:        1080,
+		Width:         1920,
+		CreatedAt:     createdAt,
+		CreatedAtUnix: 1725091100,
+		UpdatedAt:     createdAt,
+	}
+	link := models.Link{
+		ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+		Link:          "https://example.com",
+		Image:         []models.ImageMetadata{imageMetadata},
+		CreatedAt:     createdAt,
+		CreatedAtUnix: 1725091100,
+		UpdatedAt:     createdAt,
+	}
+
+	tests := []struct {
+		name      string
+		mediaType models.MediaType
+		medias    models.Medias
+	}{
+		{
+			name:      "image:POS",
+			mediaType: models.MediaTypeImage,
+			medias:    models.Medias{imageMetadata, imageMetadata},
+		},
+		{
+			name:      "gif:POS",
+			mediaType: models.MediaTypeGif,
+			medias:    models.Medias{gifMetadata},
+		},
+		{
+			name:      "video:POS",
+			mediaType: models.MediaTypeVideo,
+			medias:    models.Medias{video},
+		},
+		{
+			name:      "gallery:POS",
+			mediaType: models.MediaTypeGallery,
+			medias:    models.Medias{galleryMetadata, galleryMetadata},
+		},
+		{
+			name:      "link:POS",
+			mediaType: models.MediaTypeLink,
+			medias:    models.Medias{link},
+		},
+		{
+			name:      "multi:POS",
+			mediaType: models.MediaTypeMulti,
+			medias: models.Medias{
+				models.MultiMedia{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    video.ID,
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 0,
+					Medias:     models.Medias{video},
+				},
+				models.MultiMedia{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    galleryMetadata.GalleryID,
+					ChildType:  models.MediaTypeGallery,
+					OrderIndex: 1,
+					Medias:     models.Medias{galleryMetadata, galleryMetadata},
+				},
+				models.MultiMedia{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    link.ID,
+					ChildType:  models.MediaTypeLink,
+					OrderIndex: 2,
+					Medias:     models.Medias{link},
+				},
+			},
+		},
+		{
+			name:      "text:POS",
+			mediaType: models.MediaTypeText,
+			medias:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post := models.PostPaginated{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				MediaType: tt.mediaType,
+				Medias:    tt.medias,
+			}
+
+			data, err := json.Marshal(post)
+			if err != nil {
+				t.Fatalf("failed to marshal post: %v", err)
+			}
+
+			var gotPost models.PostPaginated
+			if err := json.Unmarshal(data, &gotPost); err != nil {
+				t.Fatalf("failed to unmarshal post: %v", err)
+			}
+
+			assert.Equal(t, post, gotPost, "expect post to survive a round trip")
+			for idx, media := range gotPost.Medias {
+				assert.IsType(t, tt.medias[idx], media, "expect media type to be kept")
+			}
+		})
+	}
+}
+
+func TestMedias_MarshalJSON(t *testing.T) {
+	medias := models.Medias{
+		models.Video{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			MediaID: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			Url:     "https://example.com/video.mp4",
+		},
+	}
+
+	data, err := json.Marshal(medias)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `
+        [
+          {
+            "media_type": "video",
+            "id": "00000000-0000-0000-0000-000000000001",
+            "media_id": "00000000-0000-0000-0000-000000000004",
+            "url": "https://example.com/video.mp4",
+            "height": 0,
+            "width": 0,
+            "created_at": "0001-01-01T00:00:00Z",
+            "created_at_unix": 0,
+            "updated_at": "0001-01-01T00:00:00Z"
+          }
+        ]
+        `, string(data))
+}
+
+func TestMedias_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{
+			name:    "unknown media type:NEG",
+			data:    `[{"media_type": "audio", "id": "00000000-0000-0000-0000-000000000001"}]`,
+			wantErr: models.ErrUnknownMediaType,
+		},
+		{
+			name:    "missing media type:NEG",
+			data:    `[{"id": "00000000-0000-0000-0000-000000000001"}]`,
+			wantErr: models.ErrUnknownMediaType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var medias models.Medias
+			err := json.Unmarshal([]byte(tt.data), &medias)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ModAction is an action a moderator takes in a voxsphere.
+type ModAction string
+
+const (
+	ModActionRemovePost     ModAction = "remove_post"
+	ModActionApprovePost    ModAction = "approve_post"
+	ModActionLockPost       ModAction = "lock_post"
+	ModActionUnlockPost     ModAction = "unlock_post"
+	ModActionPinPost        ModAction = "pin_post"
+	ModActionUnpinPost      ModAction = "unpin_post"
+	ModActionMarkNSFW       ModAction = "mark_nsfw"
+	ModActionUnmarkNSFW     ModAction = "unmark_nsfw"
+	ModActionMarkSpoiler    ModAction = "mark_spoiler"
+	ModActionUnmarkSpoiler  ModAction = "unmark_spoiler"
+	ModActionRemoveComment  ModAction = "remove_comment"
+	ModActionApproveComment ModAction = "approve_comment"
+	ModActionBanUser        ModAction = "ban_user"
+	ModActionUnbanUser      ModAction = "unban_user"
+
+	ModActionDismissPostReports    ModAction = "dismiss_post_reports"
+	ModActionDismissCommentReports ModAction = "dismiss_comment_reports"
+)
+
+// MaxPinnedPosts is the number of posts a voxsphere can pin at once.
+const MaxPinnedPosts = 2
+
+// ModLog is an entry of the mod log of a voxsphere. TargetID is the post,
+// comment or user the action was taken on.
+type ModLog struct {
+	bun.BaseModel `bun:"table:mod_logs"`
+	ID            uuid.UUID `json:"id"`
+	VoxsphereID   uuid.UUID `json:"voxsphere_id"`
+	ModeratorID   uuid.UUID `json:"moderator_id"`
+	Moderator     string    `json:"moderator" bun:",scanonly"`
+	Action        ModAction `json:"action"`
+	TargetID      uuid.UUID `json:"target_id"`
+	Details       string    `json:"details"`
+	CreatedAt     time.Time `json:"created_at"`
+	CreatedAtUnix int64     `json:"created_at_unix"`
+}
+
+// VoxsphereBan bans the user of UserID from the voxsphere of VoxsphereID until
+// ExpiresAt, or for good when ExpiresAt is nil.
+type VoxsphereBan struct {
+	bun.BaseModel `bun:"table:voxsphere_bans"`
+	VoxsphereID   uuid.UUID  `json:"voxsphere_id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	Reason        string     `json:"reason"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// BanSubmission is a ban submitted by a moderator.
+type BanSubmission struct {
+	Reason    string     `json:"reason"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// PostRestrictions holds what keeps a user from commenting on a post, along
+// with the voxsphere the post was posted in.
+type PostRestrictions struct {
+	VoxsphereID uuid.UUID
+	Locked      bool
+	Banned      bool
+}
@@ -1,6 +1,11 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,23 +26,159 @@ type Post struct {
 	UpdatedAt     time.Time `json:"updated_at"`
 }
 
+// PostSubmission is a post submitted by a user. A submission with a Link is
+// a link post, every other submission is a text post.
+type PostSubmission struct {
+	VoxsphereID uuid.UUID `json:"voxsphere_id"`
+	Title       string    `json:"title"`
+	Text        string    `json:"text"`
+	Link        string    `json:"link"`
+	Over18      bool      `json:"over18"`
+	Spoiler     bool      `json:"spoiler"`
+}
+
+// PostEdit holds the changes an author makes to their post. Nil fields are
+// left unchanged.
+type PostEdit struct {
+	Text    *string `json:"text"`
+	Over18  *bool   `json:"over18"`
+	Spoiler *bool   `json:"spoiler"`
+}
+
+type PostSort string
+
+const (
+	PostSortHot           PostSort = "hot"
+	PostSortTop           PostSort = "top"
+	PostSortNew           PostSort = "new"
+	PostSortRising        PostSort = "rising"
+	PostSortControversial PostSort = "controversial"
+)
+
+// PostSortWindow bounds the age of the posts ranked by the top and
+// controversial sorts.
+type PostSortWindow string
+
+const (
+	PostSortWindowDay   PostSortWindow = "day"
+	PostSortWindowWeek  PostSortWindow = "week"
+	PostSortWindowMonth PostSortWindow = "month"
+	PostSortWindowYear  PostSortWindow = "year"
+	PostSortWindowAll   PostSortWindow = "all"
+)
+
+// PostNSFW selects how a feed treats posts marked over 18.
+type PostNSFW string
+
+const (
+	PostNSFWInclude PostNSFW = "include"
+	PostNSFWExclude PostNSFW = "exclude"
+	PostNSFWOnly    PostNSFW = "only"
+)
+
+// PostFilter narrows down the posts of a feed. Zero valued fields do not
+// filter anything. MemberID keeps the posts of the voxspheres joined by the
+// user of MemberID. ViewerID leaves out the posts the user of ViewerID hid
+// and marks the ones they saved.
+type PostFilter struct {
+	VoxsphereID     uuid.UUID
+	VoxsphereIDs    []uuid.UUID
+	MemberID        uuid.UUID
+	ViewerID        uuid.UUID
+	AuthorName      string
+	MediaTypes      []MediaType
+	NSFW            PostNSFW
+	ExcludeSpoilers bool
+	FlairID         uuid.UUID
+}
+
+// Key returns a digest of the filter, which binds a cursor to the feed it was
+// handed out for.
+func (f PostFilter) Key() string {
+	b, _ := json.Marshal(f)
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
 type PostPaginated struct {
-	ID            uuid.UUID `json:"id"`
-	Author        string    `json:"author"`
-	Voxsphere     string    `json:"voxsphere"`
-	AuthorID      uuid.UUID `json:"author_id"`
-	VoxsphereID   uuid.UUID `json:"voxsphere_id"`
-	Title         string    `json:"title"`
-	Text          string    `json:"text"`
-	TextHtml      string    `json:"text_html"`
-	MediaType     MediaType `json:"media_type"`
-	Medias        []any     `json:"medias"`
-	Ups           int32     `json:"ups"`
-	NumComments   int32     `json:"num_comments"`
-	NumAwards     int32     `json:"num_awards"`
-	Over18        bool      `json:"over18"`
-	Spoiler       bool      `json:"spoiler"`
-	CreatedAt     time.Time `json:"created_at"`
-	CreatedAtUnix int64     `json:"created_at_unix"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID            uuid.UUID           `json:"id"`
+	Author        string              `json:"author"`
+	Voxsphere     string              `json:"voxsphere"`
+	AuthorID      uuid.UUID           `json:"author_id"`
+	VoxsphereID   uuid.UUID           `json:"voxsphere_id"`
+	Title         string              `json:"title"`
+	Text          string              `json:"text"`
+	TextHtml      string              `json:"text_html"`
+	MediaType     MediaType           `json:"media_type"`
+	Medias        Medias              `json:"medias"`
+	Ups           int32               `json:"ups"`
+	NumComments   int32               `json:"num_comments"`
+	NumAwards     int32               `json:"num_awards"`
+	AwardCounts   []AwardCount        `json:"award_counts"`
+	Over18        bool                `json:"over18"`
+	Spoiler       bool                `json:"spoiler"`
+	Locked        bool                `json:"locked"`
+	Pinned        bool                `json:"pinned"`
+	Saved         bool                `json:"saved"`
+	CreatedAt     time.Time           `json:"created_at"`
+	CreatedAtUnix int64               `json:"created_at_unix"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+	PostFlairs    []PostFlairRendered `json:"post_flairs"`
+	AuthorFlair   *UserFlairRendered  `json:"author_flair"`
+}
+
+type PostDetail struct {
+	PostPaginated
+	Awards []Award `json:"awards"`
+}
+
+// PostCursor marks the last post of a feed page. It holds the sort keys and
+// the ID of that post so the next page can resume right after it, along with
+// the key of the filter of the feed and the unix time its first page was
+// ranked at.
+type PostCursor struct {
+	Sort     PostSort       `json:"s"`
+	Window   PostSortWindow `json:"t"`
+	Filter   string         `json:"f"`
+	RankedAt int64          `json:"at"`
+	SortKey  []string       `json:"k"`
+	ID       uuid.UUID      `json:"id"`
+}
+
+var ErrInvalidPostCursor = errors.New("invalid post cursor")
+
+// sortKeyPattern matches the decimal literals NUMERIC accepts, leaving out
+// NaN and the infinities that no sort key takes.
+var sortKeyPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d{1,3})?$`)
+
+// Encode returns the opaque form of the cursor handed out to clients.
+func (c PostCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodePostCursor(cursor string) (PostCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return PostCursor{}, ErrInvalidPostCursor
+	}
+
+	var c PostCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return PostCursor{}, ErrInvalidPostCursor
+	}
+	if len(c.SortKey) == 0 || c.ID == uuid.Nil || c.RankedAt <= 0 {
+		return PostCursor{}, ErrInvalidPostCursor
+	}
+	for _, key := range c.SortKey {
+		if !sortKeyPattern.MatchString(key) {
+			return PostCursor{}, ErrInvalidPostCursor
+		}
+	}
+	return c, nil
+}
+
+type PostFeed struct {
+	Posts      []PostPaginated `json:"posts"`
+	NextCursor *string         `json:"next_cursor"`
 }
@@ -8,3 +8,31 @@ type PostFlair struct {
 	FullText        string    `json:"full_text"`
 	BackgroundColor string    `json:"background_color"`
 }
+
+type FlairRichtextType string
+
+const (
+	FlairRichtextTypeText        FlairRichtextType = "text"
+	FlairRichtextTypeEmoji       FlairRichtextType = "emoji"
+	FlairRichtextTypeCustomEmoji FlairRichtextType = "custom_emoji"
+)
+
+type FlairRichtext struct {
+	Type       FlairRichtextType `json:"type"`
+	OrderIndex int32             `json:"order_index"`
+	Text       string            `json:"text"`
+	Url        *string           `json:"url"`
+}
+
+type PostFlairRendered struct {
+	ID              uuid.UUID       `json:"id"`
+	VoxsphereID     uuid.UUID       `json:"voxsphere_id"`
+	FullText        string          `json:"full_text"`
+	BackgroundColor string          `json:"background_color"`
+	Richtext        []FlairRichtext `json:"richtext"`
+}
+
+// PostFlairSubmission is the flair a user picks for a post.
+type PostFlairSubmission struct {
+	PostFlairID uuid.UUID `json:"post_flair_id"`
+}
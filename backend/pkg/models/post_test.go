@@ -0,0 +1,85 @@
+package models_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodePostCursor(t *testing.T) {
+	cursor := models.PostCursor{
+		Sort:     models.PostSortTop,
+		Window:   models.PostSortWindowAll,
+		RankedAt: 1725091200,
+		ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	}
+	withKeys := func(keys ...string) string {
+		c := cursor
+		c.SortKey = keys
+		return c.Encode()
+	}
+
+	tests := []struct {
+		name    string
+		cursor  string
+		wantErr error
+	}{
+		{
+			name:   "integer and decimal keys :POS",
+			cursor: withKeys("-3", "12.5000", ".5", "1e3", "1725091160"),
+		},
+		{
+			name:    "not base64 :NEG",
+			cursor:  "foo!",
+			wantErr: models.ErrInvalidPostCursor,
+		},
+		{
+			name:    "not json :NEG",
+			cursor:  base64.RawURLEncoding.EncodeToString([]byte("foo")),
+			wantErr: models.ErrInvalidPostCursor,
+		},
+		{
+			name:    "no sort key :NEG",
+			cursor:  withKeys(),
+			wantErr: models.ErrInvalidPostCursor,
+		},
+		{
+			name:    "fraction key :NEG",
+			cursor:  withKeys("1/2"),
+			wantErr: models.ErrInvalidPostCursor,
+		},
+		{
+			name:    "hex key :NEG",
+			cursor:  withKeys("0x10"),
+			wantErr: models.ErrInvalidPostCursor,
+		},
+		{
+			name:    "infinite key :NEG",
+			cursor:  withKeys("Inf"),
+			wantErr: models.ErrInvalidPostCursor,
+		},
+		{
+			name:    "nan key :NEG",
+			cursor:  withKeys("NaN"),
+			wantErr: models.ErrInvalidPostCursor,
+		},
+		{
+			name:    "overflowing exponent key :NEG",
+			cursor:  withKeys("1e99999"),
+			wantErr: models.ErrInvalidPostCursor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotErr := models.DecodePostCursor(tt.cursor)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.cursor, got.Encode(), "expect cursor to round trip")
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ReportTargetType is the kind of content a report is filed against.
+type ReportTargetType string
+
+const (
+	ReportTargetPost    ReportTargetType = "post"
+	ReportTargetComment ReportTargetType = "comment"
+)
+
+// Report flags the post of PostID or the comment of CommentID as breaking the
+// rule of RuleID, or for Reason. ModLogID is the moderation action that
+// resolved the report.
+type Report struct {
+	bun.BaseModel `bun:"table:reports"`
+	ID            uuid.UUID  `json:"id"`
+	VoxsphereID   uuid.UUID  `json:"voxsphere_id"`
+	ReporterID    uuid.UUID  `json:"reporter_id" bun:",nullzero"`
+	PostID        uuid.UUID  `json:"post_id" bun:",nullzero"`
+	CommentID     uuid.UUID  `json:"comment_id" bun:",nullzero"`
+	RuleID        uuid.UUID  `json:"rule_id" bun:",nullzero"`
+	Reason        string     `json:"reason"`
+	ResolvedAt    *time.Time `json:"resolved_at"`
+	ModLogID      uuid.UUID  `json:"mod_log_id" bun:",nullzero"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CreatedAtUnix int64      `json:"created_at_unix"`
+}
+
+// ReportSubmission is a report submitted by a user. It names a rule of the
+// voxsphere, a free text reason, or both.
+type ReportSubmission struct {
+	RuleID uuid.UUID `json:"rule_id"`
+	Reason string    `json:"reason"`
+}
+
+// ReportReason counts the open reports of a target given for the same rule and
+// free text reason. Rule is the short name of the rule of RuleID, if any, and
+// Reason the free text the reporters gave, if any.
+type ReportReason struct {
+	RuleID uuid.UUID `json:"rule_id"`
+	Rule   string    `json:"rule"`
+	Reason string    `json:"reason"`
+	Count  int32     `json:"count"`
+}
+
+// ReportQueueItem groups the open reports of a post or comment.
+type ReportQueueItem struct {
+	TargetType      ReportTargetType `json:"target_type"`
+	TargetID        uuid.UUID        `json:"target_id"`
+	ReportCount     int32            `json:"report_count"`
+	Reasons         []ReportReason   `json:"reasons"`
+	FirstReportedAt time.Time        `json:"first_reported_at"`
+	LastReportedAt  time.Time        `json:"last_reported_at"`
+}
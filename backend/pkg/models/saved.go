@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type SavedPost struct {
+	bun.BaseModel `bun:"table:saved_posts"`
+	UserID        uuid.UUID `json:"user_id"`
+	PostID        uuid.UUID `json:"post_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type SavedComment struct {
+	bun.BaseModel `bun:"table:saved_comments"`
+	UserID        uuid.UUID `json:"user_id"`
+	CommentID     uuid.UUID `json:"comment_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type HiddenPost struct {
+	bun.BaseModel `bun:"table:hidden_posts"`
+	UserID        uuid.UUID `json:"user_id"`
+	PostID        uuid.UUID `json:"post_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type SavedItemType string
+
+const (
+	SavedItemTypePost    SavedItemType = "post"
+	SavedItemTypeComment SavedItemType = "comment"
+)
+
+// SavedRef points at a post or a comment saved by a user.
+type SavedRef struct {
+	Type    SavedItemType `json:"type"`
+	ID      uuid.UUID     `json:"id"`
+	SavedAt time.Time     `json:"saved_at"`
+}
+
+// SavedItem is a post or a comment saved by a user. Only the one of Type is
+// set.
+type SavedItem struct {
+	Type    SavedItemType  `json:"type"`
+	SavedAt time.Time      `json:"saved_at"`
+	Post    *PostPaginated `json:"post"`
+	Comment *UserComment   `json:"comment"`
+}
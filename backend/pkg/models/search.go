@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SearchType string
+
+const (
+	SearchTypePost      SearchType = "post"
+	SearchTypeComment   SearchType = "comment"
+	SearchTypeVoxsphere SearchType = "voxsphere"
+	SearchTypeUser      SearchType = "user"
+)
+
+// SearchResult is a single match of a search. Title holds the title of the
+// post, the title of the post a comment was made in, the title of the
+// voxsphere or the name of the user; Snippet holds the matching fragments of
+// the body with the matched words wrapped in <mark> tags.
+type SearchResult struct {
+	Type      SearchType `json:"type"`
+	ID        uuid.UUID  `json:"id"`
+	Title     string     `json:"title"`
+	Snippet   string     `json:"snippet"`
+	Rank      float32    `json:"rank"`
+	PostID    *uuid.UUID `json:"post_id"`
+	Voxsphere *string    `json:"voxsphere"`
+	Author    *string    `json:"author"`
+	CreatedAt time.Time  `json:"created_at"`
+}
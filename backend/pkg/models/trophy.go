@@ -1,6 +1,12 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
 
 type Trophy struct {
 	ID          uuid.UUID `json:"id"`
@@ -8,3 +14,26 @@ type Trophy struct {
 	Description string    `json:"description"`
 	ImageLink   string    `json:"image_link"`
 }
+
+// TrophyCriteria holds the criteria the trophy engine grants the trophy of
+// TrophyID by.
+type TrophyCriteria struct {
+	bun.BaseModel `bun:"table:trophy_criteria"`
+	TrophyID      uuid.UUID       `json:"trophy_id"`
+	Criteria      json.RawMessage `json:"criteria" bun:"type:jsonb"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// UserAchievements is what the trophy engine knows of a user. Karma is the
+// sum of the ups of the posts and the score of the comments of the user,
+// NumTopPosts the number of voxspheres whose most upvoted post the user wrote
+// and TrophyIDs the trophies the user already holds.
+type UserAchievements struct {
+	UserID         uuid.UUID   `json:"user_id"`
+	CreatedAt      time.Time   `json:"created_at"`
+	Karma          int         `json:"karma"`
+	NumPosts       int         `json:"num_posts"`
+	NumTopPosts    int         `json:"num_top_posts"`
+	AwardsReceived int         `json:"awards_received"`
+	TrophyIDs      []uuid.UUID `json:"trophy_ids"`
+}
@@ -21,3 +21,16 @@ type User struct {
 	CreatedAtUnix     int64     `json:"created_at_unix"`
 	UpdatedAt         time.Time `json:"updated_at"`
 }
+
+type UserKarma struct {
+	PostKarma    int64 `json:"post_karma"`
+	CommentKarma int64 `json:"comment_karma"`
+	Total        int64 `json:"total"`
+}
+
+type UserProfile struct {
+	User
+	Trophies []Trophy            `json:"trophies"`
+	Flairs   []UserFlairRendered `json:"flairs"`
+	Karma    UserKarma           `json:"karma"`
+}
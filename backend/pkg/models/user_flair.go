@@ -8,3 +8,34 @@ type UserFlair struct {
 	FullText        string    `json:"full_text"`
 	BackgroundColor string    `json:"background_color"`
 }
+
+type UserFlairRendered struct {
+	ID              uuid.UUID       `json:"id"`
+	VoxsphereID     uuid.UUID       `json:"voxsphere_id"`
+	Voxsphere       string          `json:"voxsphere"`
+	FullText        string          `json:"full_text"`
+	BackgroundColor string          `json:"background_color"`
+	Richtext        []FlairRichtext `json:"richtext"`
+}
+
+// FlairRichtextPiece is a piece of the rich text of a flair template. Text
+// pieces carry their Text, emoji pieces the EmojiID and custom emoji pieces
+// the CustomEmojiID of the emoji they show.
+type FlairRichtextPiece struct {
+	Type          FlairRichtextType `json:"type"`
+	Text          string            `json:"text"`
+	EmojiID       uuid.UUID         `json:"emoji_id"`
+	CustomEmojiID uuid.UUID         `json:"custom_emoji_id"`
+}
+
+// UserFlairTemplate is a user flair moderators offer in their voxsphere. Its
+// pieces are shown in order.
+type UserFlairTemplate struct {
+	BackgroundColor string               `json:"background_color"`
+	Richtext        []FlairRichtextPiece `json:"richtext"`
+}
+
+// UserFlairSubmission is the flair a user picks in a voxsphere.
+type UserFlairSubmission struct {
+	UserFlairID uuid.UUID `json:"user_flair_id"`
+}
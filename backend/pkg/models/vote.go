@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type VoteDirection string
+
+const (
+	VoteDirectionUp    VoteDirection = "up"
+	VoteDirectionDown  VoteDirection = "down"
+	VoteDirectionClear VoteDirection = "clear"
+)
+
+// Value returns what a vote of direction adds to a score.
+func (d VoteDirection) Value() int16 {
+	switch d {
+	case VoteDirectionUp:
+		return 1
+	case VoteDirectionDown:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// VoteDirectionOf returns the direction of a vote that adds value to a score.
+func VoteDirectionOf(value int16) VoteDirection {
+	switch {
+	case value > 0:
+		return VoteDirectionUp
+	case value < 0:
+		return VoteDirectionDown
+	default:
+		return VoteDirectionClear
+	}
+}
+
+type PostVote struct {
+	bun.BaseModel `bun:"table:post_votes"`
+	UserID        uuid.UUID `json:"user_id"`
+	PostID        uuid.UUID `json:"post_id"`
+	Direction     int16     `json:"direction"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type CommentVote struct {
+	bun.BaseModel `bun:"table:comment_votes"`
+	UserID        uuid.UUID `json:"user_id"`
+	CommentID     uuid.UUID `json:"comment_id"`
+	Direction     int16     `json:"direction"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Vote is the vote of a user on a post or comment of ID, along with the score
+// of the post or comment after the vote.
+type Vote struct {
+	ID        uuid.UUID     `json:"id"`
+	Direction VoteDirection `json:"direction"`
+	Score     int32         `json:"score"`
+}
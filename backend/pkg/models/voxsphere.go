@@ -23,3 +23,10 @@ type Voxsphere struct {
 	CreatedAtUnix         int64     `json:"created_at_unix"`
 	UpdatedAt             time.Time `json:"updated_at"`
 }
+
+type VoxsphereAbout struct {
+	Voxsphere
+	Rules       []Rule `json:"rules"`
+	Moderators  []User `json:"moderators"`
+	MemberCount int64  `json:"member_count"`
+}
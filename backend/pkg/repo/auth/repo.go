@@ -0,0 +1,219 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const (
+	pgUniqueViolation = "23505"
+	pgFKViolation     = "23503"
+
+	userNameConstraint        = "user_name"
+	credentialEmailConstraint = "uq_user_credentials_email"
+)
+
+var (
+	ErrAuthDuplicateName            = errors.New("user name already taken")
+	ErrAuthDuplicateEmail           = errors.New("email already taken")
+	ErrAuthCredentialNotFound       = errors.New("credential not found")
+	ErrAuthRefreshTokenNotFound     = errors.New("refresh token not found")
+	ErrAuthRefreshTokenUserNotFound = errors.New("refresh token user not found")
+)
+
+type Repo struct {
+	db *bun.DB
+}
+
+func NewRepo(db *bun.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// AddUserWithCredential adds user along with the credential they log in with.
+func (r *Repo) AddUserWithCredential(ctx context.Context, user models.User, credential models.UserCredential) (models.User, error) {
+	timestamp := time.Now()
+	user.CreatedAt = timestamp
+	user.UpdatedAt = timestamp
+	user.CreatedAtUnix = timestamp.Unix()
+	credential.UserID = user.ID
+	credential.CreatedAt = timestamp
+	credential.UpdatedAt = timestamp
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		userQuery := `
+            INSERT INTO
+                users (
+                    id,
+                    name,
+                    public_description,
+                    avatar_img,
+                    banner_img,
+                    iconcolor,
+                    keycolor,
+                    primarycolor,
+                    over18,
+                    suspended,
+                    created_at,
+                    created_at_unix,
+                    updated_at
+                )
+            VALUES
+                (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+        `
+		if _, err := tx.NewRaw(userQuery,
+			user.ID,
+			user.Name,
+			user.PublicDescription,
+			user.AvatarImg,
+			user.BannerImg,
+			user.Iconcolor,
+			user.Keycolor,
+			user.Primarycolor,
+			user.Over18,
+			user.Suspended,
+			user.CreatedAt,
+			user.CreatedAtUnix,
+			user.UpdatedAt,
+		).Exec(ctx); err != nil {
+			return err
+		}
+
+		credentialQuery := `
+            INSERT INTO
+                user_credentials (
+                    user_id,
+                    email,
+                    password_hash,
+                    created_at,
+                    updated_at
+                )
+            VALUES
+                (?, ?, ?, ?, ?);
+        `
+		if _, err := tx.NewRaw(credentialQuery,
+			credential.UserID,
+			credential.Email,
+			credential.PasswordHash,
+			credential.CreatedAt,
+			credential.UpdatedAt,
+		).Exec(ctx); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgUniqueViolation {
+			switch pgdriverErr.Field('n') {
+			case userNameConstraint:
+				return models.User{}, ErrAuthDuplicateName
+			case credentialEmailConstraint:
+				return models.User{}, ErrAuthDuplicateEmail
+			}
+		}
+		return models.User{}, err
+	}
+	return user, nil
+}
+
+// CredentialByName returns the credential of the user named name.
+func (r *Repo) CredentialByName(ctx context.Context, name string) (models.UserCredential, error) {
+	var credential models.UserCredential
+
+	query := `
+        SELECT
+            uc.user_id,
+            uc.email,
+            uc.password_hash,
+            uc.created_at,
+            uc.updated_at
+        FROM
+            user_credentials uc
+            JOIN users u ON u.id = uc.user_id
+        WHERE
+            u.name = ?;
+    `
+	if _, err := r.db.NewRaw(query, name).Exec(ctx, &credential); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.UserCredential{}, ErrAuthCredentialNotFound
+		}
+		return models.UserCredential{}, err
+	}
+	return credential, nil
+}
+
+func (r *Repo) AddRefreshToken(ctx context.Context, token models.RefreshToken) (models.RefreshToken, error) {
+	token.CreatedAt = time.Now()
+
+	query := `
+        INSERT INTO
+            refresh_tokens (
+                id,
+                user_id,
+                expires_at,
+                revoked_at,
+                created_at
+            )
+        VALUES
+            (?, ?, ?, ?, ?)
+        RETURNING
+            id,
+            user_id,
+            expires_at,
+            revoked_at,
+            created_at;
+    `
+	if _, err := r.db.NewRaw(query,
+		token.ID,
+		token.UserID,
+		token.ExpiresAt,
+		token.RevokedAt,
+		token.CreatedAt,
+	).Exec(ctx, &token); err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgFKViolation {
+			return models.RefreshToken{}, ErrAuthRefreshTokenUserNotFound
+		}
+		return models.RefreshToken{}, err
+	}
+	return token, nil
+}
+
+// RevokeRefreshToken revokes the refresh token of ID and returns it. A token
+// can be revoked once and only before it expires, so of two concurrent
+// revocations only one succeeds.
+func (r *Repo) RevokeRefreshToken(ctx context.Context, ID uuid.UUID) (models.RefreshToken, error) {
+	var token models.RefreshToken
+
+	timestamp := time.Now()
+	query := `
+        UPDATE
+            refresh_tokens
+        SET
+            revoked_at = ?0
+        WHERE
+            id = ?1
+            AND revoked_at IS NULL
+            AND expires_at > ?0
+        RETURNING
+            id,
+            user_id,
+            expires_at,
+            revoked_at,
+            created_at;
+    `
+	if _, err := r.db.NewRaw(query, timestamp, ID).Exec(ctx, &token); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.RefreshToken{}, ErrAuthRefreshTokenNotFound
+		}
+		return models.RefreshToken{}, err
+	}
+	return token, nil
+}
@@ -0,0 +1,316 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	authrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/auth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dbfixture"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+)
+
+func connectPostgres(user, password, address, dbName string) *bun.DB {
+	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, address, dbName)
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
+	db := bun.NewDB(sqldb, pgdialect.New())
+	return db
+}
+
+func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
+	db := connectPostgres("postgres", "postgres", "127.0.0.1:5432", "voxpopuli")
+
+	if err := db.Ping(); err != nil {
+		t.Fatal("db error:", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Log("db close error:", err)
+		}
+	})
+
+	// add query logging hook
+	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
+
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.UserCredential)(nil))
+	db.RegisterModel((*models.RefreshToken)(nil))
+
+	// drop all rows of the auth tables
+	for _, model := range []interface{}{
+		(*models.User)(nil),
+		(*models.UserCredential)(nil),
+		(*models.RefreshToken)(nil),
+	} {
+		if _, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background()); err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
+	}
+
+	// load fixture
+	fixture := dbfixture.New(db)
+	if err := fixture.Load(context.Background(), os.DirFS("testdata"), fixtureFiles...); err != nil {
+		t.Fatal("failed to load fixtures", err)
+	}
+
+	return db
+}
+
+func TestRepo_AddUserWithCredential(t *testing.T) {
+	fixtureFiles := []string{"users.yml", "user_credentials.yml"}
+
+	type args struct {
+		user       models.User
+		credential models.UserCredential
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantUser models.User
+		wantErr  error
+	}{
+		{
+			name: "new user :POS",
+			args: args{
+				user: models.User{
+					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Name: "Jim Doe",
+				},
+				credential: models.UserCredential{
+					Email:        "jim@example.com",
+					PasswordHash: "hash",
+				},
+			},
+			wantUser: models.User{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				Name: "Jim Doe",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "duplicate name :NEG",
+			args: args{
+				user: models.User{
+					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Name: "John Doe",
+				},
+				credential: models.UserCredential{
+					Email:        "jim@example.com",
+					PasswordHash: "hash",
+				},
+			},
+			wantUser: models.User{},
+			wantErr:  authrepo.ErrAuthDuplicateName,
+		},
+		{
+			name: "duplicate email in another case :NEG",
+			args: args{
+				user: models.User{
+					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Name: "Jim Doe",
+				},
+				credential: models.UserCredential{
+					Email:        "JOHN@example.com",
+					PasswordHash: "hash",
+				},
+			},
+			wantUser: models.User{},
+			wantErr:  authrepo.ErrAuthDuplicateEmail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := authrepo.NewRepo(db)
+
+			gotUser, gotErr := pgrepo.AddUserWithCredential(context.Background(), tt.args.user, tt.args.credential)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			gotUser.CreatedAt = time.Time{}
+			gotUser.CreatedAtUnix = 0
+			gotUser.UpdatedAt = time.Time{}
+			assert.Equal(t, tt.wantUser, gotUser, "expect user to match")
+
+			if tt.wantErr != nil {
+				// nothing of a failed registration is kept
+				count, err := db.NewSelect().Model((*models.User)(nil)).Where("id = ?", tt.args.user.ID).Count(context.Background())
+				if err != nil {
+					t.Fatal("failed to count users:", err)
+				}
+				assert.Equal(t, 0, count, "expect user not to be added")
+				return
+			}
+
+			gotCredential, err := pgrepo.CredentialByName(context.Background(), tt.args.user.Name)
+			if err != nil {
+				t.Fatal("failed to get credential:", err)
+			}
+			assert.Equal(t, tt.args.user.ID, gotCredential.UserID, "expect credential user id to match")
+			assert.Equal(t, tt.args.credential.Email, gotCredential.Email, "expect credential email to match")
+			assert.Equal(t, tt.args.credential.PasswordHash, gotCredential.PasswordHash, "expect credential password hash to match")
+		})
+	}
+}
+
+func TestRepo_CredentialByName(t *testing.T) {
+	fixtureFiles := []string{"users.yml", "user_credentials.yml"}
+
+	tests := []struct {
+		name           string
+		userName       string
+		wantCredential models.UserCredential
+		wantErr        error
+	}{
+		{
+			name:     "credential of John Doe :POS",
+			userName: "John Doe",
+			wantCredential: models.UserCredential{
+				UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:        "john@example.com",
+				PasswordHash: "$2a$10$CwTycUXWue0Thq9StjUM0uJ8.jJbhJYdqaBbrN2xkv0dVMXKk5sAW",
+				CreatedAt:    time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+				UpdatedAt:    time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+			},
+			wantErr: nil,
+		},
+		{
+			name:           "user without credential :NEG",
+			userName:       "Jane Doe",
+			wantCredential: models.UserCredential{},
+			wantErr:        authrepo.ErrAuthCredentialNotFound,
+		},
+		{
+			name:           "unknown user :NEG",
+			userName:       "Nobody",
+			wantCredential: models.UserCredential{},
+			wantErr:        authrepo.ErrAuthCredentialNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := authrepo.NewRepo(db)
+
+			gotCredential, gotErr := pgrepo.CredentialByName(context.Background(), tt.userName)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantCredential, gotCredential, "expect credential to match")
+		})
+	}
+}
+
+func TestRepo_AddRefreshToken(t *testing.T) {
+	fixtureFiles := []string{"users.yml"}
+
+	tests := []struct {
+		name      string
+		token     models.RefreshToken
+		wantToken models.RefreshToken
+		wantErr   error
+	}{
+		{
+			name: "refresh token of John Doe :POS",
+			token: models.RefreshToken{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ExpiresAt: time.Date(2099, 10, 10, 10, 10, 10, 0, time.UTC),
+			},
+			wantToken: models.RefreshToken{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ExpiresAt: time.Date(2099, 10, 10, 10, 10, 10, 0, time.UTC),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown user :NEG",
+			token: models.RefreshToken{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				ExpiresAt: time.Date(2099, 10, 10, 10, 10, 10, 0, time.UTC),
+			},
+			wantToken: models.RefreshToken{},
+			wantErr:   authrepo.ErrAuthRefreshTokenUserNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := authrepo.NewRepo(db)
+
+			gotToken, gotErr := pgrepo.AddRefreshToken(context.Background(), tt.token)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			gotToken.CreatedAt = time.Time{}
+			assert.Equal(t, tt.wantToken, gotToken, "expect refresh token to match")
+		})
+	}
+}
+
+func TestRepo_RevokeRefreshToken(t *testing.T) {
+	fixtureFiles := []string{"users.yml", "refresh_tokens.yml"}
+
+	tests := []struct {
+		name      string
+		ID        uuid.UUID
+		wantToken models.RefreshToken
+		wantErr   error
+	}{
+		{
+			name: "usable token :POS",
+			ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantToken: models.RefreshToken{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ExpiresAt: time.Date(2099, 10, 10, 10, 10, 10, 0, time.UTC),
+				CreatedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "revoked token :NEG",
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantToken: models.RefreshToken{},
+			wantErr:   authrepo.ErrAuthRefreshTokenNotFound,
+		},
+		{
+			name:      "expired token :NEG",
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			wantToken: models.RefreshToken{},
+			wantErr:   authrepo.ErrAuthRefreshTokenNotFound,
+		},
+		{
+			name:      "unknown token :NEG",
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantToken: models.RefreshToken{},
+			wantErr:   authrepo.ErrAuthRefreshTokenNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := authrepo.NewRepo(db)
+
+			gotToken, gotErr := pgrepo.RevokeRefreshToken(context.Background(), tt.ID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr == nil {
+				assert.NotNil(t, gotToken.RevokedAt, "expect refresh token to be revoked")
+				gotToken.RevokedAt = nil
+
+				// a revoked token cannot be revoked again
+				_, err := pgrepo.RevokeRefreshToken(context.Background(), tt.ID)
+				assert.ErrorIs(t, err, authrepo.ErrAuthRefreshTokenNotFound, "expect token to be revoked once")
+			}
+			assert.Equal(t, tt.wantToken, gotToken, "expect refresh token to match")
+		})
+	}
+}
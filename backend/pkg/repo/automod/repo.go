@@ -0,0 +1,377 @@
+package automod
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/karma"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const (
+	pgConstraintViolation = "23503"
+	pgUniqueViolation     = "23505"
+)
+
+var (
+	ErrAutomodConfigNotFound    = errors.New("automod config not found")
+	ErrAutomodVoxsphereNotFound = errors.New("voxsphere not found")
+	ErrAutomodUserNotFound      = errors.New("user not found")
+	ErrAutomodInvalidTarget     = errors.New("invalid automod target")
+	ErrAutomodNameTaken         = errors.New("the name of the automod user is taken")
+)
+
+// automodTarget describes the table of a post or comment automod acts on.
+type automodTarget struct {
+	table         string
+	reportsColumn string
+	removeAction  models.ModAction
+}
+
+var automodTargets = map[models.ReportTargetType]automodTarget{
+	models.ReportTargetPost: {
+		table:         "posts",
+		reportsColumn: "post_id",
+		removeAction:  models.ModActionRemovePost,
+	},
+	models.ReportTargetComment: {
+		table:         "comments",
+		reportsColumn: "comment_id",
+		removeAction:  models.ModActionRemoveComment,
+	},
+}
+
+type AutomodRepository interface {
+	AutomodConfig(context.Context, uuid.UUID) (models.AutomodConfig, error)
+	SetAutomodConfig(context.Context, models.AutomodConfig) (models.AutomodConfig, error)
+	AuthorStanding(context.Context, uuid.UUID) (models.AuthorStanding, error)
+	ApplyVerdict(context.Context, models.AutomodVerdict) error
+}
+
+type Repo struct {
+	db *bun.DB
+}
+
+func NewRepo(db *bun.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// EnsureAutoModerator adds the AutoModeratorID user automod acts as, unless it
+// exists already. It is safe to call at every start, and after the users were
+// truncated by the scraper.
+func (r *Repo) EnsureAutoModerator(ctx context.Context) error {
+	query := `
+        INSERT INTO users
+            (
+                id,
+                name,
+                public_description,
+                created_at_unix
+            )
+        VALUES
+            (?, ?, ?, EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT)
+        ON CONFLICT (id) DO NOTHING;
+    `
+	if _, err := r.db.NewRaw(query,
+		models.AutoModeratorID,
+		models.AutoModeratorName,
+		"Automated moderation of voxspheres",
+	).Exec(ctx); err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgUniqueViolation {
+			return ErrAutomodNameTaken
+		}
+		return err
+	}
+	return nil
+}
+
+// AutomodConfig returns the automod config of the voxsphere of voxsphereID.
+func (r *Repo) AutomodConfig(ctx context.Context, voxsphereID uuid.UUID) (models.AutomodConfig, error) {
+	var config models.AutomodConfig
+
+	query := `
+        SELECT
+            ac.voxsphere_id,
+            ac.config,
+            ac.updated_by,
+            ac.updated_at
+        FROM
+            automod_configs ac
+        WHERE
+            ac.voxsphere_id = ?;
+    `
+	if err := r.db.NewRaw(query, voxsphereID).Scan(ctx, &config); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.AutomodConfig{}, ErrAutomodConfigNotFound
+		}
+		return models.AutomodConfig{}, err
+	}
+	return config, nil
+}
+
+// SetAutomodConfig adds or replaces the automod config of its voxsphere.
+func (r *Repo) SetAutomodConfig(ctx context.Context, config models.AutomodConfig) (models.AutomodConfig, error) {
+	config.UpdatedAt = time.Now()
+
+	query := `
+        INSERT INTO automod_configs
+            (
+                voxsphere_id,
+                config,
+                updated_by,
+                updated_at
+            )
+        VALUES
+            (?, ?, ?, ?)
+        ON CONFLICT (voxsphere_id) DO UPDATE SET
+            config = EXCLUDED.config,
+            updated_by = EXCLUDED.updated_by,
+            updated_at = EXCLUDED.updated_at;
+    `
+	if _, err := r.db.NewRaw(query,
+		config.VoxsphereID,
+		string(config.Config),
+		config.UpdatedBy,
+		config.UpdatedAt,
+	).Exec(ctx); err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+			if pgdriverErr.Field('n') == "fk_voxsphere_id" {
+				return models.AutomodConfig{}, ErrAutomodVoxsphereNotFound
+			}
+			return models.AutomodConfig{}, ErrAutomodUserNotFound
+		}
+		return models.AutomodConfig{}, err
+	}
+	return config, nil
+}
+
+// AuthorStanding returns when the user of userID signed up and the karma the
+// user earned with posts and comments.
+func (r *Repo) AuthorStanding(ctx context.Context, userID uuid.UUID) (models.AuthorStanding, error) {
+	var standing models.AuthorStanding
+
+	query := `
+        SELECT
+            u.created_at,
+            ` + karma.Query("u.id") + ` AS karma
+        FROM
+            users u
+        WHERE
+            u.id = ?;
+    `
+	if err := r.db.NewRaw(query, userID).Scan(ctx, &standing.CreatedAt, &standing.Karma); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.AuthorStanding{}, ErrAutomodUserNotFound
+		}
+		return models.AuthorStanding{}, err
+	}
+	return standing, nil
+}
+
+// ApplyVerdict carries out verdict in a single transaction, as
+// ApplyVerdictTx does.
+func (r *Repo) ApplyVerdict(ctx context.Context, verdict models.AutomodVerdict) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return ApplyVerdictTx(ctx, tx, verdict)
+	})
+}
+
+// ApplyVerdictTx carries out verdict within tx, so that a post or comment can
+// be written along with the verdict on it. Removed targets are logged in the
+// mod log as removed by automod, and filtered targets are removed and
+// reported so that moderators can approve them from the report queue. A
+// flair is only set when it is a post flair of the voxsphere, and it replaces
+// the flair the post had so that a post keeps a single one. As a verdict is
+// applied again on every edit of its target, a target is not reported again
+// while the report of the same rule is open, and a reply is not posted again
+// when automod already posted it in the same place.
+func ApplyVerdictTx(ctx context.Context, tx bun.Tx, verdict models.AutomodVerdict) error {
+	target, ok := automodTargets[verdict.TargetType]
+	if !ok {
+		return ErrAutomodInvalidTarget
+	}
+	now := time.Now()
+
+	if verdict.Remove || verdict.Filter {
+		removeQuery := fmt.Sprintf(`
+                UPDATE
+                    %s
+                SET
+                    removed_at = ?
+                WHERE
+                    id = ?;
+            `, target.table)
+		if _, err := tx.NewRaw(removeQuery, now, verdict.TargetID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if verdict.Remove {
+		modLogQuery := `
+                INSERT INTO mod_logs
+                    (
+                        id,
+                        voxsphere_id,
+                        moderator_id,
+                        action,
+                        target_id,
+                        details,
+                        created_at,
+                        created_at_unix
+                    )
+                VALUES
+                    (?, ?, ?, ?, ?, ?, ?, ?);
+            `
+		if _, err := tx.NewRaw(modLogQuery,
+			uuid.New(),
+			verdict.VoxsphereID,
+			models.AutoModeratorID,
+			target.removeAction,
+			verdict.TargetID,
+			verdict.Details,
+			now,
+			now.Unix(),
+		).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if verdict.Filter {
+		reportQuery := fmt.Sprintf(`
+                INSERT INTO reports
+                    (
+                        id,
+                        voxsphere_id,
+                        %[1]s,
+                        reason,
+                        created_at,
+                        created_at_unix
+                    )
+                SELECT
+                    ?, ?, ?, ?, ?, ?
+                WHERE
+                    NOT EXISTS (
+                        SELECT
+                            1
+                        FROM
+                            reports r
+                        WHERE
+                            r.%[1]s = ?
+                            AND r.reporter_id IS NULL
+                            AND r.reason = ?
+                            AND r.resolved_at IS NULL
+                    );
+            `, target.reportsColumn)
+		if _, err := tx.NewRaw(reportQuery,
+			uuid.New(),
+			verdict.VoxsphereID,
+			verdict.TargetID,
+			verdict.Details,
+			now,
+			now.Unix(),
+			verdict.TargetID,
+			verdict.Details,
+		).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if verdict.FlairID != uuid.Nil && verdict.TargetType == models.ReportTargetPost {
+		unflairQuery := `
+                DELETE FROM post_post_flairs
+                WHERE
+                    post_id = ?
+                    AND EXISTS (
+                        SELECT
+                            1
+                        FROM
+                            post_flairs pf
+                        WHERE
+                            pf.id = ?
+                            AND pf.voxsphere_id = ?
+                    );
+            `
+		if _, err := tx.NewRaw(unflairQuery, verdict.TargetID, verdict.FlairID, verdict.VoxsphereID).Exec(ctx); err != nil {
+			return err
+		}
+
+		flairQuery := `
+                INSERT INTO post_post_flairs
+                    (
+                        post_id,
+                        post_flair_id
+                    )
+                SELECT
+                    ?,
+                    pf.id
+                FROM
+                    post_flairs pf
+                WHERE
+                    pf.id = ?
+                    AND pf.voxsphere_id = ?
+                ON CONFLICT DO NOTHING;
+            `
+		if _, err := tx.NewRaw(flairQuery, verdict.TargetID, verdict.FlairID, verdict.VoxsphereID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, reply := range verdict.Replies {
+		replyQuery := `
+                INSERT INTO comments
+                    (
+                        id,
+                        author_id,
+                        parent_comment_id,
+                        post_id,
+                        body,
+                        body_html,
+                        ups,
+                        score,
+                        created_at,
+                        created_at_unix,
+                        updated_at
+                    )
+                SELECT
+                    ?, ?, ?, ?, ?, ?, 0, 0, ?, ?, ?
+                WHERE
+                    NOT EXISTS (
+                        SELECT
+                            1
+                        FROM
+                            comments c
+                        WHERE
+                            c.author_id = ?
+                            AND c.post_id = ?
+                            AND c.parent_comment_id IS NOT DISTINCT FROM ?
+                            AND c.body = ?
+                    );
+            `
+		if _, err := tx.NewRaw(replyQuery,
+			reply.ID,
+			models.AutoModeratorID,
+			bun.NullZero(reply.ParentCommentID),
+			reply.PostID,
+			reply.Body,
+			reply.BodyHtml,
+			now,
+			now.Unix(),
+			now,
+			models.AutoModeratorID,
+			reply.PostID,
+			bun.NullZero(reply.ParentCommentID),
+			reply.Body,
+		).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
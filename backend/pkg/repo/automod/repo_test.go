@@ -0,0 +1,464 @@
+package automod_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	automodrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/automod"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dbfixture"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+)
+
+func connectPostgres(user, password, address, dbName string) *bun.DB {
+	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, address, dbName)
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
+	db := bun.NewDB(sqldb, pgdialect.New())
+	return db
+}
+
+func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
+	db := connectPostgres("postgres", "postgres", "127.0.0.1:5432", "voxpopuli")
+
+	if err := db.Ping(); err != nil {
+		t.Fatal("db error:", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Log("db close error:", err)
+		}
+	})
+
+	// add query logging hook
+	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
+
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
+	db.RegisterModel((*models.PostFlair)(nil))
+	db.RegisterModel((*models.PostPostFlair)(nil))
+	db.RegisterModel((*models.Report)(nil))
+	db.RegisterModel((*models.ModLog)(nil))
+	db.RegisterModel((*models.AutomodConfig)(nil))
+
+	// drop all rows of the reported tables
+	for _, model := range []interface{}{
+		(*models.Topic)(nil),
+		(*models.Voxsphere)(nil),
+		(*models.User)(nil),
+		(*models.Post)(nil),
+		(*models.Comment)(nil),
+		(*models.PostFlair)(nil),
+		(*models.PostPostFlair)(nil),
+		(*models.Report)(nil),
+		(*models.ModLog)(nil),
+		(*models.AutomodConfig)(nil),
+	} {
+		if _, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background()); err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
+	}
+
+	// load fixture
+	fixture := dbfixture.New(db)
+	if err := fixture.Load(context.Background(), os.DirFS("testdata"), fixtureFiles...); err != nil {
+		t.Fatal("failed to load fixtures", err)
+	}
+
+	return db
+}
+
+func TestRepo_EnsureAutoModerator(t *testing.T) {
+	fixtureFiles := []string{"users.yml"}
+
+	tests := []struct {
+		name       string
+		holderID   uuid.UUID
+		wantErr    error
+		wantExists bool
+	}{
+		{
+			name:       "automod user exists :POS",
+			holderID:   models.AutoModeratorID,
+			wantErr:    nil,
+			wantExists: true,
+		},
+		{
+			name:       "automod user truncated :POS",
+			holderID:   uuid.Nil,
+			wantErr:    nil,
+			wantExists: true,
+		},
+		{
+			name:       "name taken by another user :NEG",
+			holderID:   uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantErr:    automodrepo.ErrAutomodNameTaken,
+			wantExists: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := automodrepo.NewRepo(db)
+
+			// the fixture holds the automod user, hand its name to the holder
+			if tt.holderID != models.AutoModeratorID {
+				if _, err := db.NewDelete().Model((*models.User)(nil)).Where("id = ?", models.AutoModeratorID).Exec(context.Background()); err != nil {
+					t.Fatal("failed to delete the automod user", err)
+				}
+			}
+			if tt.holderID != uuid.Nil && tt.holderID != models.AutoModeratorID {
+				if _, err := db.NewInsert().Model(&models.User{ID: tt.holderID, Name: models.AutoModeratorName}).Exec(context.Background()); err != nil {
+					t.Fatal("failed to add the holder of the automod name", err)
+				}
+			}
+
+			gotErr := pgrepo.EnsureAutoModerator(context.Background())
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			gotExists, err := db.NewSelect().Model((*models.User)(nil)).Where("id = ?", models.AutoModeratorID).Exists(context.Background())
+			assert.NoError(t, err, "expect automod user lookup to succeed")
+			assert.Equal(t, tt.wantExists, gotExists, "expect automod user existence to match")
+		})
+	}
+}
+
+func TestRepo_SetAutomodConfig(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml"}
+
+	tests := []struct {
+		name    string
+		config  models.AutomodConfig
+		wantErr error
+	}{
+		{
+			name: "voxsphere not found :NEG",
+			config: models.AutomodConfig{
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				Config:      json.RawMessage(`{"rules": []}`),
+				UpdatedBy:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantErr: automodrepo.ErrAutomodVoxsphereNotFound,
+		},
+		{
+			name: "user not found :NEG",
+			config: models.AutomodConfig{
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Config:      json.RawMessage(`{"rules": []}`),
+				UpdatedBy:   uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			wantErr: automodrepo.ErrAutomodUserNotFound,
+		},
+		{
+			name: "set config :POS",
+			config: models.AutomodConfig{
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Config:      json.RawMessage(`{"rules": [{"name": "spam", "body": "spam", "action": "remove"}]}`),
+				UpdatedBy:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := automodrepo.NewRepo(db)
+
+			_, gotErr := pgrepo.AutomodConfig(context.Background(), tt.config.VoxsphereID)
+			assert.ErrorIs(t, gotErr, automodrepo.ErrAutomodConfigNotFound, "expect no config before setting one")
+
+			_, gotErr = pgrepo.SetAutomodConfig(context.Background(), tt.config)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr != nil {
+				return
+			}
+
+			// replacing the config keeps a single config per voxsphere
+			tt.config.UpdatedBy = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+			_, gotErr = pgrepo.SetAutomodConfig(context.Background(), tt.config)
+			assert.NoError(t, gotErr, "expect config to be replaced")
+
+			gotConfig, gotErr := pgrepo.AutomodConfig(context.Background(), tt.config.VoxsphereID)
+			assert.NoError(t, gotErr, "expect config to be found")
+			assert.JSONEq(t, string(tt.config.Config), string(gotConfig.Config), "expect config to match")
+			assert.Equal(t, tt.config.UpdatedBy, gotConfig.UpdatedBy, "expect last moderator to match")
+		})
+	}
+}
+
+func TestRepo_AuthorStanding(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"}
+
+	tests := []struct {
+		name         string
+		userID       uuid.UUID
+		wantStanding models.AuthorStanding
+		wantErr      error
+	}{
+		{
+			name:    "user not found :NEG",
+			userID:  uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantErr: automodrepo.ErrAutomodUserNotFound,
+		},
+		{
+			name:   "karma from posts :POS",
+			userID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantStanding: models.AuthorStanding{
+				CreatedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+				Karma:     30,
+			},
+		},
+		{
+			name:   "karma from comment scores :POS",
+			userID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantStanding: models.AuthorStanding{
+				CreatedAt: time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+				Karma:     3,
+			},
+		},
+		{
+			name:   "no karma :POS",
+			userID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			wantStanding: models.AuthorStanding{
+				CreatedAt: time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+				Karma:     0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := automodrepo.NewRepo(db)
+
+			gotStanding, gotErr := pgrepo.AuthorStanding(context.Background(), tt.userID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.True(t, tt.wantStanding.CreatedAt.Equal(gotStanding.CreatedAt), "expect created at to match")
+			assert.Equal(t, tt.wantStanding.Karma, gotStanding.Karma, "expect karma to match")
+		})
+	}
+}
+
+func TestRepo_ApplyVerdict(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml", "post_flairs.yml"}
+
+	voxsphereID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	commentID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name        string
+		verdict     models.AutomodVerdict
+		wantErr     error
+		wantRemoved bool
+		wantModLogs int
+		wantReports int
+		flairID     uuid.UUID
+		wantFlairs  int
+		wantFlair   uuid.UUID
+		wantReplies int
+	}{
+		{
+			name: "invalid target :NEG",
+			verdict: models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  "user",
+				TargetID:    postID,
+				Remove:      true,
+			},
+			wantErr: automodrepo.ErrAutomodInvalidTarget,
+		},
+		{
+			name: "remove post :POS",
+			verdict: models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    postID,
+				Remove:      true,
+				Details:     "automod: spam",
+			},
+			wantRemoved: true,
+			wantModLogs: 1,
+		},
+		{
+			name: "filter comment :POS",
+			verdict: models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetComment,
+				TargetID:    commentID,
+				Filter:      true,
+				Details:     "automod: new accounts",
+			},
+			wantRemoved: true,
+			wantReports: 1,
+		},
+		{
+			name: "flair of the voxsphere :POS",
+			verdict: models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    postID,
+				FlairID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantFlairs: 1,
+			wantFlair:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name: "flair replaces the post flair :POS",
+			verdict: models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    postID,
+				FlairID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			flairID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			wantFlairs: 1,
+			wantFlair:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name: "flair of another voxsphere :NEG",
+			verdict: models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    postID,
+				FlairID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantFlairs: 0,
+		},
+		{
+			name: "flair of another voxsphere keeps the post flair :NEG",
+			verdict: models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    postID,
+				FlairID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			flairID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			wantFlairs: 1,
+			wantFlair:  uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		},
+		{
+			name: "reply to post :POS",
+			verdict: models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    postID,
+				Replies: []models.Comment{
+					{
+						ID:       uuid.New(),
+						AuthorID: models.AutoModeratorID,
+						PostID:   postID,
+						Body:     "read the rules",
+						BodyHtml: "<p>read the rules</p>",
+					},
+				},
+			},
+			wantReplies: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := automodrepo.NewRepo(db)
+
+			if tt.flairID != uuid.Nil {
+				if _, err := db.NewRaw("INSERT INTO post_post_flairs (post_id, post_flair_id) VALUES (?, ?)", postID, tt.flairID).Exec(context.Background()); err != nil {
+					t.Fatal("failed to flair post:", err)
+				}
+			}
+
+			gotErr := pgrepo.ApplyVerdict(context.Background(), tt.verdict)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr != nil {
+				return
+			}
+
+			table := "posts"
+			if tt.verdict.TargetType == models.ReportTargetComment {
+				table = "comments"
+			}
+			var gotRemoved bool
+			if err := db.NewRaw(fmt.Sprintf("SELECT removed_at IS NOT NULL FROM %s WHERE id = ?", table), tt.verdict.TargetID).Scan(context.Background(), &gotRemoved); err != nil {
+				t.Fatal("failed to fetch target:", err)
+			}
+			assert.Equal(t, tt.wantRemoved, gotRemoved, "expect removal to match")
+
+			gotModLogs, err := db.NewSelect().Model((*models.ModLog)(nil)).Where("moderator_id = ?", models.AutoModeratorID).Count(context.Background())
+			assert.NoError(t, err, "expect mod logs to be counted")
+			assert.Equal(t, tt.wantModLogs, gotModLogs, "expect mod logs by automod to match")
+
+			gotReports, err := db.NewSelect().Model((*models.Report)(nil)).Where("reporter_id IS NULL AND reason = ?", tt.verdict.Details).Count(context.Background())
+			assert.NoError(t, err, "expect reports to be counted")
+			assert.Equal(t, tt.wantReports, gotReports, "expect reports by automod to match")
+
+			gotFlairs, err := db.NewSelect().Model((*models.PostPostFlair)(nil)).Where("post_id = ?", postID).Count(context.Background())
+			assert.NoError(t, err, "expect flairs to be counted")
+			assert.Equal(t, tt.wantFlairs, gotFlairs, "expect post flairs to match")
+			if tt.wantFlair != uuid.Nil {
+				var gotFlair uuid.UUID
+				if err := db.NewRaw("SELECT post_flair_id FROM post_post_flairs WHERE post_id = ?", postID).Scan(context.Background(), &gotFlair); err != nil {
+					t.Fatal("failed to fetch post flair:", err)
+				}
+				assert.Equal(t, tt.wantFlair, gotFlair, "expect post flair to match")
+			}
+
+			gotReplies, err := db.NewSelect().Model((*models.Comment)(nil)).Where("author_id = ?", models.AutoModeratorID).Count(context.Background())
+			assert.NoError(t, err, "expect replies to be counted")
+			assert.Equal(t, tt.wantReplies, gotReplies, "expect replies by automod to match")
+		})
+	}
+}
+
+func TestRepo_ApplyVerdictOnEdits(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml", "post_flairs.yml"}
+
+	voxsphereID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	commentID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	db := setupPostgres(t, fixtureFiles...)
+	pgrepo := automodrepo.NewRepo(db)
+
+	// the verdict is applied on creation and again on each of two edits,
+	// every time with a reply of its own ID as automod builds them
+	for range 3 {
+		verdict := models.AutomodVerdict{
+			VoxsphereID: voxsphereID,
+			TargetType:  models.ReportTargetComment,
+			TargetID:    commentID,
+			Filter:      true,
+			Details:     "automod: new accounts",
+			Replies: []models.Comment{
+				{
+					ID:              uuid.New(),
+					AuthorID:        models.AutoModeratorID,
+					ParentCommentID: commentID,
+					PostID:          postID,
+					Body:            "read the rules",
+					BodyHtml:        "<p>read the rules</p>",
+				},
+			},
+		}
+		if err := pgrepo.ApplyVerdict(context.Background(), verdict); err != nil {
+			t.Fatal("failed to apply verdict:", err)
+		}
+	}
+
+	gotReports, err := db.NewSelect().Model((*models.Report)(nil)).Where("reporter_id IS NULL AND comment_id = ?", commentID).Count(context.Background())
+	assert.NoError(t, err, "expect reports to be counted")
+	assert.Equal(t, 1, gotReports, "expect the target to be reported once")
+
+	gotReplies, err := db.NewSelect().Model((*models.Comment)(nil)).Where("author_id = ?", models.AutoModeratorID).Count(context.Background())
+	assert.NoError(t, err, "expect replies to be counted")
+	assert.Equal(t, 1, gotReplies, "expect automod to reply once")
+}
@@ -13,12 +13,20 @@ import (
 )
 
 const (
-	pgUniqueViolation = "23505"
+	pgUniqueViolation     = "23505"
+	pgConstraintViolation = "23503"
 )
 
+// welcomeCoins is the balance a user starts with on their first use of
+// coins.
+const welcomeCoins = 500
+
 var (
-	ErrAwardNotFound           = errors.New("award not found")
-	ErrAwardDuplicateIDorTitle = errors.New("award duplicate id or title")
+	ErrAwardNotFound               = errors.New("award not found")
+	ErrAwardDuplicateIDorTitle     = errors.New("award duplicate id or title")
+	ErrAwardGrantTargetNotFound    = errors.New("award grant post or comment not found")
+	ErrAwardGrantKeyReused         = errors.New("award grant idempotency key reused for another grant")
+	ErrAwardGrantInsufficientCoins = errors.New("award grant insufficient coins")
 )
 
 type AwardRepository interface {
@@ -27,6 +35,8 @@ type AwardRepository interface {
 	AddAwards(context.Context, ...models.Award) ([]models.Award, error)
 	UpdateAward(context.Context, models.Award) (models.Award, error)
 	DeleteAward(context.Context, uuid.UUID) error
+	GrantAward(context.Context, models.AwardGrant) (models.AwardGrant, error)
+	CoinWallet(context.Context, uuid.UUID, int) (models.CoinWallet, error)
 }
 
 type Repo struct {
@@ -44,7 +54,8 @@ func (r *Repo) Awards(ctx context.Context) ([]models.Award, error) {
                 SELECT
                     id,
                     title,
-                    image_link
+                    image_link,
+                    price
                 FROM
                     awards;
             `
@@ -63,7 +74,8 @@ func (r *Repo) AwardByID(ctx context.Context, ID uuid.UUID) (models.Award, error
                 SELECT
                     id,
                     title,
-                    image_link
+                    image_link,
+                    price
                 FROM
                     awards
                 WHERE
@@ -85,7 +97,8 @@ func (r *Repo) AddAwards(ctx context.Context, awards ...models.Award) ([]models.
             awards (
                 id,
                 title,
-                image_link
+                image_link,
+                price
             )
         VALUES 
     `
@@ -93,8 +106,8 @@ func (r *Repo) AddAwards(ctx context.Context, awards ...models.Award) ([]models.
 	args := make([]interface{}, 0)
 	placeholders := make([]string, 0)
 	for _, award := range awards {
-		placeholders = append(placeholders, "(?, ?, ?)")
-		args = append(args, award.ID, award.Title, award.ImageLink)
+		placeholders = append(placeholders, "(?, ?, ?, ?)")
+		args = append(args, award.ID, award.Title, award.ImageLink, award.Price)
 	}
 	query += strings.Join(placeholders, ", ") + " RETURNING *"
 
@@ -115,13 +128,14 @@ func (r *Repo) UpdateAward(ctx context.Context, award models.Award) (models.Awar
                     awards
                 SET
                     title = ?,
-                    image_link = ?
+                    image_link = ?,
+                    price = ?
                 WHERE
                     id = ?
                 RETURNING *
             `
 
-	res, err := r.db.NewRaw(query, award.Title, award.ImageLink, award.ID).Exec(ctx, &award)
+	res, err := r.db.NewRaw(query, award.Title, award.ImageLink, award.Price, award.ID).Exec(ctx, &award)
 	if errors.Is(err, sql.ErrNoRows) {
 		return models.Award{}, ErrAwardNotFound
 	}
@@ -158,3 +172,236 @@ func (r *Repo) DeleteAward(ctx context.Context, ID uuid.UUID) error {
 	}
 	return nil
 }
+
+// GrantAward gives the award of grant to its post or comment and charges the
+// giver the price of the award. A grant with an idempotency key the giver
+// already used returns the grant made with it, unless it was for another
+// award or target.
+func (r *Repo) GrantAward(ctx context.Context, grant models.AwardGrant) (models.AwardGrant, error) {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		query := `
+                SELECT
+                    price
+                FROM
+                    awards
+                WHERE
+                    id = ?;
+            `
+		if err := tx.NewRaw(query, grant.AwardID).Scan(ctx, &grant.Price); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrAwardNotFound
+			}
+			return err
+		}
+
+		query = `
+                INSERT INTO
+                    award_grants (
+                        id,
+                        award_id,
+                        giver_id,
+                        post_id,
+                        comment_id,
+                        price,
+                        idempotency_key
+                    )
+                VALUES
+                    (?, ?, ?, ?, ?, ?, ?)
+                ON CONFLICT (giver_id, idempotency_key) DO NOTHING
+                RETURNING *;
+            `
+		var granted []models.AwardGrant
+		_, err := tx.NewRaw(
+			query,
+			grant.ID,
+			grant.AwardID,
+			grant.GiverID,
+			bun.NullZero(grant.PostID),
+			bun.NullZero(grant.CommentID),
+			grant.Price,
+			grant.IdempotencyKey,
+		).Exec(ctx, &granted)
+		if err != nil {
+			var pgdriverErr pgdriver.Error
+			if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+				return ErrAwardGrantTargetNotFound
+			}
+			return err
+		}
+		if len(granted) == 0 {
+			// the key was used before, so this is either a retry or a mistake
+			var err error
+			grant, err = replayedAwardGrant(ctx, tx, grant)
+			return err
+		}
+		grant = granted[0]
+
+		if err := ensureCoinBalance(ctx, tx, grant.GiverID); err != nil {
+			return err
+		}
+
+		query = `
+                UPDATE
+                    coin_balances
+                SET
+                    balance = balance - ?
+                WHERE
+                    user_id = ?
+                    AND balance >= ?;
+            `
+		res, err := tx.NewRaw(query, grant.Price, grant.GiverID, grant.Price).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrAwardGrantInsufficientCoins
+		}
+
+		return addCoinTransaction(ctx, tx, models.CoinTransaction{
+			ID:           uuid.New(),
+			UserID:       grant.GiverID,
+			Amount:       -int64(grant.Price),
+			Reason:       models.CoinTransactionReasonAwardGrant,
+			AwardGrantID: grant.ID,
+		})
+	})
+	if err != nil {
+		return models.AwardGrant{}, err
+	}
+	return grant, nil
+}
+
+// replayedAwardGrant returns the grant the giver of grant already made with
+// its idempotency key, which must be for the same award and target.
+func replayedAwardGrant(ctx context.Context, db bun.IDB, grant models.AwardGrant) (models.AwardGrant, error) {
+	var replayed models.AwardGrant
+
+	query := `
+                SELECT
+                    *
+                FROM
+                    award_grants
+                WHERE
+                    giver_id = ?
+                    AND idempotency_key = ?;
+            `
+	if _, err := db.NewRaw(query, grant.GiverID, grant.IdempotencyKey).Exec(ctx, &replayed); err != nil {
+		return models.AwardGrant{}, err
+	}
+	if replayed.AwardID != grant.AwardID || replayed.PostID != grant.PostID || replayed.CommentID != grant.CommentID {
+		return models.AwardGrant{}, ErrAwardGrantKeyReused
+	}
+	return replayed, nil
+}
+
+// ensureCoinBalance opens the coin balance of the user with the welcome
+// coins, unless the user already has one.
+func ensureCoinBalance(ctx context.Context, db bun.IDB, userID uuid.UUID) error {
+	query := `
+                INSERT INTO
+                    coin_balances (
+                        user_id,
+                        balance
+                    )
+                VALUES
+                    (?, ?)
+                ON CONFLICT (user_id) DO NOTHING;
+            `
+	res, err := db.NewRaw(query, userID, welcomeCoins).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return nil
+	}
+
+	return addCoinTransaction(ctx, db, models.CoinTransaction{
+		ID:     uuid.New(),
+		UserID: userID,
+		Amount: welcomeCoins,
+		Reason: models.CoinTransactionReasonWelcome,
+	})
+}
+
+func addCoinTransaction(ctx context.Context, db bun.IDB, transaction models.CoinTransaction) error {
+	query := `
+                INSERT INTO
+                    coin_transactions (
+                        id,
+                        user_id,
+                        amount,
+                        reason,
+                        award_grant_id
+                    )
+                VALUES
+                    (?, ?, ?, ?, ?);
+            `
+	_, err := db.NewRaw(
+		query,
+		transaction.ID,
+		transaction.UserID,
+		transaction.Amount,
+		transaction.Reason,
+		bun.NullZero(transaction.AwardGrantID),
+	).Exec(ctx)
+	return err
+}
+
+// CoinWallet returns the coin balance of the user along with their latest
+// limit transactions, newest first.
+func (r *Repo) CoinWallet(ctx context.Context, userID uuid.UUID, limit int) (models.CoinWallet, error) {
+	wallet := models.CoinWallet{
+		Transactions: []models.CoinTransaction{},
+	}
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := ensureCoinBalance(ctx, tx, userID); err != nil {
+			return err
+		}
+
+		query := `
+                SELECT
+                    balance
+                FROM
+                    coin_balances
+                WHERE
+                    user_id = ?;
+            `
+		if err := tx.NewRaw(query, userID).Scan(ctx, &wallet.Balance); err != nil {
+			return err
+		}
+
+		query = `
+                SELECT
+                    id,
+                    user_id,
+                    amount,
+                    reason,
+                    award_grant_id,
+                    created_at
+                FROM
+                    coin_transactions
+                WHERE
+                    user_id = ?
+                ORDER BY
+                    created_at DESC,
+                    id DESC
+                LIMIT
+                    ?;
+            `
+		_, err := tx.NewRaw(query, userID, limit).Exec(ctx, &wallet.Transactions)
+		return err
+	})
+	if err != nil {
+		return models.CoinWallet{}, err
+	}
+	return wallet, nil
+}
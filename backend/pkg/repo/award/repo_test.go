@@ -7,6 +7,7 @@ import (
 	"os"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
 	awardrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/award"
@@ -41,13 +42,34 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	// add query logging hook
 	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
 
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
 	db.RegisterModel((*models.Award)(nil))
+	db.RegisterModel((*models.AwardGrant)(nil))
+	db.RegisterModel((*models.CoinBalance)(nil))
+	db.RegisterModel((*models.CoinTransaction)(nil))
 
-	// drop all rows of the award table
+	// drop all rows of the award,topics,users,coin_transactions tables
 	_, err := db.NewTruncateTable().Cascade().Model((*models.Award)(nil)).Exec(context.Background())
 	if err != nil {
 		t.Fatal("truncate table failed:", err)
 	}
+	_, err = db.NewTruncateTable().Cascade().Model((*models.Topic)(nil)).Exec(context.Background())
+	if err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
+	_, err = db.NewTruncateTable().Cascade().Model((*models.User)(nil)).Exec(context.Background())
+	if err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
+	// the ledger has no foreign keys, so it is not truncated along with users
+	_, err = db.NewTruncateTable().Model((*models.CoinTransaction)(nil)).Exec(context.Background())
+	if err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
 
 	// load fixture
 	fixture := dbfixture.New(db)
@@ -93,11 +115,13 @@ func TestRepo_Awards(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Title:     "award_foo",
 					ImageLink: "https:/fooimage.com",
+					Price:     100,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					Title:     "award_bar",
 					ImageLink: "https:/barimage.com",
+					Price:     500,
 				},
 			},
 			wantErr: nil,
@@ -152,6 +176,7 @@ func TestRepo_AwardByID(t *testing.T) {
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Title:     "award_foo",
 				ImageLink: "https:/fooimage.com",
+				Price:     100,
 			},
 			wantErr: nil,
 		},
@@ -190,6 +215,7 @@ func TestRepo_AddAwards(t *testing.T) {
 						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 						Title:     "new title",
 						ImageLink: "new image link",
+						Price:     200,
 					},
 				},
 			},
@@ -199,11 +225,13 @@ func TestRepo_AddAwards(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Title:     "award_foo",
 					ImageLink: "https:/fooimage.com",
+					Price:     100,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					Title:     "award_bar",
 					ImageLink: "https:/barimage.com",
+					Price:     500,
 				},
 			},
 			wantErr: awardrepo.ErrAwardDuplicateIDorTitle,
@@ -217,11 +245,13 @@ func TestRepo_AddAwards(t *testing.T) {
 						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 						Title:     "new title1",
 						ImageLink: "new image link1",
+						Price:     200,
 					},
 					{
 						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 						Title:     "new title2",
 						ImageLink: "new image link2",
+						Price:     300,
 					},
 				},
 			},
@@ -230,11 +260,13 @@ func TestRepo_AddAwards(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 					Title:     "new title1",
 					ImageLink: "new image link1",
+					Price:     200,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 					Title:     "new title2",
 					ImageLink: "new image link2",
+					Price:     300,
 				},
 			},
 			wantAwards: []models.Award{
@@ -242,21 +274,25 @@ func TestRepo_AddAwards(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Title:     "award_foo",
 					ImageLink: "https:/fooimage.com",
+					Price:     100,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					Title:     "award_bar",
 					ImageLink: "https:/barimage.com",
+					Price:     500,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 					Title:     "new title1",
 					ImageLink: "new image link1",
+					Price:     200,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 					Title:     "new title2",
 					ImageLink: "new image link2",
+					Price:     300,
 				},
 			},
 			wantErr: nil,
@@ -300,6 +336,7 @@ func TestRepo_UpdateAward(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000006"),
 					Title:     "updated title",
 					ImageLink: "updated image link",
+					Price:     250,
 				},
 			},
 			wantAward: models.Award{},
@@ -308,11 +345,13 @@ func TestRepo_UpdateAward(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Title:     "award_foo",
 					ImageLink: "https:/fooimage.com",
+					Price:     100,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					Title:     "award_bar",
 					ImageLink: "https:/barimage.com",
+					Price:     500,
 				},
 			},
 			wantErr: awardrepo.ErrAwardNotFound,
@@ -325,23 +364,27 @@ func TestRepo_UpdateAward(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Title:     "updated title",
 					ImageLink: "updated image link",
+					Price:     250,
 				},
 			},
 			wantAward: models.Award{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Title:     "updated title",
 				ImageLink: "updated image link",
+				Price:     250,
 			},
 			wantAwards: []models.Award{
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Title:     "updated title",
 					ImageLink: "updated image link",
+					Price:     250,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					Title:     "award_bar",
 					ImageLink: "https:/barimage.com",
+					Price:     500,
 				},
 			},
 			wantErr: nil,
@@ -387,11 +430,13 @@ func TestRepo_DeleteAward(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Title:     "award_foo",
 					ImageLink: "https:/fooimage.com",
+					Price:     100,
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					Title:     "award_bar",
 					ImageLink: "https:/barimage.com",
+					Price:     500,
 				},
 			},
 			wantErr: awardrepo.ErrAwardNotFound,
@@ -407,6 +452,7 @@ func TestRepo_DeleteAward(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					Title:     "award_bar",
 					ImageLink: "https:/barimage.com",
+					Price:     500,
 				},
 			},
 			wantErr: nil,
@@ -428,3 +474,257 @@ func TestRepo_DeleteAward(t *testing.T) {
 		})
 	}
 }
+
+func TestRepo_GrantAward(t *testing.T) {
+	fixtureFiles := []string{
+		"topics.yml",
+		"voxspheres.yml",
+		"users.yml",
+		"posts.yml",
+		"comments.yml",
+		"awards.yml",
+		"award_grants.yml",
+		"coin_balances.yml",
+		"coin_transactions.yml",
+	}
+
+	tests := []struct {
+		name        string
+		grant       models.AwardGrant
+		wantGrant   models.AwardGrant
+		wantBalance int64
+		wantErr     error
+	}{
+		{
+			name: "award not found :NEG",
+			grant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				IdempotencyKey: "key2",
+			},
+			wantBalance: 400,
+			wantErr:     awardrepo.ErrAwardNotFound,
+		},
+		{
+			name: "post not found :NEG",
+			grant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:         uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+				IdempotencyKey: "key2",
+			},
+			wantBalance: 400,
+			wantErr:     awardrepo.ErrAwardGrantTargetNotFound,
+		},
+		{
+			name: "insufficient coins :NEG",
+			grant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				IdempotencyKey: "key2",
+			},
+			wantBalance: 400,
+			wantErr:     awardrepo.ErrAwardGrantInsufficientCoins,
+		},
+		{
+			name: "key reused for another target :NEG",
+			grant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CommentID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				IdempotencyKey: "key1",
+			},
+			wantBalance: 400,
+			wantErr:     awardrepo.ErrAwardGrantKeyReused,
+		},
+		{
+			name: "retried grant :POS",
+			grant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				IdempotencyKey: "key1",
+			},
+			wantGrant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Price:          100,
+				IdempotencyKey: "key1",
+			},
+			wantBalance: 400,
+		},
+		{
+			name: "comment grant :POS",
+			grant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CommentID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				IdempotencyKey: "key2",
+			},
+			wantGrant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CommentID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Price:          100,
+				IdempotencyKey: "key2",
+			},
+			wantBalance: 300,
+		},
+		{
+			name: "first grant of a user gets welcome coins :POS",
+			grant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				PostID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				IdempotencyKey: "key1",
+			},
+			wantGrant: models.AwardGrant{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				GiverID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				PostID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Price:          100,
+				IdempotencyKey: "key1",
+			},
+			wantBalance: 400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := awardrepo.NewRepo(db)
+
+			gotGrant, gotErr := pgrepo.GrantAward(context.Background(), tt.grant)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			gotGrant.CreatedAt = time.Time{}
+			assert.Equal(t, tt.wantGrant, gotGrant, "expect grant to match")
+
+			gotWallet, err := pgrepo.CoinWallet(context.Background(), tt.grant.GiverID, 10)
+
+			assert.NoError(t, err, "expect no error while getting wallet")
+			assert.Equal(t, tt.wantBalance, gotWallet.Balance, "expect balance to match")
+
+			var ledger int64
+			for _, transaction := range gotWallet.Transactions {
+				ledger += transaction.Amount
+			}
+			assert.Equal(t, gotWallet.Balance, ledger, "expect balance to match the ledger")
+		})
+	}
+}
+
+func TestRepo_CoinTransactionsAppendOnly(t *testing.T) {
+	transactionID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name  string
+		query string
+		args  []interface{}
+	}{
+		{
+			name:  "update transaction :NEG",
+			query: "UPDATE coin_transactions SET amount = 1000 WHERE id = ?",
+			args:  []interface{}{transactionID},
+		},
+		{
+			name:  "delete transaction :NEG",
+			query: "DELETE FROM coin_transactions WHERE id = ?",
+			args:  []interface{}{transactionID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "awards.yml", "award_grants.yml", "coin_balances.yml", "coin_transactions.yml")
+
+			_, gotErr := db.NewRaw(tt.query, tt.args...).Exec(context.Background())
+			assert.ErrorContains(t, gotErr, "coin transactions are append-only", "expect ledger change to be rejected")
+
+			var gotAmount int64
+			err := db.NewRaw("SELECT amount FROM coin_transactions WHERE id = ?", transactionID).Scan(context.Background(), &gotAmount)
+			assert.NoError(t, err, "expect transaction to be kept")
+			assert.Equal(t, int64(500), gotAmount, "expect amount to be unchanged")
+		})
+	}
+
+	t.Run("delete user :POS", func(t *testing.T) {
+		db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "awards.yml", "award_grants.yml", "coin_balances.yml", "coin_transactions.yml")
+
+		_, err := db.NewRaw("DELETE FROM users WHERE id = ?", userID).Exec(context.Background())
+		assert.NoError(t, err, "expect user to be deleted")
+
+		gotCount, err := db.NewSelect().Model((*models.CoinTransaction)(nil)).Where("user_id = ?", userID).Count(context.Background())
+		assert.NoError(t, err, "expect transactions to be counted")
+		assert.Equal(t, 2, gotCount, "expect the ledger of a deleted user to be kept")
+	})
+}
+
+func TestRepo_CoinWallet(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		limit       int
+		wantBalance int64
+		wantReasons []models.CoinTransactionReason
+		wantErr     error
+	}{
+		{
+			name:        "wallet :POS",
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			limit:       10,
+			wantBalance: 400,
+			wantReasons: []models.CoinTransactionReason{
+				models.CoinTransactionReasonAwardGrant,
+				models.CoinTransactionReasonWelcome,
+			},
+		},
+		{
+			name:        "limited transactions :POS",
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			limit:       1,
+			wantBalance: 400,
+			wantReasons: []models.CoinTransactionReason{
+				models.CoinTransactionReasonAwardGrant,
+			},
+		},
+		{
+			name:        "new wallet :POS",
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			limit:       10,
+			wantBalance: 500,
+			wantReasons: []models.CoinTransactionReason{
+				models.CoinTransactionReasonWelcome,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "awards.yml", "award_grants.yml", "coin_balances.yml", "coin_transactions.yml")
+			pgrepo := awardrepo.NewRepo(db)
+
+			gotWallet, gotErr := pgrepo.CoinWallet(context.Background(), tt.userID, tt.limit)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantBalance, gotWallet.Balance, "expect balance to match")
+
+			gotReasons := make([]models.CoinTransactionReason, 0, len(gotWallet.Transactions))
+			for _, transaction := range gotWallet.Transactions {
+				gotReasons = append(gotReasons, transaction.Reason)
+			}
+			assert.Equal(t, tt.wantReasons, gotReasons, "expect transactions to match")
+		})
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/glowfi/voxpopuli/backend/internal/flairtext"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	automodrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/automod"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/driver/pgdriver"
@@ -22,14 +24,63 @@ var (
 	ErrCommentNotFound                  = errors.New("comment not found")
 	ErrCommentDuplicateID               = errors.New("comment duplicate id")
 	ErrCommentParentTableRecordNotFound = errors.New("record does not exist in the parent table")
+	ErrCommentPostNotFound              = errors.New("post not found")
+	ErrCommentAuthorNotFound            = errors.New("author not found")
 )
 
+// authorFlairColumn picks the user flair the author of the comment aliased as
+// c wears in the voxsphere of its post aliased as p, with its descriptions,
+// emojis and custom emojis merged by order index. Deleted comments show no
+// flair.
+var authorFlairColumn = `
+            (
+                SELECT
+                    JSON_BUILD_OBJECT(
+                        'id',
+                        uf.id,
+                        'voxsphere_id',
+                        uf.voxsphere_id,
+                        'voxsphere',
+                        (SELECT v.title FROM voxspheres v WHERE v.id = uf.voxsphere_id),
+                        'full_text',
+                        uf.full_text,
+                        'background_color',
+                        COALESCE(uf.background_color, ''),
+                        'richtext',
+                        ` + flairtext.UserQuery + `
+                    )
+                FROM
+                    user_flairs uf
+                    JOIN user_user_flairs uuf ON uuf.user_flair_id = uf.id
+                WHERE
+                    c.deleted_at IS NULL
+                    AND uuf.user_id = c.author_id
+                    AND uf.voxsphere_id = p.voxsphere_id
+                ORDER BY
+                    uf.id
+                LIMIT
+                    1
+            ) AS author_flair`
+
+// DeletedComment stands in for the body and author of a deleted comment.
+const DeletedComment = "[deleted]"
+
+// RemovedComment stands in for the body of a comment removed by a moderator.
+const RemovedComment = "[removed]"
+
 type CommentsRepository interface {
 	Comments(context.Context) ([]models.Comment, error)
 	CommentByID(context.Context, uuid.UUID) (models.Comment, error)
+	CommentsByPostID(context.Context, uuid.UUID) ([]models.CommentAuthor, error)
+	CommentTree(context.Context, uuid.UUID, uuid.UUID, int, int) ([]models.CommentNode, error)
+	CommentsByAuthorName(context.Context, string, int, int) ([]models.UserComment, error)
+	CommentsByIDs(context.Context, []uuid.UUID) ([]models.UserComment, error)
 	AddComments(context.Context, ...models.Comment) ([]models.Comment, error)
+	AddComment(context.Context, models.Comment, *models.AutomodVerdict) (models.Comment, error)
 	UpdateComment(context.Context, models.Comment) (models.Comment, error)
+	EditComment(context.Context, uuid.UUID, string, string, *models.AutomodVerdict) (models.Comment, error)
 	DeleteComment(context.Context, uuid.UUID) error
+	SoftDeleteComment(context.Context, uuid.UUID) error
 }
 
 type Repo struct {
@@ -99,7 +150,385 @@ func (r *Repo) CommentByID(ctx context.Context, ID uuid.UUID) (models.Comment, e
 	return comment, nil
 }
 
+// CommentsByPostID returns the comments of the post of postID. Deleted
+// comments keep their place in the thread with their author hidden, and
+// removed comments keep theirs with their body hidden.
+func (r *Repo) CommentsByPostID(ctx context.Context, postID uuid.UUID) ([]models.CommentAuthor, error) {
+	var postExists bool
+	if err := r.db.NewRaw(`SELECT EXISTS (SELECT 1 FROM posts p WHERE p.id = ?)`, postID).Scan(ctx, &postExists); err != nil {
+		return []models.CommentAuthor{}, err
+	}
+	if !postExists {
+		return []models.CommentAuthor{}, ErrCommentPostNotFound
+	}
+
+	comments := []models.CommentAuthor{}
+	if _, err := r.db.NewRaw(commentAuthorsQuery("c.post_id = ?1"), DeletedComment, postID, RemovedComment, "<p>"+RemovedComment+"</p>").Exec(ctx, &comments); err != nil {
+		return []models.CommentAuthor{}, err
+	}
+	for i := range comments {
+		renderAuthorFlairEmojis(comments[i].AuthorFlair)
+	}
+	return comments, nil
+}
+
+// commentAuthorsQuery selects the comments aliased as c matching where, which
+// takes its argument as ?1, with their author and author flair, best first.
+// Deleted comments keep their place in the thread with their author hidden,
+// and removed comments keep theirs with their body hidden.
+func commentAuthorsQuery(where string) string {
+	return `
+        SELECT
+            c.id,
+            CASE WHEN c.deleted_at IS NULL THEN c.author_id END AS author_id,
+            CASE WHEN c.deleted_at IS NULL THEN u.name ELSE ?0 END AS author,
+            c.parent_comment_id,
+            c.post_id,
+            CASE WHEN c.removed_at IS NULL THEN c.body ELSE ?2 END AS body,
+            CASE WHEN c.removed_at IS NULL THEN c.body_html ELSE ?3 END AS body_html,
+            c.ups,
+            c.score,
+            c.created_at,
+            c.created_at_unix,
+            c.updated_at,
+            ` + authorFlairColumn + `
+        FROM
+            comments c
+            JOIN users u ON u.id = c.author_id
+            JOIN posts p ON p.id = c.post_id
+        WHERE
+            ` + where + `
+        ORDER BY
+            c.score DESC,
+            c.created_at ASC,
+            c.id ASC;
+    `
+}
+
+// commentTreeRoot picks the comments aliased as c hanging from the root of a
+// comment tree: the replies to the comment of parentID, given as ?1, or the
+// top level comments of the post when parentID is uuid.Nil. Comments whose
+// parent is missing from the post, or is the comment itself, are top level
+// comments, as the scraper does not always store the whole chain.
+func commentTreeRoot(parentID uuid.UUID) string {
+	if parentID != uuid.Nil {
+		return "c.parent_comment_id = ?1 AND c.id <> ?1"
+	}
+	return `(
+                            c.parent_comment_id IS NULL
+                            OR c.parent_comment_id = c.id
+                            OR NOT EXISTS (
+                                SELECT
+                                    1
+                                FROM
+                                    comments pc
+                                WHERE
+                                    pc.id = c.parent_comment_id
+                                    AND pc.post_id = c.post_id
+                            )
+                        )`
+}
+
+// CommentTree walks the comments of the post of postID down from the replies
+// to the comment of parentID, or from the top level comments of the post when
+// parentID is uuid.Nil, the replies of each comment best first. The walk
+// carries the depth of every comment and stops depth levels down, showing at
+// most limit replies of each comment. The replies cut off by either bound are
+// returned unshown with the number of their descendants, without loading
+// them or anything under them. A cycle of parent comments is walked once.
+func (r *Repo) CommentTree(ctx context.Context, postID, parentID uuid.UUID, depth, limit int) ([]models.CommentNode, error) {
+	var postExists bool
+	if err := r.db.NewRaw(`SELECT EXISTS (SELECT 1 FROM posts p WHERE p.id = ?)`, postID).Scan(ctx, &postExists); err != nil {
+		return []models.CommentNode{}, err
+	}
+	if !postExists {
+		return []models.CommentNode{}, ErrCommentPostNotFound
+	}
+	if parentID != uuid.Nil {
+		var parentExists bool
+		if err := r.db.NewRaw(`SELECT EXISTS (SELECT 1 FROM comments c WHERE c.id = ? AND c.post_id = ?)`, parentID, postID).Scan(ctx, &parentExists); err != nil {
+			return []models.CommentNode{}, err
+		}
+		if !parentExists {
+			return []models.CommentNode{}, ErrCommentNotFound
+		}
+	}
+
+	nodes := []models.CommentNode{}
+
+	query := `
+        WITH RECURSIVE
+            tree AS (
+                SELECT
+                    r.id,
+                    0 AS depth,
+                    r.rank <= ?3 AND 0 < ?2 AS shown,
+                    ARRAY[?1::UUID, r.id] AS path
+                FROM
+                    (
+                        SELECT
+                            c.id,
+                            ROW_NUMBER() OVER (
+                                ORDER BY
+                                    c.score DESC,
+                                    c.created_at ASC,
+                                    c.id ASC
+                            ) AS rank
+                        FROM
+                            comments c
+                        WHERE
+                            c.post_id = ?0
+                            AND ` + commentTreeRoot(parentID) + `
+                    ) r
+                UNION ALL
+                SELECT
+                    r.id,
+                    t.depth + 1,
+                    r.rank <= ?3 AND t.depth + 1 < ?2,
+                    t.path || r.id
+                FROM
+                    tree t
+                    CROSS JOIN LATERAL (
+                        SELECT
+                            c.id,
+                            ROW_NUMBER() OVER (
+                                ORDER BY
+                                    c.score DESC,
+                                    c.created_at ASC,
+                                    c.id ASC
+                            ) AS rank
+                        FROM
+                            comments c
+                        WHERE
+                            c.parent_comment_id = t.id
+                            AND c.post_id = ?0
+                            AND c.id <> ALL (t.path)
+                    ) r
+                WHERE
+                    t.shown
+            ),
+            hidden AS (
+                SELECT
+                    t.id AS root_id,
+                    c.id,
+                    t.path || c.id AS path
+                FROM
+                    tree t
+                    JOIN comments c ON c.parent_comment_id = t.id
+                WHERE
+                    NOT t.shown
+                    AND c.post_id = ?0
+                    AND c.id <> ALL (t.path)
+                UNION ALL
+                SELECT
+                    h.root_id,
+                    c.id,
+                    h.path || c.id
+                FROM
+                    hidden h
+                    JOIN comments c ON c.parent_comment_id = h.id
+                WHERE
+                    c.post_id = ?0
+                    AND c.id <> ALL (h.path)
+            )
+        SELECT
+            t.id,
+            c.parent_comment_id,
+            t.depth,
+            t.shown,
+            COALESCE(d.descendants, 0) AS descendants
+        FROM
+            tree t
+            JOIN comments c ON c.id = t.id
+            LEFT JOIN (
+                SELECT
+                    h.root_id,
+                    count(*) AS descendants
+                FROM
+                    hidden h
+                GROUP BY
+                    h.root_id
+            ) d ON d.root_id = t.id
+        ORDER BY
+            t.depth,
+            c.score DESC,
+            c.created_at ASC,
+            c.id ASC;
+    `
+
+	if _, err := r.db.NewRaw(query, postID, parentID, depth, limit).Exec(ctx, &nodes); err != nil {
+		return []models.CommentNode{}, err
+	}
+
+	shownIDs := make([]uuid.UUID, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Shown {
+			shownIDs = append(shownIDs, node.ID)
+		}
+	}
+	if len(shownIDs) == 0 {
+		return nodes, nil
+	}
+
+	comments := []models.CommentAuthor{}
+	if _, err := r.db.NewRaw(commentAuthorsQuery("c.id IN (?1)"), DeletedComment, bun.In(shownIDs), RemovedComment, "<p>"+RemovedComment+"</p>").Exec(ctx, &comments); err != nil {
+		return []models.CommentNode{}, err
+	}
+	byID := make(map[uuid.UUID]models.CommentAuthor, len(comments))
+	for _, comment := range comments {
+		renderAuthorFlairEmojis(comment.AuthorFlair)
+		byID[comment.ID] = comment
+	}
+	for i, node := range nodes {
+		if node.Shown {
+			nodes[i].CommentAuthor = byID[node.ID]
+		}
+	}
+	return nodes, nil
+}
+
+// CommentsByAuthorName returns the comments of the user named name, newest
+// first, along with the post and voxsphere they were made in. Deleted and
+// removed comments are left out.
+func (r *Repo) CommentsByAuthorName(ctx context.Context, name string, skip, limit int) ([]models.UserComment, error) {
+	var authorExists bool
+	if err := r.db.NewRaw(`SELECT EXISTS (SELECT 1 FROM users u WHERE u.name = ?)`, name).Scan(ctx, &authorExists); err != nil {
+		return []models.UserComment{}, err
+	}
+	if !authorExists {
+		return []models.UserComment{}, ErrCommentAuthorNotFound
+	}
+
+	comments := []models.UserComment{}
+
+	query := `
+        SELECT
+            c.id,
+            c.author_id,
+            u.name AS author,
+            c.parent_comment_id,
+            c.post_id,
+            c.body,
+            c.body_html,
+            c.ups,
+            c.score,
+            c.created_at,
+            c.created_at_unix,
+            c.updated_at,
+            p.title AS post_title,
+            v.id AS voxsphere_id,
+            v.title AS voxsphere,
+            ` + authorFlairColumn + `
+        FROM
+            comments c
+            JOIN users u ON u.id = c.author_id
+            JOIN posts p ON p.id = c.post_id
+            JOIN voxspheres v ON v.id = p.voxsphere_id
+        WHERE
+            u.name = ?
+            AND c.deleted_at IS NULL
+            AND c.removed_at IS NULL
+        ORDER BY
+            c.created_at DESC,
+            c.id DESC
+        LIMIT ?
+        OFFSET ?;
+    `
+
+	if _, err := r.db.NewRaw(query, name, limit, skip).Exec(ctx, &comments); err != nil {
+		return []models.UserComment{}, err
+	}
+	for i := range comments {
+		renderAuthorFlairEmojis(comments[i].AuthorFlair)
+	}
+	return comments, nil
+}
+
+// CommentsByIDs returns the comments of IDs, in no particular order, along
+// with the post and voxsphere they were made in. Deleted and removed comments
+// are left out.
+func (r *Repo) CommentsByIDs(ctx context.Context, IDs []uuid.UUID) ([]models.UserComment, error) {
+	comments := []models.UserComment{}
+	if len(IDs) == 0 {
+		return comments, nil
+	}
+
+	query := `
+        SELECT
+            c.id,
+            c.author_id,
+            u.name AS author,
+            c.parent_comment_id,
+            c.post_id,
+            c.body,
+            c.body_html,
+            c.ups,
+            c.score,
+            c.created_at,
+            c.created_at_unix,
+            c.updated_at,
+            p.title AS post_title,
+            v.id AS voxsphere_id,
+            v.title AS voxsphere,
+            ` + authorFlairColumn + `
+        FROM
+            comments c
+            JOIN users u ON u.id = c.author_id
+            JOIN posts p ON p.id = c.post_id
+            JOIN voxspheres v ON v.id = p.voxsphere_id
+        WHERE
+            c.id IN (?)
+            AND c.deleted_at IS NULL
+            AND c.removed_at IS NULL;
+    `
+
+	if _, err := r.db.NewRaw(query, bun.In(IDs)).Exec(ctx, &comments); err != nil {
+		return []models.UserComment{}, err
+	}
+	for i := range comments {
+		renderAuthorFlairEmojis(comments[i].AuthorFlair)
+	}
+	return comments, nil
+}
+
+// renderAuthorFlairEmojis replaces the stored code points of the standard
+// emojis of flair with the emojis themselves.
+func renderAuthorFlairEmojis(authorFlair *models.UserFlairRendered) {
+	if authorFlair != nil {
+		flairtext.RenderEmojis(authorFlair.Richtext)
+	}
+}
+
 func (r *Repo) AddComments(ctx context.Context, comments ...models.Comment) ([]models.Comment, error) {
+	return addComments(ctx, r.db, comments...)
+}
+
+// AddComment adds comment along with the automod verdict on it when there is
+// one, so that a comment is never seen before automod judged it.
+func (r *Repo) AddComment(ctx context.Context, comment models.Comment, verdict *models.AutomodVerdict) (models.Comment, error) {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		comments, err := addComments(ctx, tx, comment)
+		if err != nil {
+			return err
+		}
+		comment = comments[0]
+
+		if verdict == nil {
+			return nil
+		}
+		return automodrepo.ApplyVerdictTx(ctx, tx, *verdict)
+	})
+	if err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+			return models.Comment{}, ErrCommentParentTableRecordNotFound
+		}
+		return models.Comment{}, err
+	}
+	return comment, nil
+}
+
+func addComments(ctx context.Context, db bun.IDB, comments ...models.Comment) ([]models.Comment, error) {
 	query := `
         INSERT INTO
             comments (
@@ -129,7 +558,7 @@ func (r *Repo) AddComments(ctx context.Context, comments ...models.Comment) ([]m
 		args = append(args,
 			comment.ID,
 			comment.AuthorID,
-			comment.ParentCommentID,
+			bun.NullZero(comment.ParentCommentID),
 			comment.PostID,
 			comment.Body,
 			comment.BodyHtml,
@@ -140,9 +569,9 @@ func (r *Repo) AddComments(ctx context.Context, comments ...models.Comment) ([]m
 			comment.UpdatedAt,
 		)
 	}
-	query += strings.Join(placeholders, ", ") + " RETURNING *"
+	query += strings.Join(placeholders, ", ") + " RETURNING id, author_id, parent_comment_id, post_id, body, body_html, ups, score, created_at, created_at_unix, updated_at"
 
-	if _, err := r.db.NewRaw(query, args...).Exec(ctx, &comments); err != nil {
+	if _, err := db.NewRaw(query, args...).Exec(ctx, &comments); err != nil {
 		var pgdriverErr pgdriver.Error
 		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgUniqueViolation {
 			return nil, ErrCommentDuplicateID
@@ -171,14 +600,14 @@ func (r *Repo) UpdateComment(ctx context.Context, comment models.Comment) (model
             updated_at = ?
         WHERE
             id = ?
-        RETURNING *
+        RETURNING id, author_id, parent_comment_id, post_id, body, body_html, ups, score, created_at, created_at_unix, updated_at
     `
 
 	comment.UpdatedAt = time.Now()
 
 	res, err := r.db.NewRaw(query,
 		comment.AuthorID,
-		comment.ParentCommentID,
+		bun.NullZero(comment.ParentCommentID),
 		comment.PostID,
 		comment.Body,
 		comment.BodyHtml,
@@ -207,6 +636,64 @@ func (r *Repo) UpdateComment(ctx context.Context, comment models.Comment) (model
 	return comment, nil
 }
 
+// EditComment replaces the body of the comment of ID along with the automod
+// verdict on the edit when there is one, so that an edit is never seen before
+// automod judged it. Only the body is written, so that votes cast meanwhile
+// are kept. Deleted and removed comments can not be edited and are reported
+// as not found.
+func (r *Repo) EditComment(ctx context.Context, ID uuid.UUID, body, bodyHtml string, verdict *models.AutomodVerdict) (models.Comment, error) {
+	var comment models.Comment
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		if comment, err = editComment(ctx, tx, ID, body, bodyHtml); err != nil {
+			return err
+		}
+
+		if verdict == nil {
+			return nil
+		}
+		return automodrepo.ApplyVerdictTx(ctx, tx, *verdict)
+	})
+	if err != nil {
+		return models.Comment{}, err
+	}
+	return comment, nil
+}
+
+func editComment(ctx context.Context, db bun.IDB, ID uuid.UUID, body, bodyHtml string) (models.Comment, error) {
+	var comment models.Comment
+
+	query := `
+        UPDATE
+            comments
+        SET
+            body = ?,
+            body_html = ?,
+            updated_at = ?
+        WHERE
+            id = ?
+            AND deleted_at IS NULL
+            AND removed_at IS NULL
+        RETURNING id, author_id, parent_comment_id, post_id, body, body_html, ups, score, created_at, created_at_unix, updated_at
+    `
+
+	res, err := db.NewRaw(query, body, bodyHtml, time.Now(), ID).Exec(ctx, &comment)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Comment{}, ErrCommentNotFound
+		}
+		return models.Comment{}, err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return models.Comment{}, err
+	}
+	if rowsAffected == 0 {
+		return models.Comment{}, ErrCommentNotFound
+	}
+	return comment, nil
+}
+
 func (r *Repo) DeleteComment(ctx context.Context, ID uuid.UUID) error {
 	query := `
         DELETE FROM 
@@ -227,3 +714,32 @@ func (r *Repo) DeleteComment(ctx context.Context, ID uuid.UUID) error {
 	}
 	return nil
 }
+
+// SoftDeleteComment marks the comment of ID as deleted and wipes its body. The
+// row is kept so that its replies stay in place in the thread.
+func (r *Repo) SoftDeleteComment(ctx context.Context, ID uuid.UUID) error {
+	query := `
+        UPDATE
+            comments
+        SET
+            body = ?0,
+            body_html = ?1,
+            deleted_at = ?2,
+            updated_at = ?2
+        WHERE
+            id = ?3
+            AND deleted_at IS NULL
+    `
+	res, err := r.db.NewRaw(query, DeletedComment, "<p>"+DeletedComment+"</p>", time.Now(), ID).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrCommentNotFound
+	}
+	return nil
+}
@@ -326,6 +326,504 @@ func TestRepo_CommentByID(t *testing.T) {
 	}
 }
 
+func TestRepo_CommentsByPostID(t *testing.T) {
+	type args struct {
+		postID uuid.UUID
+	}
+
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantComments []models.CommentAuthor
+		wantErr      error
+	}{
+		{
+			name:         "post not found :NEG",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			args: args{
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			wantComments: []models.CommentAuthor{},
+			wantErr:      commentrepo.ErrCommentPostNotFound,
+		},
+		{
+			name:         "post without comments :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml"},
+			args: args{
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantComments: []models.CommentAuthor{},
+			wantErr:      nil,
+		},
+		{
+			name:         "comments of post 1 :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			args: args{
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantComments: []models.CommentAuthor{
+				{
+					Comment: models.Comment{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+						PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Body:            "This is a parent comment 1",
+						BodyHtml:        "<p>This is a parent comment 1</p>",
+						Ups:             1,
+						Score:           1,
+						CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:   1725091100,
+						UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Author: "John Doe",
+				},
+				{
+					Comment: models.Comment{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Body:            "This is reply 1 to parent comment 1",
+						BodyHtml:        "<p>This is reply 1 to parent comment 1</p>",
+						Ups:             1,
+						Score:           1,
+						CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:   1725091100,
+						UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Author: "Jane Doe",
+				},
+				{
+					Comment: models.Comment{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Body:            "This is reply to reply 1",
+						BodyHtml:        "<p>This is reply to reply 1</p>",
+						Ups:             1,
+						Score:           1,
+						CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:   1725091100,
+						UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Author: "Jake Doe",
+				},
+				{
+					Comment: models.Comment{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+						AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+						PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Body:            "This is a parent comment 2",
+						BodyHtml:        "<p>This is a parent comment 2</p>",
+						Ups:             1,
+						Score:           1,
+						CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:   1725091100,
+						UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Author: "Jake Doe",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "comments of post 2 :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			args: args{
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantComments: []models.CommentAuthor{
+				{
+					Comment: models.Comment{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+						AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+						PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Body:            "This is a parent comment 3",
+						BodyHtml:        "<p>This is a parent comment 3</p>",
+						Ups:             1,
+						Score:           1,
+						CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:   1725091100,
+						UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Author: "John Doe",
+				},
+				{
+					Comment: models.Comment{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+						AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+						PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Body:            "This is reply to parent comment 3",
+						BodyHtml:        "<p>This is reply to parent comment 3</p>",
+						Ups:             1,
+						Score:           1,
+						CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:   1725091100,
+						UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Author: "Jane Doe",
+				},
+				{
+					Comment: models.Comment{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000007"),
+						AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+						PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Body:            "This is a parent comment 4",
+						BodyHtml:        "<p>This is a parent comment 4</p>",
+						Ups:             1,
+						Score:           1,
+						CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:   1725091100,
+						UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Author: "Jake Doe",
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := commentrepo.NewRepo(db)
+
+			gotComments, gotErr := pgrepo.CommentsByPostID(context.Background(), tt.args.postID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantComments, gotComments, "expect comments to match")
+		})
+	}
+}
+
+func TestRepo_CommentTree(t *testing.T) {
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	id1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	id2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	id3 := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	id4 := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	// node is the part of a comment node the walk decides on
+	type node struct {
+		ID          uuid.UUID
+		Depth       int32
+		Shown       bool
+		Descendants int32
+	}
+
+	type args struct {
+		postID   uuid.UUID
+		parentID uuid.UUID
+		depth    int
+		limit    int
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		setup     string
+		wantNodes []node
+		wantErr   error
+	}{
+		{
+			name: "post not found :NEG",
+			args: args{
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				depth:  8,
+				limit:  200,
+			},
+			wantNodes: []node{},
+			wantErr:   commentrepo.ErrCommentPostNotFound,
+		},
+		{
+			name: "parent comment of another post :NEG",
+			args: args{
+				postID:   postID,
+				parentID: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+				depth:    8,
+				limit:    200,
+			},
+			wantNodes: []node{},
+			wantErr:   commentrepo.ErrCommentNotFound,
+		},
+		{
+			name: "full tree :POS",
+			args: args{
+				postID: postID,
+				depth:  8,
+				limit:  200,
+			},
+			wantNodes: []node{
+				{ID: id1, Depth: 0, Shown: true},
+				{ID: id4, Depth: 0, Shown: true},
+				{ID: id2, Depth: 1, Shown: true},
+				{ID: id3, Depth: 2, Shown: true},
+			},
+		},
+		{
+			name: "tree stopped at depth :POS",
+			args: args{
+				postID: postID,
+				depth:  1,
+				limit:  200,
+			},
+			wantNodes: []node{
+				{ID: id1, Depth: 0, Shown: true},
+				{ID: id4, Depth: 0, Shown: true},
+				{ID: id2, Depth: 1, Descendants: 1},
+			},
+		},
+		{
+			name: "tree truncated by limit :POS",
+			args: args{
+				postID: postID,
+				depth:  8,
+				limit:  1,
+			},
+			wantNodes: []node{
+				{ID: id1, Depth: 0, Shown: true},
+				{ID: id4, Depth: 0},
+				{ID: id2, Depth: 1, Shown: true},
+				{ID: id3, Depth: 2, Shown: true},
+			},
+		},
+		{
+			name: "subtree of a parent comment :POS",
+			args: args{
+				postID:   postID,
+				parentID: id1,
+				depth:    1,
+				limit:    200,
+			},
+			wantNodes: []node{
+				{ID: id2, Depth: 0, Shown: true},
+				{ID: id3, Depth: 1},
+			},
+		},
+		{
+			name: "comment that is its own parent :POS",
+			args: args{
+				postID: postID,
+				depth:  8,
+				limit:  200,
+			},
+			setup: "UPDATE comments SET parent_comment_id = id WHERE id = '00000000-0000-0000-0000-000000000004'",
+			wantNodes: []node{
+				{ID: id1, Depth: 0, Shown: true},
+				{ID: id4, Depth: 0, Shown: true},
+				{ID: id2, Depth: 1, Shown: true},
+				{ID: id3, Depth: 2, Shown: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml")
+			pgrepo := commentrepo.NewRepo(db)
+
+			if tt.setup != "" {
+				if _, err := db.NewRaw(tt.setup).Exec(context.Background()); err != nil {
+					t.Fatal("failed to set up comments:", err)
+				}
+			}
+
+			gotNodes, gotErr := pgrepo.CommentTree(context.Background(), tt.args.postID, tt.args.parentID, tt.args.depth, tt.args.limit)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			got := make([]node, 0, len(gotNodes))
+			for _, gotNode := range gotNodes {
+				got = append(got, node{ID: gotNode.ID, Depth: gotNode.Depth, Shown: gotNode.Shown, Descendants: gotNode.Descendants})
+				if gotNode.Shown {
+					assert.NotEmpty(t, gotNode.Author, "expect shown comment to be loaded")
+				} else {
+					assert.Empty(t, gotNode.Body, "expect unshown comment not to be loaded")
+				}
+			}
+			assert.Equal(t, tt.wantNodes, got, "expect comment nodes to match")
+		})
+	}
+}
+
+func TestRepo_CommentsByAuthorName(t *testing.T) {
+	type args struct {
+		name  string
+		skip  int
+		limit int
+	}
+
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantComments []models.UserComment
+		wantErr      error
+	}{
+		{
+			name:         "author not found :NEG",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			args: args{
+				name:  "Nobody",
+				skip:  0,
+				limit: 10,
+			},
+			wantComments: []models.UserComment{},
+			wantErr:      commentrepo.ErrCommentAuthorNotFound,
+		},
+		{
+			name:         "author without comments :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml"},
+			args: args{
+				name:  "John Doe",
+				skip:  0,
+				limit: 10,
+			},
+			wantComments: []models.UserComment{},
+			wantErr:      nil,
+		},
+		{
+			name:         "comments of author newest first :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			args: args{
+				name:  "John Doe",
+				skip:  0,
+				limit: 10,
+			},
+			wantComments: []models.UserComment{
+				{
+					CommentAuthor: models.CommentAuthor{
+						Comment: models.Comment{
+							ID:              uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+							AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+							PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							Body:            "This is a parent comment 3",
+							BodyHtml:        "<p>This is a parent comment 3</p>",
+							Ups:             1,
+							Score:           1,
+							CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+							CreatedAtUnix:   1725091100,
+							UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						},
+						Author: "John Doe",
+					},
+					PostTitle:   "Example Post Title 2",
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Voxsphere:   "v/bar",
+				},
+				{
+					CommentAuthor: models.CommentAuthor{
+						Comment: models.Comment{
+							ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+							PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Body:            "This is a parent comment 1",
+							BodyHtml:        "<p>This is a parent comment 1</p>",
+							Ups:             1,
+							Score:           1,
+							CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+							CreatedAtUnix:   1725091100,
+							UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						},
+						Author: "John Doe",
+					},
+					PostTitle:   "Example Post Title 1",
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Voxsphere:   "v/foo",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "comments of author skip 1 limit 1 :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			args: args{
+				name:  "John Doe",
+				skip:  1,
+				limit: 1,
+			},
+			wantComments: []models.UserComment{
+				{
+					CommentAuthor: models.CommentAuthor{
+						Comment: models.Comment{
+							ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+							PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Body:            "This is a parent comment 1",
+							BodyHtml:        "<p>This is a parent comment 1</p>",
+							Ups:             1,
+							Score:           1,
+							CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+							CreatedAtUnix:   1725091100,
+							UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						},
+						Author: "John Doe",
+					},
+					PostTitle:   "Example Post Title 1",
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Voxsphere:   "v/foo",
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := commentrepo.NewRepo(db)
+
+			gotComments, gotErr := pgrepo.CommentsByAuthorName(context.Background(), tt.args.name, tt.args.skip, tt.args.limit)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantComments, gotComments, "expect comments to match")
+		})
+	}
+}
+
+func TestRepo_CommentsByIDs(t *testing.T) {
+	tests := []struct {
+		name           string
+		fixtureFiles   []string
+		IDs            []uuid.UUID
+		wantCommentIDs []uuid.UUID
+	}{
+		{
+			name:           "no ids :NEG",
+			fixtureFiles:   []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			IDs:            nil,
+			wantCommentIDs: []uuid.UUID{},
+		},
+		{
+			name:           "unknown ids left out :POS",
+			fixtureFiles:   []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			IDs:            []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000002"), uuid.MustParse("00000000-0000-0000-0000-000000000009"), uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			wantCommentIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001"), uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := commentrepo.NewRepo(db)
+
+			gotComments, gotErr := pgrepo.CommentsByIDs(context.Background(), tt.IDs)
+			assert.NoError(t, gotErr, "expect no error")
+
+			gotCommentIDs := []uuid.UUID{}
+			for _, comment := range gotComments {
+				gotCommentIDs = append(gotCommentIDs, comment.ID)
+			}
+			assert.ElementsMatch(t, tt.wantCommentIDs, gotCommentIDs, "expect comments to match")
+		})
+	}
+}
+
 func TestRepo_AddComments(t *testing.T) {
 	type args struct {
 		comments []models.Comment
@@ -1364,6 +1862,149 @@ func TestRepo_UpdateComment(t *testing.T) {
 	}
 }
 
+func TestRepo_EditComment(t *testing.T) {
+	type args struct {
+		ID       uuid.UUID
+		body     string
+		bodyHtml string
+	}
+	tests := []struct {
+		name        string
+		deleted     bool
+		removed     bool
+		args        args
+		wantComment models.Comment
+		wantErr     error
+	}{
+		{
+			name: "comment not found :NEG",
+			args: args{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				body:     "edited",
+				bodyHtml: "<p>edited</p>",
+			},
+			wantComment: models.Comment{},
+			wantErr:     commentrepo.ErrCommentNotFound,
+		},
+		{
+			name:    "deleted comment :NEG",
+			deleted: true,
+			args: args{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				body:     "edited",
+				bodyHtml: "<p>edited</p>",
+			},
+			wantComment: models.Comment{},
+			wantErr:     commentrepo.ErrCommentNotFound,
+		},
+		{
+			name:    "removed comment :NEG",
+			removed: true,
+			args: args{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				body:     "edited",
+				bodyHtml: "<p>edited</p>",
+			},
+			wantComment: models.Comment{},
+			wantErr:     commentrepo.ErrCommentNotFound,
+		},
+		{
+			name: "edit comment :POS",
+			args: args{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				body:     "edited",
+				bodyHtml: "<p>edited</p>",
+			},
+			wantComment: models.Comment{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Body:            "edited",
+				BodyHtml:        "<p>edited</p>",
+				Ups:             1,
+				Score:           1,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml")
+			pgrepo := commentrepo.NewRepo(db)
+
+			if tt.deleted {
+				if err := pgrepo.SoftDeleteComment(context.Background(), tt.args.ID); err != nil {
+					t.Fatal("failed to delete comment:", err)
+				}
+			}
+			if tt.removed {
+				if _, err := db.NewRaw("UPDATE comments SET removed_at = NOW() WHERE id = ?", tt.args.ID).Exec(context.Background()); err != nil {
+					t.Fatal("failed to remove comment:", err)
+				}
+			}
+
+			gotComment, gotErr := pgrepo.EditComment(context.Background(), tt.args.ID, tt.args.body, tt.args.bodyHtml, nil)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assertCommentWithoutTimestamp(t, tt.wantComment, gotComment)
+		})
+	}
+}
+
+func TestRepo_SoftDeleteComment(t *testing.T) {
+	t.Run("comment not found :NEG", func(t *testing.T) {
+		db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml")
+		pgrepo := commentrepo.NewRepo(db)
+
+		err := pgrepo.SoftDeleteComment(context.Background(), uuid.MustParse("00000000-0000-0000-0000-000000000009"))
+
+		assert.ErrorIs(t, err, commentrepo.ErrCommentNotFound, "expect error to match")
+	})
+
+	t.Run("deleted comment :NEG", func(t *testing.T) {
+		db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml")
+		pgrepo := commentrepo.NewRepo(db)
+
+		err := pgrepo.SoftDeleteComment(context.Background(), uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+		assert.NoError(t, err, "expect no error while deleting comment")
+
+		err = pgrepo.SoftDeleteComment(context.Background(), uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+		assert.ErrorIs(t, err, commentrepo.ErrCommentNotFound, "expect a deleted comment to be not found")
+	})
+
+	t.Run("delete comment and keep its replies :POS", func(t *testing.T) {
+		db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml")
+		pgrepo := commentrepo.NewRepo(db)
+
+		err := pgrepo.SoftDeleteComment(context.Background(), uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+		assert.NoError(t, err, "expect no error while deleting comment")
+
+		gotComments, err := pgrepo.CommentsByPostID(context.Background(), uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+		assert.NoError(t, err, "expect no error while getting comments")
+		assert.Len(t, gotComments, 4, "expect the replies of the deleted comment to be kept")
+
+		for _, comment := range gotComments {
+			switch comment.ID {
+			case uuid.MustParse("00000000-0000-0000-0000-000000000001"):
+				assert.Equal(t, commentrepo.DeletedComment, comment.Author, "expect author to be hidden")
+				assert.Equal(t, uuid.Nil, comment.AuthorID, "expect author id to be hidden")
+				assert.Equal(t, commentrepo.DeletedComment, comment.Body, "expect body to be wiped")
+				assert.Equal(t, "<p>[deleted]</p>", comment.BodyHtml, "expect body html to be wiped")
+			case uuid.MustParse("00000000-0000-0000-0000-000000000002"):
+				assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000001"), comment.ParentCommentID, "expect reply to keep its parent")
+				assert.Equal(t, "This is reply 1 to parent comment 1", comment.Body, "expect reply to be kept")
+			}
+		}
+
+		gotUserComments, err := pgrepo.CommentsByAuthorName(context.Background(), "John Doe", 0, 10)
+		assert.NoError(t, err, "expect no error while getting user comments")
+		if assert.Len(t, gotUserComments, 1, "expect deleted comment to be left out") {
+			assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000005"), gotUserComments[0].ID, "expect comment ID to match")
+		}
+	})
+}
+
 func TestRepo_DeleteComment(t *testing.T) {
 	type args struct {
 		ID uuid.UUID
@@ -1477,38 +2118,12 @@ func TestRepo_DeleteComment(t *testing.T) {
 			wantErr: commentrepo.ErrCommentNotFound,
 		},
 		{
-			name:         "delete comment :POS",
+			name:         "delete comment and its replies :POS",
 			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
 			args: args{
 				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			},
 			wantComments: []models.Comment{
-				{
-					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Body:            "This is reply 1 to parent comment 1",
-					BodyHtml:        "<p>This is reply 1 to parent comment 1</p>",
-					Ups:             1,
-					Score:           1,
-					CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-					CreatedAtUnix:   1725091100,
-					UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-				},
-				{
-					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-					AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-					ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Body:            "This is reply to reply 1",
-					BodyHtml:        "<p>This is reply to reply 1</p>",
-					Ups:             1,
-					Score:           1,
-					CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-					CreatedAtUnix:   1725091100,
-					UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-				},
 				{
 					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 					AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
@@ -1646,32 +2261,6 @@ func TestRepo_ForeignKeyCascade(t *testing.T) {
 		comments := commentrepo.NewRepo(db)
 
 		wantComments := []models.Comment{
-			{
-				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				Body:            "This is reply 1 to parent comment 1",
-				BodyHtml:        "<p>This is reply 1 to parent comment 1</p>",
-				Ups:             1,
-				Score:           1,
-				CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-				CreatedAtUnix:   1725091100,
-				UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-			},
-			{
-				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-				AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-				ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				Body:            "This is reply to reply 1",
-				BodyHtml:        "<p>This is reply to reply 1</p>",
-				Ups:             1,
-				Score:           1,
-				CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-				CreatedAtUnix:   1725091100,
-				UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-			},
 			{
 				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 				AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
@@ -1685,19 +2274,6 @@ func TestRepo_ForeignKeyCascade(t *testing.T) {
 				CreatedAtUnix:   1725091100,
 				UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
 			},
-			{
-				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000006"),
-				AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
-				PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Body:            "This is reply to parent comment 3",
-				BodyHtml:        "<p>This is reply to parent comment 3</p>",
-				Ups:             1,
-				Score:           1,
-				CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-				CreatedAtUnix:   1725091100,
-				UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
-			},
 			{
 				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000007"),
 				AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
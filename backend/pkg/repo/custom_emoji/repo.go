@@ -15,12 +15,16 @@ import (
 const (
 	pgUniqueViolation     = "23505"
 	pgConstraintViolation = "23503"
+
+	// titleConstraint keeps the titles of the emojis of a voxsphere unique.
+	titleConstraint = "uk_voxsphere_id_title"
 )
 
 var (
 	ErrCustomEmojiNotFound                  = errors.New("custom emoji not found")
 	ErrCustomEmojiDuplicateID               = errors.New("custom emoji duplicate id")
 	ErrCustomEmojiParentTableRecordNotFound = errors.New("record does not exist in the parent table")
+	ErrCustomEmojiDuplicateTitle            = errors.New("the voxsphere already has a custom emoji of that title")
 )
 
 type CustomEmojiRepository interface {
@@ -29,6 +33,7 @@ type CustomEmojiRepository interface {
 	AddCustomEmojis(context.Context, ...models.CustomEmoji) ([]models.CustomEmoji, error)
 	UpdateCustomEmoji(context.Context, models.CustomEmoji) (models.CustomEmoji, error)
 	DeleteCustomEmoji(context.Context, uuid.UUID) error
+	VoxsphereCustomEmojis(context.Context, uuid.UUID) ([]models.CustomEmoji, error)
 }
 
 type Repo struct {
@@ -105,6 +110,9 @@ func (r *Repo) AddCustomEmojis(ctx context.Context, customEmojis ...models.Custo
 	if _, err := r.db.NewRaw(query, args...).Exec(ctx, &customEmojis); err != nil {
 		var pgdriverErr pgdriver.Error
 		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgUniqueViolation {
+			if pgdriverErr.Field('n') == titleConstraint {
+				return nil, ErrCustomEmojiDuplicateTitle
+			}
 			return nil, ErrCustomEmojiDuplicateID
 		}
 		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
@@ -135,6 +143,9 @@ func (r *Repo) UpdateCustomEmoji(ctx context.Context, customEmoji models.CustomE
 		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
 			return models.CustomEmoji{}, ErrCustomEmojiParentTableRecordNotFound
 		}
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('n') == titleConstraint {
+			return models.CustomEmoji{}, ErrCustomEmojiDuplicateTitle
+		}
 		if errors.Is(err, sql.ErrNoRows) {
 			return models.CustomEmoji{}, ErrCustomEmojiNotFound
 		}
@@ -170,3 +181,27 @@ func (r *Repo) DeleteCustomEmoji(ctx context.Context, ID uuid.UUID) error {
 	}
 	return nil
 }
+
+// VoxsphereCustomEmojis returns the custom emojis of the voxsphere of
+// voxsphereID ordered by title.
+func (r *Repo) VoxsphereCustomEmojis(ctx context.Context, voxsphereID uuid.UUID) ([]models.CustomEmoji, error) {
+	customEmojis := []models.CustomEmoji{}
+
+	query := `
+                SELECT
+                    id,
+                    voxsphere_id,
+                    url,
+                    title
+                FROM
+                    custom_emojis
+                WHERE
+                    voxsphere_id = ?
+                ORDER BY
+                    title;
+            `
+	if _, err := r.db.NewRaw(query, voxsphereID).Exec(ctx, &customEmojis); err != nil {
+		return []models.CustomEmoji{}, err
+	}
+	return customEmojis, nil
+}
@@ -222,6 +222,36 @@ func TestRepo_AddCustomEmojis(t *testing.T) {
 			},
 			wantErr: customemojirepo.ErrCustomEmojiDuplicateID,
 		},
+		{
+			name:         "add custom emoji with duplicate title :NEG",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "custom_emojis.yml"},
+			args: args{
+				customEmojis: []models.CustomEmoji{
+					{
+						ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Url:         "https://example.com/emoji3.png",
+						Title:       "emoji1",
+					},
+				},
+			},
+			wantInsertedCustomEmojis: nil,
+			wantCustomEmojis: []models.CustomEmoji{
+				{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Url:         "https://example.com/emoji1.png",
+					Title:       "emoji1",
+				},
+				{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Url:         "https://example.com/emoji2.png",
+					Title:       "emoji2",
+				},
+			},
+			wantErr: customemojirepo.ErrCustomEmojiDuplicateTitle,
+		},
 		{
 			name:         "voxsphere does not exist in the parent table :NEG",
 			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "custom_emojis.yml"},
@@ -526,3 +556,39 @@ func TestRepo_ForeignKeyCascade(t *testing.T) {
 		assertCustomEmojis(t, wantCustomEmojis, gotCustomEmojis)
 	})
 }
+
+func TestRepo_VoxsphereCustomEmojis(t *testing.T) {
+	tests := []struct {
+		name             string
+		voxsphereID      uuid.UUID
+		wantCustomEmojis []models.CustomEmoji
+	}{
+		{
+			name:        "custom emojis of the voxsphere :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantCustomEmojis: []models.CustomEmoji{
+				{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Url:         "https://example.com/emoji2.png",
+					Title:       "emoji2",
+				},
+			},
+		},
+		{
+			name:             "no custom emojis :POS",
+			voxsphereID:      uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantCustomEmojis: []models.CustomEmoji{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "custom_emojis.yml")
+			pgrepo := customemojirepo.NewRepo(db)
+
+			gotCustomEmojis, gotErr := pgrepo.VoxsphereCustomEmojis(context.Background(), tt.voxsphereID)
+			assert.NoError(t, gotErr, "expect no error")
+			assert.Equal(t, tt.wantCustomEmojis, gotCustomEmojis, "expect custom emojis to match")
+		})
+	}
+}
@@ -312,3 +312,23 @@ func AssertGalleries(t *testing.T, wantGalleries, gotGalleries []models.Gallery)
 		AssertGallery(t, gallery, gotGalleries[idx])
 	}
 }
+
+func AssertMultiMedias(t *testing.T, wantMultiMedias, gotMultiMedias []models.MultiMedia) {
+	t.Helper()
+
+	if len(wantMultiMedias) != len(gotMultiMedias) {
+		t.Fatal("length of wantMultiMedias and gotMultiMedias do not match")
+	}
+
+	for _, multiMedia := range wantMultiMedias {
+		idx := slices.IndexFunc(gotMultiMedias, func(m models.MultiMedia) bool {
+			return m.ID == multiMedia.ID
+		})
+
+		if idx == -1 {
+			t.Fatalf("multi media %v of ID %v is not present in gotMultiMedias", multiMedia.MediaID, multiMedia.ID)
+			return
+		}
+		assert.Equal(t, multiMedia, gotMultiMedias[idx], "expected multi media to match")
+	}
+}
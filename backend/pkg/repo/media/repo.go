@@ -16,12 +16,17 @@ import (
 const (
 	pgUniqueViolation     = "23505"
 	pgConstraintViolation = "23503"
+	pgCheckViolation      = "23514"
+
+	multiMediaOrderIndexConstraint = "uq_multi_medias_order_index"
 )
 
 var (
 	ErrNotFound                  = errors.New("not found")
 	ErrDuplicateID               = errors.New("duplicate id")
 	ErrParentTableRecordNotFound = errors.New("record does not exist in the parent table")
+	ErrDuplicateOrderIndex       = errors.New("duplicate order index")
+	ErrInvalidChildType          = errors.New("invalid child type")
 )
 
 type MediaRepository interface {
@@ -87,6 +92,14 @@ type MediaRepository interface {
 	AddLinks(context.Context, ...models.Link) ([]models.Link, error)
 	UpdateLink(context.Context, models.Link) (models.Link, error)
 	DeleteLink(context.Context, uuid.UUID) error
+
+	// multi media
+	MultiMedias(context.Context) ([]models.MultiMedia, error)
+	MultiMediaByID(context.Context, uuid.UUID) (models.MultiMedia, error)
+	MultiMediasByMediaID(context.Context, uuid.UUID) ([]models.MultiMedia, error)
+	AddMultiMedias(context.Context, ...models.MultiMedia) ([]models.MultiMedia, error)
+	UpdateMultiMedia(context.Context, models.MultiMedia) (models.MultiMedia, error)
+	DeleteMultiMedia(context.Context, uuid.UUID) error
 }
 
 type Repo struct {
@@ -1583,3 +1596,184 @@ func (r *Repo) DeleteLink(ctx context.Context, ID uuid.UUID) error {
 	}
 	return nil
 }
+
+func (r *Repo) MultiMedias(ctx context.Context) ([]models.MultiMedia, error) {
+	var multiMedias []models.MultiMedia
+
+	query := `
+        SELECT
+            id,
+            media_id,
+            child_id,
+            child_type,
+            order_index
+        FROM
+            multi_medias;
+    `
+
+	_, err := r.db.NewRaw(query).Exec(ctx, &multiMedias)
+	if err != nil {
+		return []models.MultiMedia{}, err
+	}
+	return multiMedias, nil
+}
+
+func (r *Repo) MultiMediaByID(ctx context.Context, ID uuid.UUID) (models.MultiMedia, error) {
+	var multiMedia models.MultiMedia
+
+	query := `
+        SELECT
+            id,
+            media_id,
+            child_id,
+            child_type,
+            order_index
+        FROM
+            multi_medias
+        WHERE
+            id = ?;
+    `
+
+	_, err := r.db.NewRaw(query, ID).Exec(ctx, &multiMedia)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.MultiMedia{}, ErrNotFound
+		}
+		return models.MultiMedia{}, err
+	}
+	return multiMedia, nil
+}
+
+// MultiMediasByMediaID returns the children of the multi post media of ID in
+// their order.
+func (r *Repo) MultiMediasByMediaID(ctx context.Context, ID uuid.UUID) ([]models.MultiMedia, error) {
+	var multiMedias []models.MultiMedia
+
+	query := `
+        SELECT
+            id,
+            media_id,
+            child_id,
+            child_type,
+            order_index
+        FROM
+            multi_medias
+        WHERE
+            media_id = ?
+        ORDER BY
+            order_index;
+    `
+
+	_, err := r.db.NewRaw(query, ID).Exec(ctx, &multiMedias)
+	if err != nil {
+		return []models.MultiMedia{}, err
+	}
+	return multiMedias, nil
+}
+
+func (r *Repo) AddMultiMedias(ctx context.Context, multiMedias ...models.MultiMedia) ([]models.MultiMedia, error) {
+	query := `
+        INSERT INTO
+            multi_medias (
+                id,
+                media_id,
+                child_id,
+                child_type,
+                order_index
+            )
+        VALUES 
+    `
+	args := make([]interface{}, 0)
+	placeholders := []string{}
+	for _, multiMedia := range multiMedias {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
+		args = append(args, multiMedia.ID, multiMedia.MediaID, multiMedia.ChildID, multiMedia.ChildType, multiMedia.OrderIndex)
+	}
+	query += strings.Join(placeholders, ", ") + " RETURNING *"
+
+	if _, err := r.db.NewRaw(query, args...).Exec(ctx, &multiMedias); err != nil {
+		return nil, multiMediaError(err)
+	}
+	return multiMedias, nil
+}
+
+func (r *Repo) UpdateMultiMedia(ctx context.Context, multiMedia models.MultiMedia) (models.MultiMedia, error) {
+	query := `
+        UPDATE
+            multi_medias
+        SET
+            media_id = ?,
+            child_id = ?,
+            child_type = ?,
+            order_index = ?
+        WHERE
+            id = ?
+        RETURNING *
+    `
+
+	res, err := r.db.NewRaw(query,
+		multiMedia.MediaID,
+		multiMedia.ChildID,
+		multiMedia.ChildType,
+		multiMedia.OrderIndex,
+		multiMedia.ID,
+	).Exec(ctx, &multiMedia)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.MultiMedia{}, ErrNotFound
+		}
+		return models.MultiMedia{}, multiMediaError(err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return models.MultiMedia{}, err
+	}
+	if rowsAffected == 0 {
+		return models.MultiMedia{}, ErrNotFound
+	}
+	return multiMedia, nil
+}
+
+func (r *Repo) DeleteMultiMedia(ctx context.Context, ID uuid.UUID) error {
+	query := `
+        DELETE FROM 
+            multi_medias
+        WHERE 
+            id = ?
+    `
+	res, err := r.db.NewRaw(query, ID).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// multiMediaError maps the constraint violations of a multi media write to
+// the errors of the repository.
+func multiMediaError(err error) error {
+	var pgdriverErr pgdriver.Error
+	if !errors.As(err, &pgdriverErr) {
+		return err
+	}
+
+	switch pgdriverErr.Field('C') {
+	case pgUniqueViolation:
+		if pgdriverErr.Field('n') == multiMediaOrderIndexConstraint {
+			return ErrDuplicateOrderIndex
+		}
+		return ErrDuplicateID
+	case pgConstraintViolation:
+		return ErrParentTableRecordNotFound
+	case pgCheckViolation:
+		return ErrInvalidChildType
+	default:
+		return err
+	}
+}
@@ -56,6 +56,7 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	db.RegisterModel((*models.GalleryMetadata)(nil))
 	db.RegisterModel((*models.Video)(nil))
 	db.RegisterModel((*models.Link)(nil))
+	db.RegisterModel((*models.MultiMedia)(nil))
 
 	// drop all rows of the topics,voxspheres table
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.Topic)(nil)).Exec(context.Background()); err != nil {
@@ -97,6 +98,9 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.Link)(nil)).Exec(context.Background()); err != nil {
 		t.Fatal("truncate table failed:", err)
 	}
+	if _, err := db.NewTruncateTable().Cascade().Model((*models.MultiMedia)(nil)).Exec(context.Background()); err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
 
 	// load fixture
 	fixture := dbfixture.New(db)
@@ -6145,3 +6149,625 @@ func TestRepo_GalleryMetadataForeignKeyCascade(t *testing.T) {
 		mediarepo.AssertGalleryMetadatasWithTimestamp(t, wantGalleryMetadatas, gotGalleryMetadatas)
 	})
 }
+
+func TestRepo_MultiMedias(t *testing.T) {
+	tests := []struct {
+		name            string
+		fixtureFiles    []string
+		wantMultiMedias []models.MultiMedia
+		wantErr         error
+	}{
+		{
+			name: "multi medias :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			wantMultiMedias: []models.MultiMedia{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 0,
+				},
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ChildType:  models.MediaTypeLink,
+					OrderIndex: 1,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:            "no multi medias :POS",
+			fixtureFiles:    []string{},
+			wantMultiMedias: nil,
+			wantErr:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := mediarepo.NewRepo(db)
+
+			gotMultiMedias, gotErr := pgrepo.MultiMedias(context.Background())
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			mediarepo.AssertMultiMedias(t, tt.wantMultiMedias, gotMultiMedias)
+		})
+	}
+}
+
+func TestRepo_MultiMediaByID(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+	tests := []struct {
+		name           string
+		fixtureFiles   []string
+		args           args
+		wantMultiMedia models.MultiMedia
+		wantErr        error
+	}{
+		{
+			name: "multi media not found :NEG",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			wantMultiMedia: models.MultiMedia{},
+			wantErr:        mediarepo.ErrNotFound,
+		},
+		{
+			name: "multi media found :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantMultiMedia: models.MultiMedia{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+				ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				ChildType:  models.MediaTypeLink,
+				OrderIndex: 1,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := mediarepo.NewRepo(db)
+
+			gotMultiMedia, gotErr := pgrepo.MultiMediaByID(context.Background(), tt.args.ID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantMultiMedia, gotMultiMedia, "expect multi media to match")
+		})
+	}
+}
+
+func TestRepo_MultiMediasByMediaID(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+	tests := []struct {
+		name            string
+		fixtureFiles    []string
+		args            args
+		wantMultiMedias []models.MultiMedia
+		wantErr         error
+	}{
+		{
+			name: "no children :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			wantMultiMedias: nil,
+			wantErr:         nil,
+		},
+		{
+			name: "children in order :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			},
+			wantMultiMedias: []models.MultiMedia{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 0,
+				},
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ChildType:  models.MediaTypeLink,
+					OrderIndex: 1,
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := mediarepo.NewRepo(db)
+
+			gotMultiMedias, gotErr := pgrepo.MultiMediasByMediaID(context.Background(), tt.args.ID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantMultiMedias, gotMultiMedias, "expect multi medias to match")
+		})
+	}
+}
+
+func TestRepo_AddMultiMedias(t *testing.T) {
+	type args struct {
+		multiMedias []models.MultiMedia
+	}
+	wantMultiMedias := []models.MultiMedia{
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ChildType:  models.MediaTypeVideo,
+			OrderIndex: 0,
+		},
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ChildType:  models.MediaTypeLink,
+			OrderIndex: 1,
+		},
+	}
+	tests := []struct {
+		name                   string
+		fixtureFiles           []string
+		args                   args
+		wantInsertedMultiMedia []models.MultiMedia
+		wantMultiMedias        []models.MultiMedia
+		wantErr                error
+	}{
+		{
+			name: "duplicate multi media :NEG",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				multiMedias: []models.MultiMedia{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+						ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+						ChildType:  models.MediaTypeImage,
+						OrderIndex: 2,
+					},
+				},
+			},
+			wantInsertedMultiMedia: nil,
+			wantMultiMedias:        wantMultiMedias,
+			wantErr:                mediarepo.ErrDuplicateID,
+		},
+		{
+			name: "duplicate order index :NEG",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				multiMedias: []models.MultiMedia{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+						ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+						ChildType:  models.MediaTypeImage,
+						OrderIndex: 1,
+					},
+				},
+			},
+			wantInsertedMultiMedia: nil,
+			wantMultiMedias:        wantMultiMedias,
+			wantErr:                mediarepo.ErrDuplicateOrderIndex,
+		},
+		{
+			name: "invalid child type :NEG",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				multiMedias: []models.MultiMedia{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+						ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+						ChildType:  models.MediaTypeMulti,
+						OrderIndex: 2,
+					},
+				},
+			},
+			wantInsertedMultiMedia: nil,
+			wantMultiMedias:        wantMultiMedias,
+			wantErr:                mediarepo.ErrInvalidChildType,
+		},
+		{
+			name: "post media is not present in parent table :NEG",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				multiMedias: []models.MultiMedia{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+						ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+						ChildType:  models.MediaTypeImage,
+						OrderIndex: 0,
+					},
+				},
+			},
+			wantInsertedMultiMedia: nil,
+			wantMultiMedias:        wantMultiMedias,
+			wantErr:                mediarepo.ErrParentTableRecordNotFound,
+		},
+		{
+			name: "add multi medias :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				multiMedias: []models.MultiMedia{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+						ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+						ChildType:  models.MediaTypeGallery,
+						OrderIndex: 2,
+					},
+				},
+			},
+			wantInsertedMultiMedia: []models.MultiMedia{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					ChildType:  models.MediaTypeGallery,
+					OrderIndex: 2,
+				},
+			},
+			wantMultiMedias: append(slices.Clone(wantMultiMedias), models.MultiMedia{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+				ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+				ChildType:  models.MediaTypeGallery,
+				OrderIndex: 2,
+			}),
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := mediarepo.NewRepo(db)
+
+			gotInsertedMultiMedias, gotErr := pgrepo.AddMultiMedias(context.Background(), tt.args.multiMedias...)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantInsertedMultiMedia, gotInsertedMultiMedias, "expect inserted multi medias to match")
+
+			gotMultiMedias, err := pgrepo.MultiMedias(context.Background())
+
+			assert.NoError(t, err, "expect no error while getting multi medias")
+			mediarepo.AssertMultiMedias(t, tt.wantMultiMedias, gotMultiMedias)
+		})
+	}
+}
+
+func TestRepo_UpdateMultiMedia(t *testing.T) {
+	type args struct {
+		multiMedia models.MultiMedia
+	}
+	tests := []struct {
+		name            string
+		fixtureFiles    []string
+		args            args
+		wantMultiMedia  models.MultiMedia
+		wantMultiMedias []models.MultiMedia
+		wantErr         error
+	}{
+		{
+			name: "multi media not found :NEG",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				multiMedia: models.MultiMedia{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					ChildType:  models.MediaTypeImage,
+					OrderIndex: 2,
+				},
+			},
+			wantMultiMedia: models.MultiMedia{},
+			wantMultiMedias: []models.MultiMedia{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 0,
+				},
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ChildType:  models.MediaTypeLink,
+					OrderIndex: 1,
+				},
+			},
+			wantErr: mediarepo.ErrNotFound,
+		},
+		{
+			name: "duplicate order index :NEG",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				multiMedia: models.MultiMedia{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 1,
+				},
+			},
+			wantMultiMedia: models.MultiMedia{},
+			wantMultiMedias: []models.MultiMedia{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 0,
+				},
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ChildType:  models.MediaTypeLink,
+					OrderIndex: 1,
+				},
+			},
+			wantErr: mediarepo.ErrDuplicateOrderIndex,
+		},
+		{
+			name: "update multi media :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts.yml",
+				"multi_post_medias.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				multiMedia: models.MultiMedia{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 2,
+				},
+			},
+			wantMultiMedia: models.MultiMedia{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+				ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				ChildType:  models.MediaTypeVideo,
+				OrderIndex: 2,
+			},
+			wantMultiMedias: []models.MultiMedia{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 2,
+				},
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ChildType:  models.MediaTypeLink,
+					OrderIndex: 1,
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := mediarepo.NewRepo(db)
+
+			gotMultiMedia, gotErr := pgrepo.UpdateMultiMedia(context.Background(), tt.args.multiMedia)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantMultiMedia, gotMultiMedia, "expect multi media to match")
+
+			gotMultiMedias, err := pgrepo.MultiMedias(context.Background())
+
+			assert.NoError(t, err, "expect no error while getting multi medias")
+			mediarepo.AssertMultiMedias(t, tt.wantMultiMedias, gotMultiMedias)
+		})
+	}
+}
+
+func TestRepo_DeleteMultiMedia(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+	tests := []struct {
+		name            string
+		fixtureFiles    []string
+		args            args
+		wantMultiMedias []models.MultiMedia
+		wantErr         error
+	}{
+		{
+			name:         "multi media not found :NEG",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "multi_post_medias.yml", "multi_medias.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			wantMultiMedias: []models.MultiMedia{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ChildType:  models.MediaTypeVideo,
+					OrderIndex: 0,
+				},
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ChildType:  models.MediaTypeLink,
+					OrderIndex: 1,
+				},
+			},
+			wantErr: mediarepo.ErrNotFound,
+		},
+		{
+			name:         "multi media deleted :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "multi_post_medias.yml", "multi_medias.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantMultiMedias: []models.MultiMedia{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ChildType:  models.MediaTypeLink,
+					OrderIndex: 1,
+				},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := mediarepo.NewRepo(db)
+
+			gotErr := pgrepo.DeleteMultiMedia(context.Background(), tt.args.ID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			gotMultiMedias, err := pgrepo.MultiMedias(context.Background())
+
+			assert.NoError(t, err, "expect no error while getting multi medias")
+			mediarepo.AssertMultiMedias(t, tt.wantMultiMedias, gotMultiMedias)
+		})
+	}
+}
+
+func TestRepo_MultiMediaForeignKeyCascade(t *testing.T) {
+	t.Run("on deleting media from parent table , no child references should exist in multi_medias table", func(t *testing.T) {
+		db := setupPostgres(
+			t,
+			"topics.yml",
+			"voxspheres.yml",
+			"users.yml",
+			"posts.yml",
+			"multi_post_medias.yml",
+			"multi_medias.yml",
+		)
+		pgrepo := mediarepo.NewRepo(db)
+
+		err := pgrepo.DeletePostMedia(context.Background(), uuid.MustParse("00000000-0000-0000-0000-000000000006"))
+
+		assert.NoError(t, err, "expect no error while deleting post media")
+
+		gotMultiMedias, err := pgrepo.MultiMedias(context.Background())
+
+		assert.NoError(t, err, "expect no error while getting multi medias")
+		assert.Equal(t, []models.MultiMedia(nil), gotMultiMedias, "expect multi medias to match")
+	})
+}
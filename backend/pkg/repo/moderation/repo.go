@@ -0,0 +1,527 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const pgConstraintViolation = "23503"
+
+var (
+	ErrModPostNotFound      = errors.New("post not found")
+	ErrModCommentNotFound   = errors.New("comment not found")
+	ErrModUserNotFound      = errors.New("user not found")
+	ErrModVoxsphereNotFound = errors.New("voxsphere not found")
+	ErrModBanNotFound       = errors.New("ban not found")
+	ErrModInvalidAction     = errors.New("invalid moderation action")
+	ErrModPinLimit          = fmt.Errorf("a voxsphere can pin at most %d posts", models.MaxPinnedPosts)
+)
+
+// modTarget describes the table a moderation action is taken on. voxsphere
+// selects and locks the target of ID, returning the voxsphere it belongs to.
+// actions holds the SET clause of every action that can be taken on the
+// target, empty for actions that leave the target as is. The actions of
+// resolves resolve the open reports on the target, found by reportsColumn.
+type modTarget struct {
+	table         string
+	voxsphere     string
+	actions       map[models.ModAction]string
+	resolves      map[models.ModAction]bool
+	reportsColumn string
+	notFound      error
+}
+
+var (
+	postModTarget = modTarget{
+		table: "posts",
+		voxsphere: `
+            SELECT
+                p.voxsphere_id
+            FROM
+                posts p
+            WHERE
+                p.id = ?
+            FOR UPDATE;
+        `,
+		actions: map[models.ModAction]string{
+			models.ModActionRemovePost:    "removed_at = CURRENT_TIMESTAMP",
+			models.ModActionApprovePost:   "removed_at = NULL",
+			models.ModActionLockPost:      "locked = true",
+			models.ModActionUnlockPost:    "locked = false",
+			models.ModActionPinPost:       "pinned_at = COALESCE(pinned_at, CURRENT_TIMESTAMP)",
+			models.ModActionUnpinPost:     "pinned_at = NULL",
+			models.ModActionMarkNSFW:      "over18 = true",
+			models.ModActionUnmarkNSFW:    "over18 = false",
+			models.ModActionMarkSpoiler:   "spoiler = true",
+			models.ModActionUnmarkSpoiler: "spoiler = false",
+
+			models.ModActionDismissPostReports: "",
+		},
+		resolves: map[models.ModAction]bool{
+			models.ModActionRemovePost:         true,
+			models.ModActionApprovePost:        true,
+			models.ModActionDismissPostReports: true,
+		},
+		reportsColumn: "post_id",
+		notFound:      ErrModPostNotFound,
+	}
+	commentModTarget = modTarget{
+		table: "comments",
+		voxsphere: `
+            SELECT
+                p.voxsphere_id
+            FROM
+                comments c
+            JOIN
+                posts p ON p.id = c.post_id
+            WHERE
+                c.id = ?
+            FOR UPDATE OF c;
+        `,
+		actions: map[models.ModAction]string{
+			models.ModActionRemoveComment:  "removed_at = CURRENT_TIMESTAMP",
+			models.ModActionApproveComment: "removed_at = NULL",
+
+			models.ModActionDismissCommentReports: "",
+		},
+		resolves: map[models.ModAction]bool{
+			models.ModActionRemoveComment:         true,
+			models.ModActionApproveComment:        true,
+			models.ModActionDismissCommentReports: true,
+		},
+		reportsColumn: "comment_id",
+		notFound:      ErrModCommentNotFound,
+	}
+)
+
+type ModerationRepository interface {
+	IsVoxsphereModerator(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	PostVoxsphereID(context.Context, uuid.UUID) (uuid.UUID, error)
+	CommentVoxsphereID(context.Context, uuid.UUID) (uuid.UUID, error)
+	ModeratePost(context.Context, models.ModLog) (models.ModLog, error)
+	ModerateComment(context.Context, models.ModLog) (models.ModLog, error)
+	BanUser(context.Context, models.VoxsphereBan, models.ModLog) (models.VoxsphereBan, error)
+	UnbanUser(context.Context, models.ModLog) error
+	IsBannedFromVoxsphere(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	PostRestrictions(context.Context, uuid.UUID, uuid.UUID) (models.PostRestrictions, error)
+	ModLogsByVoxsphereID(context.Context, uuid.UUID, int, int) ([]models.ModLog, error)
+}
+
+type Repo struct {
+	db *bun.DB
+}
+
+func NewRepo(db *bun.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// IsVoxsphereModerator reports whether the user of userID moderates the
+// voxsphere of voxsphereID.
+func (r *Repo) IsVoxsphereModerator(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error) {
+	var isModerator bool
+
+	query := `
+        SELECT
+            EXISTS (
+                SELECT
+                    1
+                FROM
+                    voxsphere_moderators vm
+                WHERE
+                    vm.voxsphere_id = ?
+                    AND vm.user_id = ?
+            );
+    `
+	if err := r.db.NewRaw(query, voxsphereID, userID).Scan(ctx, &isModerator); err != nil {
+		return false, err
+	}
+	return isModerator, nil
+}
+
+// PostVoxsphereID returns the ID of the voxsphere the post of postID was
+// posted in.
+func (r *Repo) PostVoxsphereID(ctx context.Context, postID uuid.UUID) (uuid.UUID, error) {
+	var voxsphereID uuid.UUID
+
+	query := `
+        SELECT
+            p.voxsphere_id
+        FROM
+            posts p
+        WHERE
+            p.id = ?;
+    `
+	if err := r.db.NewRaw(query, postID).Scan(ctx, &voxsphereID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrModPostNotFound
+		}
+		return uuid.Nil, err
+	}
+	return voxsphereID, nil
+}
+
+// CommentVoxsphereID returns the ID of the voxsphere of the post the comment
+// of commentID was made on.
+func (r *Repo) CommentVoxsphereID(ctx context.Context, commentID uuid.UUID) (uuid.UUID, error) {
+	var voxsphereID uuid.UUID
+
+	query := `
+        SELECT
+            p.voxsphere_id
+        FROM
+            comments c
+        JOIN
+            posts p ON p.id = c.post_id
+        WHERE
+            c.id = ?;
+    `
+	if err := r.db.NewRaw(query, commentID).Scan(ctx, &voxsphereID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrModCommentNotFound
+		}
+		return uuid.Nil, err
+	}
+	return voxsphereID, nil
+}
+
+// ModeratePost takes the action of modLog on the post of modLog.TargetID and
+// adds modLog to the mod log of the voxsphere of the post. Pinning a post
+// fails with ErrModPinLimit once its voxsphere pins the maximum number of
+// posts.
+func (r *Repo) ModeratePost(ctx context.Context, modLog models.ModLog) (models.ModLog, error) {
+	return r.moderate(ctx, postModTarget, modLog)
+}
+
+// ModerateComment takes the action of modLog on the comment of
+// modLog.TargetID and adds modLog to the mod log of the voxsphere of the
+// comment.
+func (r *Repo) ModerateComment(ctx context.Context, modLog models.ModLog) (models.ModLog, error) {
+	return r.moderate(ctx, commentModTarget, modLog)
+}
+
+// moderate applies the action of modLog to the target and logs it in the same
+// transaction, so that no action goes unlogged. The target row stays locked
+// until the action commits, and pinning a post also locks its voxsphere so
+// that concurrent pins cannot exceed the limit. Removing, approving or
+// dismissing the reports of a target resolves its open reports with modLog.
+func (r *Repo) moderate(ctx context.Context, target modTarget, modLog models.ModLog) (models.ModLog, error) {
+	set, ok := target.actions[modLog.Action]
+	if !ok {
+		return models.ModLog{}, ErrModInvalidAction
+	}
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewRaw(target.voxsphere, modLog.TargetID).Scan(ctx, &modLog.VoxsphereID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return target.notFound
+			}
+			return err
+		}
+
+		if modLog.Action == models.ModActionPinPost {
+			if err := checkPinLimit(ctx, tx, modLog.VoxsphereID, modLog.TargetID); err != nil {
+				return err
+			}
+		}
+
+		if len(set) != 0 {
+			updateQuery := fmt.Sprintf(`
+                UPDATE
+                    %s
+                SET
+                    %s
+                WHERE
+                    id = ?;
+            `, target.table, set)
+			if _, err := tx.NewRaw(updateQuery, modLog.TargetID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		modLog, err = addModLog(ctx, tx, modLog)
+		if err != nil {
+			return err
+		}
+
+		if target.resolves[modLog.Action] {
+			return resolveReports(ctx, tx, target.reportsColumn, modLog)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.ModLog{}, err
+	}
+	return modLog, nil
+}
+
+// resolveReports resolves the open reports whose column matches the target of
+// modLog, linking them to modLog.
+func resolveReports(ctx context.Context, db bun.IDB, column string, modLog models.ModLog) error {
+	query := fmt.Sprintf(`
+        UPDATE
+            reports
+        SET
+            resolved_at = ?,
+            mod_log_id = ?
+        WHERE
+            %s = ?
+            AND resolved_at IS NULL;
+    `, column)
+	_, err := db.NewRaw(query, modLog.CreatedAt, modLog.ID, modLog.TargetID).Exec(ctx)
+	return err
+}
+
+// checkPinLimit fails with ErrModPinLimit when the voxsphere of voxsphereID
+// already pins the maximum number of posts other than the post of postID.
+func checkPinLimit(ctx context.Context, db bun.IDB, voxsphereID, postID uuid.UUID) error {
+	lockQuery := `
+        SELECT
+            v.id
+        FROM
+            voxspheres v
+        WHERE
+            v.id = ?
+        FOR UPDATE;
+    `
+	if _, err := db.NewRaw(lockQuery, voxsphereID).Exec(ctx); err != nil {
+		return err
+	}
+
+	var pinned int
+	countQuery := `
+        SELECT
+            count(*)
+        FROM
+            posts p
+        WHERE
+            p.voxsphere_id = ?
+            AND p.pinned_at IS NOT NULL
+            AND p.id <> ?;
+    `
+	if err := db.NewRaw(countQuery, voxsphereID, postID).Scan(ctx, &pinned); err != nil {
+		return err
+	}
+	if pinned >= models.MaxPinnedPosts {
+		return ErrModPinLimit
+	}
+	return nil
+}
+
+// BanUser bans the user of ban.UserID from the voxsphere of ban.VoxsphereID,
+// replacing the reason and expiry of an earlier ban, and adds modLog to the
+// mod log of the voxsphere.
+func (r *Repo) BanUser(ctx context.Context, ban models.VoxsphereBan, modLog models.ModLog) (models.VoxsphereBan, error) {
+	ban.CreatedAt = time.Now()
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		query := `
+            INSERT INTO voxsphere_bans
+                (
+                    voxsphere_id,
+                    user_id,
+                    reason,
+                    expires_at,
+                    created_at
+                )
+            VALUES
+                (?, ?, ?, ?, ?)
+            ON CONFLICT (voxsphere_id, user_id) DO UPDATE
+            SET
+                reason = EXCLUDED.reason,
+                expires_at = EXCLUDED.expires_at,
+                created_at = EXCLUDED.created_at;
+        `
+		if _, err := tx.NewRaw(query,
+			ban.VoxsphereID,
+			ban.UserID,
+			ban.Reason,
+			ban.ExpiresAt,
+			ban.CreatedAt,
+		).Exec(ctx); err != nil {
+			var pgdriverErr pgdriver.Error
+			if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+				if pgdriverErr.Field('n') == "fk_voxsphere_id" {
+					return ErrModVoxsphereNotFound
+				}
+				return ErrModUserNotFound
+			}
+			return err
+		}
+
+		modLog.VoxsphereID = ban.VoxsphereID
+		modLog.TargetID = ban.UserID
+		_, err := addModLog(ctx, tx, modLog)
+		return err
+	})
+	if err != nil {
+		return models.VoxsphereBan{}, err
+	}
+	return ban, nil
+}
+
+// UnbanUser lifts the ban of the user of modLog.TargetID from the voxsphere of
+// modLog.VoxsphereID and adds modLog to the mod log of the voxsphere.
+func (r *Repo) UnbanUser(ctx context.Context, modLog models.ModLog) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		query := `
+            DELETE FROM
+                voxsphere_bans
+            WHERE
+                voxsphere_id = ?
+                AND user_id = ?;
+        `
+		res, err := tx.NewRaw(query, modLog.VoxsphereID, modLog.TargetID).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if rowsAffected, err := res.RowsAffected(); err != nil {
+			return err
+		} else if rowsAffected == 0 {
+			return ErrModBanNotFound
+		}
+
+		_, err = addModLog(ctx, tx, modLog)
+		return err
+	})
+}
+
+// IsBannedFromVoxsphere reports whether the user of userID is banned from the
+// voxsphere of voxsphereID. Expired bans are ignored.
+func (r *Repo) IsBannedFromVoxsphere(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error) {
+	var isBanned bool
+
+	query := `
+        SELECT
+            EXISTS (
+                SELECT
+                    1
+                FROM
+                    voxsphere_bans vb
+                WHERE
+                    vb.voxsphere_id = ?
+                    AND vb.user_id = ?
+                    AND (vb.expires_at IS NULL OR vb.expires_at > CURRENT_TIMESTAMP)
+            );
+    `
+	if err := r.db.NewRaw(query, voxsphereID, userID).Scan(ctx, &isBanned); err != nil {
+		return false, err
+	}
+	return isBanned, nil
+}
+
+// PostRestrictions reports whether the post of postID is locked and whether
+// the user of userID is banned from the voxsphere of the post.
+func (r *Repo) PostRestrictions(ctx context.Context, postID, userID uuid.UUID) (models.PostRestrictions, error) {
+	var restrictions models.PostRestrictions
+
+	query := `
+        SELECT
+            p.voxsphere_id,
+            p.locked,
+            EXISTS (
+                SELECT
+                    1
+                FROM
+                    voxsphere_bans vb
+                WHERE
+                    vb.voxsphere_id = p.voxsphere_id
+                    AND vb.user_id = ?
+                    AND (vb.expires_at IS NULL OR vb.expires_at > CURRENT_TIMESTAMP)
+            ) AS banned
+        FROM
+            posts p
+        WHERE
+            p.id = ?;
+    `
+	if err := r.db.NewRaw(query, userID, postID).Scan(ctx, &restrictions.VoxsphereID, &restrictions.Locked, &restrictions.Banned); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.PostRestrictions{}, ErrModPostNotFound
+		}
+		return models.PostRestrictions{}, err
+	}
+	return restrictions, nil
+}
+
+// ModLogsByVoxsphereID returns the mod log of the voxsphere of voxsphereID,
+// newest first.
+func (r *Repo) ModLogsByVoxsphereID(ctx context.Context, voxsphereID uuid.UUID, skip, limit int) ([]models.ModLog, error) {
+	modLogs := []models.ModLog{}
+
+	query := `
+        SELECT
+            ml.id,
+            ml.voxsphere_id,
+            ml.moderator_id,
+            u.name AS moderator,
+            ml.action,
+            ml.target_id,
+            ml.details,
+            ml.created_at,
+            ml.created_at_unix
+        FROM
+            mod_logs ml
+        JOIN
+            users u ON u.id = ml.moderator_id
+        WHERE
+            ml.voxsphere_id = ?
+        ORDER BY
+            ml.created_at DESC,
+            ml.id
+        LIMIT
+            ?
+        OFFSET
+            ?;
+    `
+	if _, err := r.db.NewRaw(query, voxsphereID, limit, skip).Exec(ctx, &modLogs); err != nil {
+		return []models.ModLog{}, err
+	}
+	return modLogs, nil
+}
+
+// addModLog adds modLog to the mod log, stamped with the current time.
+func addModLog(ctx context.Context, db bun.IDB, modLog models.ModLog) (models.ModLog, error) {
+	modLog.CreatedAt = time.Now()
+	modLog.CreatedAtUnix = modLog.CreatedAt.Unix()
+
+	query := `
+        INSERT INTO mod_logs
+            (
+                id,
+                voxsphere_id,
+                moderator_id,
+                action,
+                target_id,
+                details,
+                created_at,
+                created_at_unix
+            )
+        VALUES
+            (?, ?, ?, ?, ?, ?, ?, ?);
+    `
+	if _, err := db.NewRaw(query,
+		modLog.ID,
+		modLog.VoxsphereID,
+		modLog.ModeratorID,
+		modLog.Action,
+		modLog.TargetID,
+		modLog.Details,
+		modLog.CreatedAt,
+		modLog.CreatedAtUnix,
+	).Exec(ctx); err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+			return models.ModLog{}, ErrModUserNotFound
+		}
+		return models.ModLog{}, err
+	}
+	return modLog, nil
+}
@@ -0,0 +1,564 @@
+package moderation_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	moderationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/moderation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dbfixture"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+)
+
+func connectPostgres(user, password, address, dbName string) *bun.DB {
+	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, address, dbName)
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
+	db := bun.NewDB(sqldb, pgdialect.New())
+	return db
+}
+
+func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
+	db := connectPostgres("postgres", "postgres", "127.0.0.1:5432", "voxpopuli")
+
+	if err := db.Ping(); err != nil {
+		t.Fatal("db error:", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Log("db close error:", err)
+		}
+	})
+
+	// add query logging hook
+	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
+
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
+	db.RegisterModel((*models.VoxsphereModerator)(nil))
+	db.RegisterModel((*models.VoxsphereBan)(nil))
+	db.RegisterModel((*models.ModLog)(nil))
+	db.RegisterModel((*models.Report)(nil))
+
+	// drop all rows of the moderated tables
+	for _, model := range []interface{}{
+		(*models.Topic)(nil),
+		(*models.Voxsphere)(nil),
+		(*models.User)(nil),
+		(*models.Post)(nil),
+		(*models.Comment)(nil),
+		(*models.VoxsphereModerator)(nil),
+		(*models.VoxsphereBan)(nil),
+		(*models.ModLog)(nil),
+		(*models.Report)(nil),
+	} {
+		if _, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background()); err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
+	}
+
+	// load fixture
+	fixture := dbfixture.New(db)
+	if err := fixture.Load(context.Background(), os.DirFS("testdata"), fixtureFiles...); err != nil {
+		t.Fatal("failed to load fixtures", err)
+	}
+
+	return db
+}
+
+// modLogCount returns the number of mod log entries of the voxsphere of
+// voxsphereID.
+func modLogCount(t *testing.T, db *bun.DB, voxsphereID uuid.UUID) int {
+	t.Helper()
+
+	var count int
+	if err := db.NewRaw("SELECT count(*) FROM mod_logs WHERE voxsphere_id = ?", voxsphereID).Scan(context.Background(), &count); err != nil {
+		t.Fatal("failed to count mod logs:", err)
+	}
+	return count
+}
+
+func newModLog(action models.ModAction, targetID uuid.UUID) models.ModLog {
+	return models.ModLog{
+		ID:          uuid.New(),
+		ModeratorID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Action:      action,
+		TargetID:    targetID,
+	}
+}
+
+func TestRepo_IsVoxsphereModerator(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_moderators.yml"}
+
+	tests := []struct {
+		name            string
+		voxsphereID     uuid.UUID
+		userID          uuid.UUID
+		wantIsModerator bool
+	}{
+		{
+			name:            "moderator :POS",
+			voxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantIsModerator: true,
+		},
+		{
+			name:            "moderator of another voxsphere :NEG",
+			voxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			userID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantIsModerator: false,
+		},
+		{
+			name:            "not a moderator :NEG",
+			voxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantIsModerator: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := moderationrepo.NewRepo(db)
+
+			gotIsModerator, gotErr := pgrepo.IsVoxsphereModerator(context.Background(), tt.voxsphereID, tt.userID)
+			assert.NoError(t, gotErr, "expect no error")
+			assert.Equal(t, tt.wantIsModerator, gotIsModerator, "expect moderator to match")
+		})
+	}
+}
+
+func TestRepo_ModeratePost(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml"}
+
+	tests := []struct {
+		name       string
+		pinned     []uuid.UUID
+		modLog     models.ModLog
+		wantColumn string
+		wantValue  bool
+		wantErr    error
+	}{
+		{
+			name:    "post not found :NEG",
+			modLog:  newModLog(models.ModActionLockPost, uuid.MustParse("00000000-0000-0000-0000-000000000009")),
+			wantErr: moderationrepo.ErrModPostNotFound,
+		},
+		{
+			name:    "comment action on a post :NEG",
+			modLog:  newModLog(models.ModActionRemoveComment, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantErr: moderationrepo.ErrModInvalidAction,
+		},
+		{
+			name:       "remove post :POS",
+			modLog:     newModLog(models.ModActionRemovePost, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantColumn: "removed_at IS NOT NULL",
+			wantValue:  true,
+		},
+		{
+			name:       "lock post :POS",
+			modLog:     newModLog(models.ModActionLockPost, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantColumn: "locked",
+			wantValue:  true,
+		},
+		{
+			name:       "mark post nsfw :POS",
+			modLog:     newModLog(models.ModActionMarkNSFW, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantColumn: "over18",
+			wantValue:  true,
+		},
+		{
+			name:       "pin post :POS",
+			pinned:     []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+			modLog:     newModLog(models.ModActionPinPost, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantColumn: "pinned_at IS NOT NULL",
+			wantValue:  true,
+		},
+		{
+			name: "pin a pinned post again :POS",
+			pinned: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			modLog:     newModLog(models.ModActionPinPost, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantColumn: "pinned_at IS NOT NULL",
+			wantValue:  true,
+		},
+		{
+			name: "pin a third post :NEG",
+			pinned: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+			modLog:  newModLog(models.ModActionPinPost, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantErr: moderationrepo.ErrModPinLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := moderationrepo.NewRepo(db)
+			voxsphereID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+			for _, postID := range tt.pinned {
+				if _, err := db.NewRaw("UPDATE posts SET pinned_at = CURRENT_TIMESTAMP WHERE id = ?", postID).Exec(context.Background()); err != nil {
+					t.Fatal("failed to pin post:", err)
+				}
+			}
+
+			gotModLog, gotErr := pgrepo.ModeratePost(context.Background(), tt.modLog)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, models.ModLog{}, gotModLog, "expect no mod log")
+				assert.Equal(t, 0, modLogCount(t, db, voxsphereID), "expect nothing to be logged")
+				return
+			}
+
+			assert.Equal(t, voxsphereID, gotModLog.VoxsphereID, "expect mod log of the voxsphere of the post")
+			assert.Equal(t, 1, modLogCount(t, db, voxsphereID), "expect action to be logged")
+
+			var gotValue bool
+			query := fmt.Sprintf("SELECT %s FROM posts WHERE id = ?", tt.wantColumn)
+			if err := db.NewRaw(query, tt.modLog.TargetID).Scan(context.Background(), &gotValue); err != nil {
+				t.Fatal("failed to get post:", err)
+			}
+			assert.Equal(t, tt.wantValue, gotValue, "expect post to be moderated")
+		})
+	}
+}
+
+func TestRepo_ModerateComment(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"}
+
+	tests := []struct {
+		name        string
+		modLogs     []models.ModLog
+		wantRemoved bool
+		wantErr     error
+	}{
+		{
+			name:    "comment not found :NEG",
+			modLogs: []models.ModLog{newModLog(models.ModActionRemoveComment, uuid.MustParse("00000000-0000-0000-0000-000000000009"))},
+			wantErr: moderationrepo.ErrModCommentNotFound,
+		},
+		{
+			name:        "remove comment :POS",
+			modLogs:     []models.ModLog{newModLog(models.ModActionRemoveComment, uuid.MustParse("00000000-0000-0000-0000-000000000001"))},
+			wantRemoved: true,
+		},
+		{
+			name: "approve removed comment :POS",
+			modLogs: []models.ModLog{
+				newModLog(models.ModActionRemoveComment, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				newModLog(models.ModActionApproveComment, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+			wantRemoved: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := moderationrepo.NewRepo(db)
+			voxsphereID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+			var gotErr error
+			for _, modLog := range tt.modLogs {
+				_, gotErr = pgrepo.ModerateComment(context.Background(), modLog)
+			}
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, len(tt.modLogs), modLogCount(t, db, voxsphereID), "expect every action to be logged")
+
+			var gotRemoved bool
+			if err := db.NewRaw("SELECT removed_at IS NOT NULL FROM comments WHERE id = ?", tt.modLogs[0].TargetID).Scan(context.Background(), &gotRemoved); err != nil {
+				t.Fatal("failed to get comment:", err)
+			}
+			assert.Equal(t, tt.wantRemoved, gotRemoved, "expect comment removal to match")
+		})
+	}
+}
+
+func TestRepo_ResolveReports(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml", "reports.yml"}
+
+	tests := []struct {
+		name         string
+		comment      bool
+		modLog       models.ModLog
+		wantResolved bool
+	}{
+		{
+			name:         "lock post keeps reports open :POS",
+			modLog:       newModLog(models.ModActionLockPost, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantResolved: false,
+		},
+		{
+			name:         "remove post resolves reports :POS",
+			modLog:       newModLog(models.ModActionRemovePost, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantResolved: true,
+		},
+		{
+			name:         "dismiss post reports :POS",
+			modLog:       newModLog(models.ModActionDismissPostReports, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantResolved: true,
+		},
+		{
+			name:         "approve comment resolves reports :POS",
+			comment:      true,
+			modLog:       newModLog(models.ModActionApproveComment, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantResolved: true,
+		},
+		{
+			name:         "dismiss comment reports :POS",
+			comment:      true,
+			modLog:       newModLog(models.ModActionDismissCommentReports, uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			wantResolved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := moderationrepo.NewRepo(db)
+
+			moderate, column := pgrepo.ModeratePost, "post_id"
+			if tt.comment {
+				moderate, column = pgrepo.ModerateComment, "comment_id"
+			}
+			gotModLog, gotErr := moderate(context.Background(), tt.modLog)
+			assert.NoError(t, gotErr, "expect no error")
+
+			var gotModLogID uuid.UUID
+			query := fmt.Sprintf("SELECT COALESCE(mod_log_id, ?) FROM reports WHERE %s = ?", column)
+			if err := db.NewRaw(query, uuid.Nil, tt.modLog.TargetID).Scan(context.Background(), &gotModLogID); err != nil {
+				t.Fatal("failed to get report:", err)
+			}
+			if tt.wantResolved {
+				assert.Equal(t, gotModLog.ID, gotModLogID, "expect report to link to the action")
+			} else {
+				assert.Equal(t, uuid.Nil, gotModLogID, "expect report to stay open")
+			}
+		})
+	}
+}
+
+func TestRepo_BanUser(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_bans.yml"}
+	expiresAt := time.Date(2124, 10, 10, 10, 10, 10, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		ban        models.VoxsphereBan
+		wantBanned bool
+		wantErr    error
+	}{
+		{
+			name: "voxsphere not found :NEG",
+			ban: models.VoxsphereBan{
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				UserID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantErr: moderationrepo.ErrModVoxsphereNotFound,
+		},
+		{
+			name: "user not found :NEG",
+			ban: models.VoxsphereBan{
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			wantErr: moderationrepo.ErrModUserNotFound,
+		},
+		{
+			name: "ban user :POS",
+			ban: models.VoxsphereBan{
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Reason:      "spam",
+				ExpiresAt:   &expiresAt,
+			},
+			wantBanned: true,
+		},
+		{
+			name: "renew expired ban :POS",
+			ban: models.VoxsphereBan{
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				Reason:      "again",
+			},
+			wantBanned: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := moderationrepo.NewRepo(db)
+
+			gotBan, gotErr := pgrepo.BanUser(context.Background(), tt.ban, newModLog(models.ModActionBanUser, tt.ban.UserID))
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, models.VoxsphereBan{}, gotBan, "expect no ban")
+				return
+			}
+
+			assert.Equal(t, tt.ban.Reason, gotBan.Reason, "expect reason to match")
+			assert.Equal(t, 1, modLogCount(t, db, tt.ban.VoxsphereID), "expect ban to be logged")
+
+			gotBanned, err := pgrepo.IsBannedFromVoxsphere(context.Background(), tt.ban.VoxsphereID, tt.ban.UserID)
+			assert.NoError(t, err, "expect no error")
+			assert.Equal(t, tt.wantBanned, gotBanned, "expect user to be banned")
+		})
+	}
+}
+
+func TestRepo_UnbanUser(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_bans.yml"}
+
+	tests := []struct {
+		name    string
+		modLog  models.ModLog
+		wantErr error
+	}{
+		{
+			name: "ban not found :NEG",
+			modLog: models.ModLog{
+				ID:          uuid.New(),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				ModeratorID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Action:      models.ModActionUnbanUser,
+				TargetID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantErr: moderationrepo.ErrModBanNotFound,
+		},
+		{
+			name: "unban user :POS",
+			modLog: models.ModLog{
+				ID:          uuid.New(),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ModeratorID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Action:      models.ModActionUnbanUser,
+				TargetID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := moderationrepo.NewRepo(db)
+
+			gotErr := pgrepo.UnbanUser(context.Background(), tt.modLog)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			gotBanned, err := pgrepo.IsBannedFromVoxsphere(context.Background(), tt.modLog.VoxsphereID, tt.modLog.TargetID)
+			assert.NoError(t, err, "expect no error")
+			assert.False(t, gotBanned, "expect user not to be banned")
+		})
+	}
+}
+
+func TestRepo_PostRestrictions(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "voxsphere_bans.yml"}
+
+	tests := []struct {
+		name             string
+		postID           uuid.UUID
+		userID           uuid.UUID
+		lock             bool
+		wantRestrictions models.PostRestrictions
+		wantErr          error
+	}{
+		{
+			name:    "post not found :NEG",
+			postID:  uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			userID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantErr: moderationrepo.ErrModPostNotFound,
+		},
+		{
+			name:             "unrestricted :POS",
+			postID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantRestrictions: models.PostRestrictions{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		},
+		{
+			name:             "banned user :POS",
+			postID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userID:           uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantRestrictions: models.PostRestrictions{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Banned: true},
+		},
+		{
+			name:             "expired ban :POS",
+			postID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userID:           uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			wantRestrictions: models.PostRestrictions{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		},
+		{
+			name:             "locked post :POS",
+			postID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			lock:             true,
+			wantRestrictions: models.PostRestrictions{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Locked: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := moderationrepo.NewRepo(db)
+
+			if tt.lock {
+				if _, err := db.NewRaw("UPDATE posts SET locked = true WHERE id = ?", tt.postID).Exec(context.Background()); err != nil {
+					t.Fatal("failed to lock post:", err)
+				}
+			}
+
+			gotRestrictions, gotErr := pgrepo.PostRestrictions(context.Background(), tt.postID, tt.userID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantRestrictions, gotRestrictions, "expect restrictions to match")
+		})
+	}
+}
+
+func TestRepo_ModLogsByVoxsphereID(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml"}
+
+	db := setupPostgres(t, fixtureFiles...)
+	pgrepo := moderationrepo.NewRepo(db)
+	voxsphereID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	var wantIDs []uuid.UUID
+	for _, action := range []models.ModAction{models.ModActionLockPost, models.ModActionUnlockPost, models.ModActionRemovePost} {
+		modLog, err := pgrepo.ModeratePost(context.Background(), newModLog(action, uuid.MustParse("00000000-0000-0000-0000-000000000001")))
+		if err != nil {
+			t.Fatal("failed to moderate post:", err)
+		}
+		wantIDs = append([]uuid.UUID{modLog.ID}, wantIDs...)
+	}
+
+	gotModLogs, gotErr := pgrepo.ModLogsByVoxsphereID(context.Background(), voxsphereID, 0, 2)
+	assert.NoError(t, gotErr, "expect no error")
+
+	var gotIDs []uuid.UUID
+	for _, modLog := range gotModLogs {
+		assert.Equal(t, "John Doe", modLog.Moderator, "expect moderator name to match")
+		gotIDs = append(gotIDs, modLog.ID)
+	}
+	assert.Equal(t, wantIDs[:2], gotIDs, "expect newest mod logs first")
+
+	gotModLogs, gotErr = pgrepo.ModLogsByVoxsphereID(context.Background(), uuid.MustParse("00000000-0000-0000-0000-000000000002"), 0, 10)
+	assert.NoError(t, gotErr, "expect no error")
+	assert.Equal(t, []models.ModLog{}, gotModLogs, "expect empty mod log")
+}
@@ -8,7 +8,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/glowfi/voxpopuli/backend/internal/flairtext"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	automodrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/automod"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/driver/pgdriver"
@@ -23,60 +25,202 @@ var (
 	ErrPostNotFound                  = errors.New("post not found")
 	ErrPostDuplicateID               = errors.New("post duplicate id")
 	ErrPostParentTableRecordNotFound = errors.New("record does not exist in the parent table")
+	ErrPostInvalidSort               = errors.New("invalid post sort")
+	ErrPostInvalidSortWindow         = errors.New("invalid post sort window")
+	ErrPostAuthorNotFound            = errors.New("author not found")
+	ErrPostVoxsphereNotFound         = errors.New("voxsphere not found")
 )
 
-type PostRepository interface {
-	PostsPaginated(ctx context.Context, skip, limit int) ([]models.PostPaginated, error)
-	Posts(context.Context) ([]models.Post, error)
-	PostByID(context.Context, uuid.UUID) (models.Post, error)
-	AddPosts(context.Context, ...models.Post) ([]models.Post, error)
-	UpdatePost(context.Context, models.Post) (models.Post, error)
-	DeletePost(context.Context, uuid.UUID) error
+// postSortKeys holds the keys every post sort orders by. Posts are ranked by
+// the keys in descending order and then by their ID, which is also what the
+// keyset of a cursor is compared against. The keys are formatted with the
+// alias of the posts table and the unix time the feed is ranked at, and cast
+// to NUMERIC so that they survive a round trip through a cursor unchanged. A
+// cursor keeps the time of the first page, so that the ranks of the posts do
+// not drift from one page to the next.
+//
+// hot ranks posts by the order of magnitude of their ups offset by their age,
+// so that a post needs ten times the ups to outrank one 12.5 hours newer.
+// rising ranks the ups a post gained per hour of its age, and controversial
+// favours posts whose comment count rivals their ups. Downvoted posts count as
+// having no ups there, which keeps POWER away from negative bases and
+// exponents, and the comment count comes from the join of postSortJoins.
+var postSortKeys = map[models.PostSort][]string{
+	models.PostSortHot: {
+		`(SIGN(%[1]s.ups) * LOG(GREATEST(ABS(%[1]s.ups), 1)::NUMERIC) + (%[1]s.created_at_unix - 1134028003) / 45000.0)::NUMERIC`,
+	},
+	models.PostSortTop: {
+		`%[1]s.ups::NUMERIC`,
+		`%[1]s.created_at_unix::NUMERIC`,
+	},
+	models.PostSortNew: {
+		`%[1]s.created_at_unix::NUMERIC`,
+	},
+	models.PostSortRising: {
+		`(%[1]s.ups / ((%[2]d - %[1]s.created_at_unix) / 3600.0 + 2))::NUMERIC`,
+		`%[1]s.created_at_unix::NUMERIC`,
+	},
+	models.PostSortControversial: {
+		`POWER(
+                GREATEST(%[1]s.ups, 0) + pc.n_comments,
+                LEAST(GREATEST(%[1]s.ups, 0), pc.n_comments)::NUMERIC
+                / GREATEST(GREATEST(%[1]s.ups, 0), pc.n_comments, 1)
+              )::NUMERIC`,
+		`pc.n_comments::NUMERIC`,
+		`%[1]s.created_at_unix::NUMERIC`,
+	},
 }
 
-type Repo struct {
-	db *bun.DB
+// postSortJoins holds the joins the keys of a sort rely on, formatted with the
+// alias of the posts table. They are computed once per post rather than once
+// per key.
+var postSortJoins = map[models.PostSort]string{
+	models.PostSortControversial: `LEFT JOIN LATERAL (SELECT count(*) AS n_comments FROM comments c WHERE c.post_id = %[1]s.id) pc ON TRUE`,
 }
 
-func NewRepo(db *bun.DB) *Repo {
-	return &Repo{db: db}
+// postSortJoin returns the joins of the keys of sort for the posts table
+// aliased as alias.
+func postSortJoin(sort models.PostSort, alias string) string {
+	join, ok := postSortJoins[sort]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf(join, alias)
 }
 
-func (r *Repo) PostsPaginated(ctx context.Context, skip, limit int) ([]models.PostPaginated, error) {
-	fmt.Println(skip, limit)
-	var posts []models.PostPaginated
+// pinnedSortKey ranks the posts pinned by the moderators of a voxsphere above
+// the other posts in the feed of the voxsphere. It looks the post up by its ID
+// so that it works for both the posts table and a selection of posts.
+const pinnedSortKey = `(SELECT (pp.pinned_at IS NOT NULL)::INT FROM posts pp WHERE pp.id = %[1]s.id)::NUMERIC`
 
-	query := `
-        WITH
-          ps AS (
-            SELECT
-              p.id,
-              p.author_id,
-              p.voxsphere_id,
-              p.title,
-              p.text,
-              p.text_html,
-              p.ups,
-              p.over18,
-              p.spoiler,
-              p.created_at,
-              p.created_at_unix,
-              p.updated_at
-            FROM
-              posts p
-            ORDER BY
-              p.id
-            LIMIT
-              ?
-            OFFSET
-              ?
-          )
-        SELECT
-          ps.*,
+// postSortWindows holds the age limit of the posts ranked by a sort window.
+var postSortWindows = map[models.PostSortWindow]string{
+	models.PostSortWindowDay:   "1 day",
+	models.PostSortWindowWeek:  "1 week",
+	models.PostSortWindowMonth: "1 month",
+	models.PostSortWindowYear:  "1 year",
+	models.PostSortWindowAll:   "",
+}
+
+// risingWindow is the age limit of the posts ranked by the rising sort.
+const risingWindow = "1 day"
+
+// postSortClauses returns the WHERE clause of the posts aliased as p and the
+// sort keys for the given sort and window, as of the unix time now. The feed
+// of a single voxsphere shows its pinned posts first.
+func postSortClauses(sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, now int64) (string, []string, error) {
+	keys, ok := postSortKeys[sort]
+	if !ok {
+		return "", nil, ErrPostInvalidSort
+	}
+	if filter.VoxsphereID != uuid.Nil {
+		keys = append([]string{pinnedSortKey}, keys...)
+	}
+
+	var interval string
+	switch sort {
+	case models.PostSortTop, models.PostSortControversial:
+		if interval, ok = postSortWindows[window]; !ok {
+			return "", nil, ErrPostInvalidSortWindow
+		}
+	case models.PostSortRising:
+		interval = risingWindow
+	}
+
+	where := "TRUE"
+	if interval != "" {
+		where = fmt.Sprintf("p.created_at_unix >= EXTRACT(EPOCH FROM TO_TIMESTAMP(%d) - INTERVAL '%s')::BIGINT", now, interval)
+	}
+	return where, keys, nil
+}
+
+// postFilterClause returns the WHERE clause of the posts aliased as p for the
+// given filter along with its arguments. User input only ever reaches the
+// query through the arguments. Posts without media count as text posts, and
+// posts removed by a moderator are never part of a feed.
+func postFilterClause(filter models.PostFilter) (string, []interface{}) {
+	clauses := []string{"p.removed_at IS NULL"}
+	args := make([]interface{}, 0)
+
+	if filter.VoxsphereID != uuid.Nil {
+		clauses = append(clauses, "p.voxsphere_id = ?")
+		args = append(args, filter.VoxsphereID)
+	}
+	if len(filter.AuthorName) != 0 {
+		clauses = append(clauses, "p.author_id = (SELECT u.id FROM users u WHERE u.name = ?)")
+		args = append(args, filter.AuthorName)
+	}
+	if len(filter.VoxsphereIDs) != 0 {
+		clauses = append(clauses, "p.voxsphere_id IN (?)")
+		args = append(args, bun.In(filter.VoxsphereIDs))
+	}
+	if filter.MemberID != uuid.Nil {
+		clauses = append(clauses, "p.voxsphere_id IN (SELECT vm.voxsphere_id FROM voxsphere_members vm WHERE vm.user_id = ?)")
+		args = append(args, filter.MemberID)
+	}
+	if len(filter.MediaTypes) != 0 {
+		clauses = append(clauses, "COALESCE((SELECT pm.media_type::TEXT FROM post_medias pm WHERE pm.post_id = p.id LIMIT 1), 'text') IN (?)")
+		args = append(args, bun.In(filter.MediaTypes))
+	}
+	switch filter.NSFW {
+	case models.PostNSFWExclude:
+		clauses = append(clauses, "NOT p.over18")
+	case models.PostNSFWOnly:
+		clauses = append(clauses, "p.over18")
+	}
+	if filter.ExcludeSpoilers {
+		clauses = append(clauses, "NOT p.spoiler")
+	}
+	if filter.FlairID != uuid.Nil {
+		clauses = append(clauses, "EXISTS (SELECT 1 FROM post_post_flairs ppf WHERE ppf.post_id = p.id AND ppf.post_flair_id = ?)")
+		args = append(args, filter.FlairID)
+	}
+	if filter.ViewerID != uuid.Nil {
+		clauses = append(clauses, "NOT EXISTS (SELECT 1 FROM hidden_posts hp WHERE hp.post_id = p.id AND hp.user_id = ?)")
+		args = append(args, filter.ViewerID)
+	}
+
+	return strings.Join(clauses, " AND "), args
+}
+
+// postSavedColumn tells whether the post aliased as p was saved by the user
+// given as its argument, which comes before the arguments of the WHERE
+// clause. uuid.Nil saved nothing.
+const postSavedColumn = `p.id IN (SELECT sp.post_id FROM saved_posts sp WHERE sp.user_id = ?) AS saved`
+
+// postSortOrder returns the ORDER BY clause of the sort keys as of the unix
+// time now for the posts table aliased as alias.
+func postSortOrder(keys []string, alias string, now int64) string {
+	order := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		order = append(order, fmt.Sprintf(key, alias, now)+" DESC")
+	}
+	order = append(order, alias+".id DESC")
+	return strings.Join(order, ", ")
+}
+
+// postSortKeyset returns the row of the sort keys as of the unix time now and
+// the ID of the posts table aliased as alias, to be compared against the
+// keyset of a cursor.
+func postSortKeyset(keys []string, alias string, now int64) string {
+	keyset := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		keyset = append(keyset, fmt.Sprintf(key, alias, now))
+	}
+	keyset = append(keyset, alias+".id")
+	return "(" + strings.Join(keyset, ", ") + ")"
+}
+
+// postPaginatedColumns holds the derived columns of a paginated post. It
+// expects the selected post to be aliased as ps and its media as m. The
+// children of a multi media carry no link preview image, since the images of
+// a multi media are children of their own.
+const postPaginatedColumns = `
           (SELECT v.title FROM voxspheres v WHERE v.id=ps.voxsphere_id) as voxsphere,
           (SELECT u.name FROM users u WHERE u.id=ps.author_id) as author,
           (SELECT count(*) FROM comments WHERE comments.post_id=ps.id) as num_comments,
-          (SELECT count(*) FROM post_awards WHERE post_awards.post_id=ps.id) as num_awards,
+          (SELECT count(*) FROM post_awards WHERE post_awards.post_id=ps.id)
+            + (SELECT count(*) FROM award_grants WHERE award_grants.post_id=ps.id) as num_awards,
           COALESCE(m.media_type,'text') as media_type,
           CASE
             WHEN m.media_type = 'image' THEN (
@@ -86,6 +230,8 @@ func (r *Repo) PostsPaginated(ctx context.Context, skip, limit int) ([]models.Po
                 (
                   SELECT
                     JSON_BUILD_OBJECT(
+                      'media_type',
+                      'image',
                       'id',
                       imageMetadata.id,
                       'image_id',
@@ -131,6 +277,8 @@ func (r *Repo) PostsPaginated(ctx context.Context, skip, limit int) ([]models.Po
                 (
                   SELECT
                     JSON_BUILD_OBJECT(
+                      'media_type',
+                      'gif',
                       'id',
                       gifMetadata.id,
                       'gif_id',
@@ -176,6 +324,8 @@ func (r *Repo) PostsPaginated(ctx context.Context, skip, limit int) ([]models.Po
                 (
                   SELECT
                     JSON_BUILD_OBJECT(
+                      'media_type',
+                      'gallery',
                       'id',
                       galleryMetadata.id,
                       'gallery_id',
@@ -220,6 +370,8 @@ func (r *Repo) PostsPaginated(ctx context.Context, skip, limit int) ([]models.Po
               SELECT
                 JSON_AGG(
                   JSON_BUILD_OBJECT(
+                    'media_type',
+                    'video',
                     'id',
                     videos.id,
                     'media_id',
@@ -253,6 +405,8 @@ func (r *Repo) PostsPaginated(ctx context.Context, skip, limit int) ([]models.Po
               SELECT
                 JSON_AGG(
                   JSON_BUILD_OBJECT(
+                    'media_type',
+                    'link',
                     'id',
                     links.id,
                     'media_id',
@@ -321,20 +475,623 @@ func (r *Repo) PostsPaginated(ctx context.Context, skip, limit int) ([]models.Po
               WHERE
                 links.media_id = m.id
             )
+            WHEN m.media_type = 'multi' THEN (
+              SELECT
+                JSON_AGG(
+                  JSON_BUILD_OBJECT(
+                    'media_type', 'multi',
+                    'id', mm.id,
+                    'media_id', mm.media_id,
+                    'child_id', mm.child_id,
+                    'child_type', mm.child_type,
+                    'order_index', mm.order_index,
+                    'medias', CASE
+                      WHEN mm.child_type = 'image' THEN (
+                        SELECT
+                          JSON_AGG(
+                            JSON_BUILD_OBJECT(
+                              'media_type', 'image',
+                              'id', imageMetadata.id,
+                              'image_id', imageMetadata.image_id,
+                              'height', imageMetadata.height,
+                              'width', imageMetadata.width,
+                              'url', imageMetadata.url,
+                              'created_at', TO_CHAR(imageMetadata.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+                              'created_at_unix', imageMetadata.created_at_unix,
+                              'updated_at', TO_CHAR(imageMetadata.updated_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+                            )
+                            ORDER BY
+                              imageMetadata.height
+                          )
+                        FROM
+                          image_metadatas imageMetadata
+                        WHERE
+                          imageMetadata.image_id = mm.child_id
+                      )
+                      WHEN mm.child_type = 'gif' THEN (
+                        SELECT
+                          JSON_AGG(
+                            JSON_BUILD_OBJECT(
+                              'media_type', 'gif',
+                              'id', gifMetadata.id,
+                              'gif_id', gifMetadata.gif_id,
+                              'height', gifMetadata.height,
+                              'width', gifMetadata.width,
+                              'url', gifMetadata.url,
+                              'created_at', TO_CHAR(gifMetadata.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+                              'created_at_unix', gifMetadata.created_at_unix,
+                              'updated_at', TO_CHAR(gifMetadata.updated_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+                            )
+                            ORDER BY
+                              gifMetadata.height
+                          )
+                        FROM
+                          gif_metadatas gifMetadata
+                        WHERE
+                          gifMetadata.gif_id = mm.child_id
+                      )
+                      WHEN mm.child_type = 'gallery' THEN (
+                        SELECT
+                          JSON_AGG(
+                            JSON_BUILD_OBJECT(
+                              'media_type', 'gallery',
+                              'id', galleryMetadata.id,
+                              'gallery_id', galleryMetadata.gallery_id,
+                              'order_index', galleryMetadata.order_index,
+                              'height', galleryMetadata.height,
+                              'width', galleryMetadata.width,
+                              'url', galleryMetadata.url,
+                              'created_at', TO_CHAR(galleryMetadata.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+                              'created_at_unix', galleryMetadata.created_at_unix,
+                              'updated_at', TO_CHAR(galleryMetadata.updated_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+                            )
+                            ORDER BY
+                              galleryMetadata.order_index
+                          )
+                        FROM
+                          gallery_metadatas galleryMetadata
+                        WHERE
+                          galleryMetadata.gallery_id = mm.child_id
+                      )
+                      WHEN mm.child_type = 'video' THEN (
+                        SELECT
+                          JSON_AGG(
+                            JSON_BUILD_OBJECT(
+                              'media_type', 'video',
+                              'id', videos.id,
+                              'media_id', videos.media_id,
+                              'url', videos.url,
+                              'height', videos.height,
+                              'width', videos.width,
+                              'created_at', TO_CHAR(videos.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+                              'created_at_unix', videos.created_at_unix,
+                              'updated_at', TO_CHAR(videos.updated_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+                            )
+                          )
+                        FROM
+                          videos
+                        WHERE
+                          videos.id = mm.child_id
+                      )
+                      WHEN mm.child_type = 'link' THEN (
+                        SELECT
+                          JSON_AGG(
+                            JSON_BUILD_OBJECT(
+                              'media_type', 'link',
+                              'id', links.id,
+                              'media_id', links.media_id,
+                              'link', links.link,
+                              'image', NULL,
+                              'created_at', TO_CHAR(links.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+                              'created_at_unix', links.created_at_unix,
+                              'updated_at', TO_CHAR(links.updated_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+                            )
+                          )
+                        FROM
+                          links
+                        WHERE
+                          links.id = mm.child_id
+                      )
+                    END
+                  )
+                  ORDER BY
+                    mm.order_index
+                )
+              FROM
+                multi_medias mm
+              WHERE
+                mm.media_id = m.id
+            )
             ELSE NULL
           END AS medias
+`
+
+// awardCountsColumn aggregates the awards of the post aliased as ps, both
+// scraped and given by users, counted by award with the most given first.
+const awardCountsColumn = `
+          (
+            SELECT
+              JSON_AGG(
+                JSON_BUILD_OBJECT(
+                  'id',
+                  a.id,
+                  'title',
+                  a.title,
+                  'image_link',
+                  a.image_link,
+                  'price',
+                  a.price,
+                  'count',
+                  ac.count
+                )
+                ORDER BY
+                  ac.count DESC,
+                  a.title
+              )
+            FROM
+              (
+                SELECT
+                  given.award_id,
+                  count(*) AS count
+                FROM
+                  (
+                    SELECT pa.award_id FROM post_awards pa WHERE pa.post_id = ps.id
+                    UNION ALL
+                    SELECT ag.award_id FROM award_grants ag WHERE ag.post_id = ps.id
+                  ) AS given
+                GROUP BY
+                  given.award_id
+              ) AS ac
+              JOIN awards a ON a.id = ac.award_id
+          ) AS award_counts`
+
+// postFlairsColumn aggregates the flairs of the post aliased as ps, each
+// with its descriptions, emojis and custom emojis merged by order index.
+var postFlairsColumn = `
+          (
+            SELECT
+              JSON_AGG(
+                JSON_BUILD_OBJECT(
+                  'id',
+                  pf.id,
+                  'voxsphere_id',
+                  pf.voxsphere_id,
+                  'full_text',
+                  pf.full_text,
+                  'background_color',
+                  pf.background_color,
+                  'richtext',
+                  ` + flairtext.PostQuery + `
+                )
+              )
+            FROM
+              post_flairs pf
+              JOIN post_post_flairs ppf ON ppf.post_flair_id = pf.id
+            WHERE
+              ppf.post_id = ps.id
+          ) AS post_flairs`
+
+// authorFlairColumn picks the user flair the author of the post aliased as
+// ps wears in the voxsphere of the post, with its descriptions, emojis and
+// custom emojis merged by order index.
+var authorFlairColumn = `
+          (
+            SELECT
+              JSON_BUILD_OBJECT(
+                'id',
+                uf.id,
+                'voxsphere_id',
+                uf.voxsphere_id,
+                'voxsphere',
+                (SELECT v.title FROM voxspheres v WHERE v.id = uf.voxsphere_id),
+                'full_text',
+                uf.full_text,
+                'background_color',
+                COALESCE(uf.background_color, ''),
+                'richtext',
+                ` + flairtext.UserQuery + `
+              )
+            FROM
+              user_flairs uf
+              JOIN user_user_flairs uuf ON uuf.user_flair_id = uf.id
+            WHERE
+              uuf.user_id = ps.author_id
+              AND uf.voxsphere_id = ps.voxsphere_id
+            ORDER BY
+              uf.id
+            LIMIT
+              1
+          ) AS author_flair`
+
+type PostRepository interface {
+	PostsPaginated(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, skip, limit int) ([]models.PostPaginated, error)
+	PostsAfter(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, after *models.PostCursor, limit int) (models.PostFeed, error)
+	PostDetailByID(context.Context, uuid.UUID, uuid.UUID) (models.PostDetail, error)
+	PostsByIDs(context.Context, []uuid.UUID, uuid.UUID) ([]models.PostPaginated, error)
+	Posts(context.Context) ([]models.Post, error)
+	PostByID(context.Context, uuid.UUID) (models.Post, error)
+	AddPosts(context.Context, ...models.Post) ([]models.Post, error)
+	AddPostWithMedia(context.Context, models.Post, models.PostMedia, *models.AutomodVerdict, ...models.Link) (models.Post, error)
+	UpdatePost(context.Context, models.Post) (models.Post, error)
+	EditPost(context.Context, models.Post, *models.AutomodVerdict) (models.Post, error)
+	DeletePost(context.Context, uuid.UUID) error
+}
+
+type Repo struct {
+	db *bun.DB
+}
+
+func NewRepo(db *bun.DB) *Repo {
+	return &Repo{db: db}
+}
+
+func (r *Repo) PostsPaginated(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, skip, limit int) ([]models.PostPaginated, error) {
+	var posts []models.PostPaginated
+
+	if err := r.checkFilterTargets(ctx, filter); err != nil {
+		return []models.PostPaginated{}, err
+	}
+
+	now := time.Now().Unix()
+	where, keys, err := postSortClauses(sort, window, filter, now)
+	if err != nil {
+		return []models.PostPaginated{}, err
+	}
+	filterWhere, args := postFilterClause(filter)
+	where += " AND " + filterWhere
+	args = append(args, limit, skip)
+
+	query := `
+        WITH
+          ps AS (
+            SELECT
+              p.id,
+              p.author_id,
+              p.voxsphere_id,
+              p.title,
+              p.text,
+              p.text_html,
+              p.ups,
+              p.over18,
+              p.spoiler,
+              p.locked,
+              p.pinned_at IS NOT NULL AS pinned,
+              ` + postSavedColumn + `,
+              p.created_at,
+              p.created_at_unix,
+              p.updated_at
+            FROM
+              posts p
+              ` + postSortJoin(sort, "p") + `
+            WHERE
+              ` + where + `
+            ORDER BY
+              ` + postSortOrder(keys, "p", now) + `
+            LIMIT
+              ?
+            OFFSET
+              ?
+          )
+        SELECT
+          ps.*,
+          ` + postPaginatedColumns + `,
+          ` + awardCountsColumn + `,
+          ` + postFlairsColumn + `,
+          ` + authorFlairColumn + `
+        FROM
+          ps
+        LEFT JOIN post_medias m ON ps.id = m.post_id
+        ` + postSortJoin(sort, "ps") + `
+        ORDER BY
+          ` + postSortOrder(keys, "ps", now) + `;
+    `
+
+	args = append([]interface{}{filter.ViewerID}, args...)
+	_, err = r.db.NewRaw(query, args...).Exec(ctx, &posts)
+	if err != nil {
+		return []models.PostPaginated{}, err
+	}
+	for i := range posts {
+		renderPostEmojis(&posts[i])
+	}
+	return posts, nil
+}
+
+// checkFilterTargets fails with ErrPostAuthorNotFound or
+// ErrPostVoxsphereNotFound when filter lists the posts of an author or a
+// voxsphere that does not exist, so that an unknown author or voxsphere is
+// told apart from one without posts.
+func (r *Repo) checkFilterTargets(ctx context.Context, filter models.PostFilter) error {
+	if len(filter.AuthorName) != 0 {
+		var authorExists bool
+		if err := r.db.NewRaw(`SELECT EXISTS (SELECT 1 FROM users u WHERE u.name = ?)`, filter.AuthorName).Scan(ctx, &authorExists); err != nil {
+			return err
+		}
+		if !authorExists {
+			return ErrPostAuthorNotFound
+		}
+	}
+
+	if filter.VoxsphereID != uuid.Nil {
+		var voxsphereExists bool
+		if err := r.db.NewRaw(`SELECT EXISTS (SELECT 1 FROM voxspheres v WHERE v.id = ?)`, filter.VoxsphereID).Scan(ctx, &voxsphereExists); err != nil {
+			return err
+		}
+		if !voxsphereExists {
+			return ErrPostVoxsphereNotFound
+		}
+	}
+	return nil
+}
+
+// postPaginatedKeyed is a paginated post along with its sort keys.
+type postPaginatedKeyed struct {
+	models.PostPaginated
+	SortKey []string `bun:"sort_key"`
+}
+
+// PostsAfter returns up to limit posts ranked right after the after cursor,
+// or the first posts when after is nil, along with the cursor of the next
+// page when there is one. A cursor only resumes the feed of the sort, window
+// and filter it was handed out for.
+func (r *Repo) PostsAfter(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, after *models.PostCursor, limit int) (models.PostFeed, error) {
+	var posts []postPaginatedKeyed
+
+	if err := r.checkFilterTargets(ctx, filter); err != nil {
+		return models.PostFeed{}, err
+	}
+
+	// the pages after the first are ranked as of the time of the first one
+	now := time.Now().Unix()
+	if after != nil {
+		if after.Sort != sort || after.Window != window || after.Filter != filter.Key() {
+			return models.PostFeed{}, models.ErrInvalidPostCursor
+		}
+		now = after.RankedAt
+	}
+
+	where, keys, err := postSortClauses(sort, window, filter, now)
+	if err != nil {
+		return models.PostFeed{}, err
+	}
+	filterWhere, args := postFilterClause(filter)
+	where += " AND " + filterWhere
+
+	if after != nil {
+		if len(after.SortKey) != len(keys) {
+			return models.PostFeed{}, models.ErrInvalidPostCursor
+		}
+		placeholders := make([]string, 0, len(keys)+1)
+		for _, key := range after.SortKey {
+			placeholders = append(placeholders, "?::NUMERIC")
+			args = append(args, key)
+		}
+		placeholders = append(placeholders, "?")
+		args = append(args, after.ID)
+		where += " AND " + postSortKeyset(keys, "p", now) + " < (" + strings.Join(placeholders, ", ") + ")"
+	}
+	// fetch one more post than asked for to know whether there is a next page
+	args = append(args, limit+1)
+
+	sortKey := make([]string, 0, len(keys))
+	for _, key := range keys {
+		sortKey = append(sortKey, fmt.Sprintf(key, "ps", now)+"::TEXT")
+	}
+
+	query := `
+        WITH
+          ps AS (
+            SELECT
+              p.id,
+              p.author_id,
+              p.voxsphere_id,
+              p.title,
+              p.text,
+              p.text_html,
+              p.ups,
+              p.over18,
+              p.spoiler,
+              p.locked,
+              p.pinned_at IS NOT NULL AS pinned,
+              ` + postSavedColumn + `,
+              p.created_at,
+              p.created_at_unix,
+              p.updated_at
+            FROM
+              posts p
+              ` + postSortJoin(sort, "p") + `
+            WHERE
+              ` + where + `
+            ORDER BY
+              ` + postSortOrder(keys, "p", now) + `
+            LIMIT
+              ?
+          )
+        SELECT
+          ps.*,
+          ` + postPaginatedColumns + `,
+          ` + awardCountsColumn + `,
+          ` + postFlairsColumn + `,
+          ` + authorFlairColumn + `,
+          JSON_BUILD_ARRAY(` + strings.Join(sortKey, ", ") + `) AS sort_key
+        FROM
+          ps
+        LEFT JOIN post_medias m ON ps.id = m.post_id
+        ` + postSortJoin(sort, "ps") + `
+        ORDER BY
+          ` + postSortOrder(keys, "ps", now) + `;
+    `
+
+	args = append([]interface{}{filter.ViewerID}, args...)
+	if _, err := r.db.NewRaw(query, args...).Exec(ctx, &posts); err != nil {
+		return models.PostFeed{}, err
+	}
+
+	hasNext := len(posts) > limit
+	posts = posts[:min(len(posts), limit)]
+
+	feed := models.PostFeed{
+		Posts: make([]models.PostPaginated, 0, len(posts)),
+	}
+	for _, post := range posts {
+		renderPostEmojis(&post.PostPaginated)
+		feed.Posts = append(feed.Posts, post.PostPaginated)
+	}
+	if hasNext && len(posts) > 0 {
+		last := posts[len(posts)-1]
+		nextCursor := models.PostCursor{
+			Sort:     sort,
+			Window:   window,
+			Filter:   filter.Key(),
+			RankedAt: now,
+			SortKey:  last.SortKey,
+			ID:       last.ID,
+		}.Encode()
+		feed.NextCursor = &nextCursor
+	}
+	return feed, nil
+}
+
+// PostDetailByID returns the post of ID along with its awards, unless a
+// moderator removed it, marked as saved when the user of viewerID saved it.
+func (r *Repo) PostDetailByID(ctx context.Context, ID, viewerID uuid.UUID) (models.PostDetail, error) {
+	var post models.PostDetail
+
+	query := `
+        WITH
+          ps AS (
+            SELECT
+              p.id,
+              p.author_id,
+              p.voxsphere_id,
+              p.title,
+              p.text,
+              p.text_html,
+              p.ups,
+              p.over18,
+              p.spoiler,
+              p.locked,
+              p.pinned_at IS NOT NULL AS pinned,
+              ` + postSavedColumn + `,
+              p.created_at,
+              p.created_at_unix,
+              p.updated_at
+            FROM
+              posts p
+            WHERE
+              p.id = ?
+              AND p.removed_at IS NULL
+          )
+        SELECT
+          ps.*,
+          ` + postPaginatedColumns + `,
+          ` + awardCountsColumn + `,
+          ` + postFlairsColumn + `,
+          ` + authorFlairColumn + `,
+          (
+            SELECT
+              JSON_AGG(
+                JSON_BUILD_OBJECT(
+                  'id',
+                  a.id,
+                  'title',
+                  a.title,
+                  'image_link',
+                  a.image_link,
+                  'price',
+                  a.price
+                )
+                ORDER BY
+                  a.title
+              )
+            FROM
+              awards a
+              JOIN post_awards pa ON pa.award_id = a.id
+            WHERE
+              pa.post_id = ps.id
+          ) AS awards
         FROM
           ps
         LEFT JOIN post_medias m ON ps.id = m.post_id;
     `
 
-	_, err := r.db.NewRaw(query, limit, skip).Exec(ctx, &posts)
+	_, err := r.db.NewRaw(query, viewerID, ID).Exec(ctx, &post)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.PostDetail{}, ErrPostNotFound
+		}
+		return models.PostDetail{}, err
+	}
+
+	renderPostEmojis(&post.PostPaginated)
+
+	return post, nil
+}
+
+// PostsByIDs returns the posts of IDs that were not removed, in no
+// particular order, marked as saved when the user of viewerID saved them.
+func (r *Repo) PostsByIDs(ctx context.Context, IDs []uuid.UUID, viewerID uuid.UUID) ([]models.PostPaginated, error) {
+	posts := []models.PostPaginated{}
+	if len(IDs) == 0 {
+		return posts, nil
+	}
+
+	query := `
+        WITH
+          ps AS (
+            SELECT
+              p.id,
+              p.author_id,
+              p.voxsphere_id,
+              p.title,
+              p.text,
+              p.text_html,
+              p.ups,
+              p.over18,
+              p.spoiler,
+              p.locked,
+              p.pinned_at IS NOT NULL AS pinned,
+              ` + postSavedColumn + `,
+              p.created_at,
+              p.created_at_unix,
+              p.updated_at
+            FROM
+              posts p
+            WHERE
+              p.id IN (?)
+              AND p.removed_at IS NULL
+          )
+        SELECT
+          ps.*,
+          ` + postPaginatedColumns + `,
+          ` + awardCountsColumn + `,
+          ` + postFlairsColumn + `,
+          ` + authorFlairColumn + `
+        FROM
+          ps
+        LEFT JOIN post_medias m ON ps.id = m.post_id;
+    `
+
+	if _, err := r.db.NewRaw(query, viewerID, bun.In(IDs)).Exec(ctx, &posts); err != nil {
 		return []models.PostPaginated{}, err
 	}
+	for i := range posts {
+		renderPostEmojis(&posts[i])
+	}
 	return posts, nil
 }
 
+// renderPostEmojis replaces the stored code points of the standard emojis in
+// the post flairs and the author flair of post with the emojis themselves.
+func renderPostEmojis(post *models.PostPaginated) {
+	for i := range post.PostFlairs {
+		flairtext.RenderEmojis(post.PostFlairs[i].Richtext)
+	}
+	if post.AuthorFlair != nil {
+		flairtext.RenderEmojis(post.AuthorFlair.Richtext)
+	}
+}
+
 func (r *Repo) Posts(ctx context.Context) ([]models.Post, error) {
 	var posts []models.Post
 
@@ -397,6 +1154,79 @@ func (r *Repo) PostByID(ctx context.Context, ID uuid.UUID) (models.Post, error)
 }
 
 func (r *Repo) AddPosts(ctx context.Context, posts ...models.Post) ([]models.Post, error) {
+	return addPosts(ctx, r.db, posts...)
+}
+
+// AddPostWithMedia adds post along with its post media, the links of a link
+// post and the automod verdict on it when there is one, so that a post is
+// never seen without its media or before automod judged it.
+func (r *Repo) AddPostWithMedia(ctx context.Context, post models.Post, postMedia models.PostMedia, verdict *models.AutomodVerdict, links ...models.Link) (models.Post, error) {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		posts, err := addPosts(ctx, tx, post)
+		if err != nil {
+			return err
+		}
+		post = posts[0]
+
+		postMediaQuery := `
+            INSERT INTO
+                post_medias (
+                    id,
+                    post_id,
+                    media_type
+                )
+            VALUES
+                (?, ?, ?);
+        `
+		if _, err := tx.NewRaw(postMediaQuery, postMedia.ID, post.ID, postMedia.MediaType).Exec(ctx); err != nil {
+			return err
+		}
+
+		for _, link := range links {
+			linkQuery := `
+                INSERT INTO
+                    links (
+                        id,
+                        media_id,
+                        link,
+                        created_at,
+                        created_at_unix,
+                        updated_at
+                    )
+                VALUES
+                    (?, ?, ?, ?, ?, ?);
+            `
+			if _, err := tx.NewRaw(linkQuery,
+				link.ID,
+				postMedia.ID,
+				link.Link,
+				post.CreatedAt,
+				post.CreatedAtUnix,
+				post.UpdatedAt,
+			).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		if verdict == nil {
+			return nil
+		}
+		return automodrepo.ApplyVerdictTx(ctx, tx, *verdict)
+	})
+	if err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgUniqueViolation {
+			return models.Post{}, ErrPostDuplicateID
+		}
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+			return models.Post{}, ErrPostParentTableRecordNotFound
+		}
+		return models.Post{}, err
+	}
+	return post, nil
+}
+
+func addPosts(ctx context.Context, db bun.IDB, posts ...models.Post) ([]models.Post, error) {
 	query := `
         INSERT INTO
             posts (
@@ -449,9 +1279,9 @@ func (r *Repo) AddPosts(ctx context.Context, posts ...models.Post) ([]models.Pos
 
 	// Join the placeholders
 	query += strings.Join(placeholders, ", ")
-	query += " RETURNING *"
+	query += " RETURNING id, author_id, voxsphere_id, title, text, text_html, ups, over18, spoiler, created_at, created_at_unix, updated_at"
 
-	if _, err := r.db.NewRaw(query, args...).Exec(ctx, &posts); err != nil {
+	if _, err := db.NewRaw(query, args...).Exec(ctx, &posts); err != nil {
 		var pgdriverErr pgdriver.Error
 		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgUniqueViolation {
 			return nil, ErrPostDuplicateID
@@ -466,6 +1296,77 @@ func (r *Repo) AddPosts(ctx context.Context, posts ...models.Post) ([]models.Pos
 }
 
 func (r *Repo) UpdatePost(ctx context.Context, post models.Post) (models.Post, error) {
+	return updatePost(ctx, r.db, post)
+}
+
+// EditPost writes the text and flags of post along with the automod verdict on
+// the edit when there is one, so that an edit is never seen before automod
+// judged it.
+func (r *Repo) EditPost(ctx context.Context, post models.Post, verdict *models.AutomodVerdict) (models.Post, error) {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		if post, err = editPost(ctx, tx, post); err != nil {
+			return err
+		}
+
+		if verdict == nil {
+			return nil
+		}
+		return automodrepo.ApplyVerdictTx(ctx, tx, *verdict)
+	})
+	if err != nil {
+		return models.Post{}, err
+	}
+	return post, nil
+}
+
+// editPost writes the fields an author can edit of post and returns the post
+// as stored. The other columns are left alone, so that votes cast since post
+// was read are kept. Removed posts can not be edited and are reported as not
+// found.
+func editPost(ctx context.Context, db bun.IDB, post models.Post) (models.Post, error) {
+	var edited models.Post
+
+	query := `
+        UPDATE
+            posts
+        SET
+            text = ?,
+            text_html = ?,
+            over18 = ?,
+            spoiler = ?,
+            updated_at = ?
+        WHERE
+            id = ?
+            AND removed_at IS NULL
+        RETURNING id, author_id, voxsphere_id, title, text, text_html, ups, over18, spoiler, created_at, created_at_unix, updated_at
+    `
+
+	res, err := db.NewRaw(query,
+		post.Text,
+		post.TextHtml,
+		post.Over18,
+		post.Spoiler,
+		time.Now(),
+		post.ID,
+	).Exec(ctx, &edited)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Post{}, ErrPostNotFound
+		}
+		return models.Post{}, err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return models.Post{}, err
+	}
+	if rowsAffected == 0 {
+		return models.Post{}, ErrPostNotFound
+	}
+	return edited, nil
+}
+
+func updatePost(ctx context.Context, db bun.IDB, post models.Post) (models.Post, error) {
 	query := `
         UPDATE
             posts
@@ -481,13 +1382,13 @@ func (r *Repo) UpdatePost(ctx context.Context, post models.Post) (models.Post, e
             updated_at = ?
         WHERE
             id = ?
-        RETURNING *
+        RETURNING id, author_id, voxsphere_id, title, text, text_html, ups, over18, spoiler, created_at, created_at_unix, updated_at
     `
 
 	timestamp := time.Now()
 	post.UpdatedAt = timestamp
 
-	res, err := r.db.NewRaw(query,
+	res, err := db.NewRaw(query,
 		post.AuthorID,
 		post.VoxsphereID,
 		post.Title,
@@ -3,11 +3,11 @@ package postrepo_test
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
 	"os"
 	"slices"
 	"testing"
+	"text/template"
 	"time"
 
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
@@ -59,7 +59,21 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	db.RegisterModel((*models.GalleryMetadata)(nil))
 	db.RegisterModel((*models.Video)(nil))
 	db.RegisterModel((*models.Link)(nil))
+	db.RegisterModel((*models.MultiMedia)(nil))
 	db.RegisterModel((*models.Comment)(nil))
+	db.RegisterModel((*models.Emoji)(nil))
+	db.RegisterModel((*models.CustomEmoji)(nil))
+	db.RegisterModel((*models.PostFlair)(nil))
+	db.RegisterModel((*models.PostPostFlair)(nil))
+	db.RegisterModel((*models.PostFlairDescription)(nil))
+	db.RegisterModel((*models.PostFlairEmoji)(nil))
+	db.RegisterModel((*models.PostFlairCustomEmoji)(nil))
+	db.RegisterModel((*models.Award)(nil))
+	db.RegisterModel((*models.PostAward)(nil))
+	db.RegisterModel((*models.AwardGrant)(nil))
+	db.RegisterModel((*models.VoxsphereMember)(nil))
+	db.RegisterModel((*models.SavedPost)(nil))
+	db.RegisterModel((*models.HiddenPost)(nil))
 
 	// drop all rows of the topics,voxspheres table
 	_, err := db.NewTruncateTable().Cascade().Model((*models.Topic)(nil)).Exec(context.Background())
@@ -105,9 +119,22 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.Comment)(nil)).Exec(context.Background()); err != nil {
 		t.Fatal("truncate table failed:", err)
 	}
+	if _, err := db.NewTruncateTable().Cascade().Model((*models.Emoji)(nil)).Exec(context.Background()); err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
+	if _, err := db.NewTruncateTable().Cascade().Model((*models.Award)(nil)).Exec(context.Background()); err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
 
 	// load fixture
-	fixture := dbfixture.New(db)
+	fixture := dbfixture.New(db, dbfixture.WithTemplateFuncs(template.FuncMap{
+		"hoursAgo": func(hours int64) time.Time {
+			return time.Now().UTC().Add(-time.Duration(hours) * time.Hour).Truncate(time.Microsecond)
+		},
+		"hoursAgoUnix": func(hours int64) int64 {
+			return time.Now().Add(-time.Duration(hours) * time.Hour).Unix()
+		},
+	}))
 	if err := fixture.Load(context.Background(), os.DirFS("testdata"), fixtureFiles...); err != nil {
 		t.Fatal("failed to load fixtures", err)
 	}
@@ -132,16 +159,22 @@ func assertTimeWithinRange(t *testing.T, time, start, end time.Time) {
 	assert.WithinRange(t, time, start, end)
 }
 
-// mapToStruct is a helper function to unmarshal the content into the appropriate struct
-func mapToStruct(content interface{}, out interface{}) error {
-	contentBytes, err := json.Marshal(content)
-	if err != nil {
-		return err
+// mediasOf asserts every media of medias to be a T and returns them as such.
+func mediasOf[T models.Media](t *testing.T, medias models.Medias) []T {
+	t.Helper()
+
+	var typed []T
+	for _, media := range medias {
+		m, ok := media.(T)
+		if !ok {
+			t.Fatalf("expect media to be a %T, got %T", m, media)
+		}
+		typed = append(typed, m)
 	}
-	return json.Unmarshal(contentBytes, out)
+	return typed
 }
 
-func assertPostMedias(t *testing.T, expectedMedias, actualMedias []any, mediaType models.MediaType) {
+func assertPostMedias(t *testing.T, expectedMedias, actualMedias models.Medias, mediaType models.MediaType) {
 	t.Helper()
 
 	if len(expectedMedias) != len(actualMedias) {
@@ -150,74 +183,22 @@ func assertPostMedias(t *testing.T, expectedMedias, actualMedias []any, mediaTyp
 
 	switch mediaType {
 	case models.MediaTypeImage:
-		var expectedImages []models.ImageMetadata
-		var actualImages []models.ImageMetadata
-
-		if err := mapToStruct(expectedMedias, &expectedImages); err != nil {
-			t.Fatalf("failed to cast expected media to image metadata for media type %s: %v", mediaType, err)
-		}
-
-		if err := mapToStruct(actualMedias, &actualImages); err != nil {
-			t.Fatalf("failed to cast actual media to image metadata for media type %s: %v", mediaType, err)
-		}
-
-		mediarepo.AssertImageMetadatasWithTimestamp(t, expectedImages, actualImages)
+		mediarepo.AssertImageMetadatasWithTimestamp(t, mediasOf[models.ImageMetadata](t, expectedMedias), mediasOf[models.ImageMetadata](t, actualMedias))
 
 	case models.MediaTypeGif:
-		var expectedGifs []models.GifMetadata
-		var actualGifs []models.GifMetadata
-
-		if err := mapToStruct(expectedMedias, &expectedGifs); err != nil {
-			t.Fatalf("failed to cast expected media to gif metadata for media type %s: %v", mediaType, err)
-		}
-
-		if err := mapToStruct(actualMedias, &actualGifs); err != nil {
-			t.Fatalf("failed to cast actual media to gif metadata for media type %s: %v", mediaType, err)
-		}
-
-		mediarepo.AssertGifMetadatasWithTimestamp(t, expectedGifs, actualGifs)
+		mediarepo.AssertGifMetadatasWithTimestamp(t, mediasOf[models.GifMetadata](t, expectedMedias), mediasOf[models.GifMetadata](t, actualMedias))
 
 	case models.MediaTypeGallery:
-		var expectedGalleries []models.GalleryMetadata
-		var actualGalleries []models.GalleryMetadata
-
-		if err := mapToStruct(expectedMedias, &expectedGalleries); err != nil {
-			t.Fatalf("failed to cast expected media to gallery metadata for media type %s: %v", mediaType, err)
-		}
-
-		if err := mapToStruct(actualMedias, &actualGalleries); err != nil {
-			t.Fatalf("failed to cast actual media to gallery metadata for media type %s: %v", mediaType, err)
-		}
-
-		mediarepo.AssertGalleryMetadatasWithTimestamp(t, expectedGalleries, actualGalleries)
+		mediarepo.AssertGalleryMetadatasWithTimestamp(t, mediasOf[models.GalleryMetadata](t, expectedMedias), mediasOf[models.GalleryMetadata](t, actualMedias))
 
 	case models.MediaTypeVideo:
-		var expectedVideos []models.Video
-		var actualVideos []models.Video
-
-		if err := mapToStruct(expectedMedias, &expectedVideos); err != nil {
-			t.Fatalf("failed to cast expected media to video for media type %s: %v", mediaType, err)
-		}
-
-		if err := mapToStruct(actualMedias, &actualVideos); err != nil {
-			t.Fatalf("failed to cast actual media to video for media type %s: %v", mediaType, err)
-		}
-
-		mediarepo.AssertVideosWithTimestamp(t, expectedVideos, actualVideos)
+		mediarepo.AssertVideosWithTimestamp(t, mediasOf[models.Video](t, expectedMedias), mediasOf[models.Video](t, actualMedias))
 
 	case models.MediaTypeLink:
-		var expectedLinks []models.Link
-		var actualLinks []models.Link
-
-		if err := mapToStruct(expectedMedias, &expectedLinks); err != nil {
-			t.Fatalf("failed to cast expected media to link for media type %s: %v", mediaType, err)
-		}
+		mediarepo.AssertLinksWitTimestamp(t, mediasOf[models.Link](t, expectedMedias), mediasOf[models.Link](t, actualMedias))
 
-		if err := mapToStruct(actualMedias, &actualLinks); err != nil {
-			t.Fatalf("failed to cast actual media to link for media type %s: %v", mediaType, err)
-		}
-
-		mediarepo.AssertLinksWitTimestamp(t, expectedLinks, actualLinks)
+	case models.MediaTypeMulti:
+		assert.Equal(t, mediasOf[models.MultiMedia](t, expectedMedias), mediasOf[models.MultiMedia](t, actualMedias), "expect multi medias to match")
 
 	default:
 		t.Fatal("unsupported media type")
@@ -700,6 +681,172 @@ func TestRepo_AddPosts(t *testing.T) {
 	}
 }
 
+func TestRepo_AddPostWithMedia(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml"}
+
+	type args struct {
+		post      models.Post
+		postMedia models.PostMedia
+		links     []models.Link
+	}
+	tests := []struct {
+		name           string
+		args           args
+		wantPost       models.Post
+		wantPostMedias []models.PostMedia
+		wantLinks      []string
+		wantErr        error
+	}{
+		{
+			name: "text post :POS",
+			args: args{
+				post: models.Post{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:       "Example Post Title 3",
+					Text:        "This is an example post text 3.",
+					TextHtml:    "<p>This is an example post text 3.</p>",
+				},
+				postMedia: models.PostMedia{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					MediaType: models.MediaTypeText,
+				},
+			},
+			wantPost: models.Post{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Title:       "Example Post Title 3",
+				Text:        "This is an example post text 3.",
+				TextHtml:    "<p>This is an example post text 3.</p>",
+			},
+			wantPostMedias: []models.PostMedia{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					PostID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					MediaType: models.MediaTypeText,
+				},
+			},
+			wantLinks: nil,
+			wantErr:   nil,
+		},
+		{
+			name: "link post :POS",
+			args: args{
+				post: models.Post{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:       "Example Post Title 3",
+				},
+				postMedia: models.PostMedia{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					MediaType: models.MediaTypeLink,
+				},
+				links: []models.Link{
+					{
+						ID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						Link: "https://example.com",
+					},
+				},
+			},
+			wantPost: models.Post{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Title:       "Example Post Title 3",
+			},
+			wantPostMedias: []models.PostMedia{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					PostID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					MediaType: models.MediaTypeLink,
+				},
+			},
+			wantLinks: []string{"https://example.com"},
+			wantErr:   nil,
+		},
+		{
+			name: "duplicate post id :NEG",
+			args: args{
+				post: models.Post{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:       "Example Post Title 3",
+				},
+				postMedia: models.PostMedia{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					MediaType: models.MediaTypeText,
+				},
+			},
+			wantPost:       models.Post{},
+			wantPostMedias: nil,
+			wantLinks:      nil,
+			wantErr:        postrepo.ErrPostDuplicateID,
+		},
+		{
+			name: "voxsphere does not exist in parent table :NEG",
+			args: args{
+				post: models.Post{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+					Title:       "Example Post Title 3",
+				},
+				postMedia: models.PostMedia{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					MediaType: models.MediaTypeLink,
+				},
+				links: []models.Link{
+					{
+						ID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						Link: "https://example.com",
+					},
+				},
+			},
+			wantPost:       models.Post{},
+			wantPostMedias: nil,
+			wantLinks:      nil,
+			wantErr:        postrepo.ErrPostParentTableRecordNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := postrepo.NewRepo(db)
+			mediaRepo := mediarepo.NewRepo(db)
+
+			gotPost, gotErr := pgrepo.AddPostWithMedia(context.Background(), tt.args.post, tt.args.postMedia, nil, tt.args.links...)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			gotPost.CreatedAt = time.Time{}
+			gotPost.CreatedAtUnix = 0
+			gotPost.UpdatedAt = time.Time{}
+			assert.Equal(t, tt.wantPost, gotPost, "expect post to match")
+
+			// the medias of a post are added along with it or not at all
+			gotPostMedias, err := mediaRepo.PostMedias(context.Background())
+			if err != nil {
+				t.Fatal("failed to get post medias:", err)
+			}
+			assert.ElementsMatch(t, tt.wantPostMedias, gotPostMedias, "expect post medias to match")
+
+			gotLinks, err := mediaRepo.Links(context.Background())
+			if err != nil {
+				t.Fatal("failed to get links:", err)
+			}
+			var gotLinkUrls []string
+			for _, link := range gotLinks {
+				assert.Equal(t, tt.args.postMedia.ID, link.MediaID, "expect link to belong to the post media")
+				gotLinkUrls = append(gotLinkUrls, link.Link)
+			}
+			assert.Equal(t, tt.wantLinks, gotLinkUrls, "expect links to match")
+		})
+	}
+}
+
 func TestRepo_UpdatePost(t *testing.T) {
 	type args struct {
 		post models.Post
@@ -956,6 +1103,82 @@ func TestRepo_UpdatePost(t *testing.T) {
 	}
 }
 
+func TestRepo_EditPost(t *testing.T) {
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name     string
+		removed  bool
+		post     models.Post
+		wantPost models.Post
+		wantErr  error
+	}{
+		{
+			name: "post not found :NEG",
+			post: models.Post{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				Text: "edited",
+			},
+			wantPost: models.Post{},
+			wantErr:  postrepo.ErrPostNotFound,
+		},
+		{
+			name:    "removed post :NEG",
+			removed: true,
+			post: models.Post{
+				ID:   postID,
+				Text: "edited",
+			},
+			wantPost: models.Post{},
+			wantErr:  postrepo.ErrPostNotFound,
+		},
+		{
+			name: "stale fields are not written :POS",
+			post: models.Post{
+				ID:          postID,
+				AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Title:       "stale title",
+				Text:        "edited",
+				TextHtml:    "<p>edited</p>",
+				Ups:         0,
+				Over18:      true,
+				Spoiler:     true,
+			},
+			wantPost: models.Post{
+				ID:          postID,
+				AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Title:       "Example Post Title 1",
+				Text:        "edited",
+				TextHtml:    "<p>edited</p>",
+				Ups:         10,
+				Over18:      true,
+				Spoiler:     true,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml")
+			pgrepo := postrepo.NewRepo(db)
+			if tt.removed {
+				if _, err := db.NewRaw("UPDATE posts SET removed_at = NOW() WHERE id = ?", tt.post.ID).Exec(context.Background()); err != nil {
+					t.Fatal("failed to remove post:", err)
+				}
+			}
+
+			gotPost, gotErr := pgrepo.EditPost(context.Background(), tt.post, nil)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			// the timestamps come from the fixture and the edit
+			gotPost.CreatedAt, gotPost.CreatedAtUnix, gotPost.UpdatedAt = time.Time{}, 0, time.Time{}
+			assert.Equal(t, tt.wantPost, gotPost, "expect edited post to match")
+		})
+	}
+}
+
 func TestRepo_DeletePost(t *testing.T) {
 	type args struct {
 		ID uuid.UUID
@@ -1116,8 +1339,11 @@ func TestRepo_ForeignKeyCascade(t *testing.T) {
 
 func TestRepo_PostsPaginated(t *testing.T) {
 	type args struct {
-		skip  int
-		limit int
+		sort   models.PostSort
+		window models.PostSortWindow
+		filter models.PostFilter
+		skip   int
+		limit  int
 	}
 	tests := []struct {
 		name               string
@@ -1145,8 +1371,10 @@ func TestRepo_PostsPaginated(t *testing.T) {
 				"comments.yml",
 			},
 			args: args{
-				skip:  0,
-				limit: 5,
+				sort:   models.PostSortNew,
+				window: models.PostSortWindowAll,
+				skip:   0,
+				limit:  5,
 			},
 			wantPostsPaginated: []models.PostPaginated{
 				{
@@ -1159,7 +1387,7 @@ func TestRepo_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 1.",
 					TextHtml:    "This is an example post text 1 in HTML.",
 					MediaType:   models.MediaTypeImage,
-					Medias: []any{
+					Medias: models.Medias{
 						models.ImageMetadata{
 							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							ImageID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
@@ -1200,7 +1428,7 @@ func TestRepo_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 2.",
 					TextHtml:    "This is an example post text 2 in HTML.",
 					MediaType:   models.MediaTypeGif,
-					Medias: []any{
+					Medias: models.Medias{
 						models.GifMetadata{
 							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							GifID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
@@ -1241,7 +1469,7 @@ func TestRepo_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 3.",
 					TextHtml:    "This is an example post text 3 in HTML.",
 					MediaType:   models.MediaTypeGallery,
-					Medias: []any{
+					Medias: models.Medias{
 						models.GalleryMetadata{
 							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							GalleryID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
@@ -1306,7 +1534,7 @@ func TestRepo_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 4.",
 					TextHtml:    "This is an example post text 4 in HTML.",
 					MediaType:   models.MediaTypeVideo,
-					Medias: []any{
+					Medias: models.Medias{
 						models.Video{
 							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
@@ -1337,7 +1565,7 @@ func TestRepo_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 5.",
 					TextHtml:    "This is an example post text 5 in HTML.",
 					MediaType:   models.MediaTypeLink,
-					Medias: []any{
+					Medias: models.Medias{
 						models.Link{
 							ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							MediaID: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
@@ -1382,7 +1610,7 @@ func TestRepo_PostsPaginated(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "paginated posts skip 3 limit 2 :POS",
+			name: "paginated posts top of all time skip 0 limit 2 :POS",
 			fixtureFiles: []string{
 				"topics.yml",
 				"voxspheres.yml",
@@ -1400,8 +1628,10 @@ func TestRepo_PostsPaginated(t *testing.T) {
 				"comments.yml",
 			},
 			args: args{
-				skip:  3,
-				limit: 2,
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowAll,
+				skip:   0,
+				limit:  2,
 			},
 			wantPostsPaginated: []models.PostPaginated{
 				{
@@ -1412,7 +1642,7 @@ func TestRepo_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 4.",
 					TextHtml:    "This is an example post text 4 in HTML.",
 					MediaType:   models.MediaTypeVideo,
-					Medias: []any{
+					Medias: models.Medias{
 						models.Video{
 							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
@@ -1439,7 +1669,7 @@ func TestRepo_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 5.",
 					TextHtml:    "This is an example post text 5 in HTML.",
 					MediaType:   models.MediaTypeLink,
-					Medias: []any{
+					Medias: models.Medias{
 						models.Link{
 							ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							MediaID: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
@@ -1502,8 +1732,10 @@ func TestRepo_PostsPaginated(t *testing.T) {
 				"comments.yml",
 			},
 			args: args{
-				skip:  100,
-				limit: 100,
+				sort:   models.PostSortHot,
+				window: models.PostSortWindowDay,
+				skip:   100,
+				limit:  100,
 			},
 			wantPostsPaginated: nil,
 			wantErr:            nil,
@@ -1512,8 +1744,10 @@ func TestRepo_PostsPaginated(t *testing.T) {
 			name:         "no posts :POS",
 			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml"},
 			args: args{
-				skip:  100,
-				limit: 100,
+				sort:   models.PostSortHot,
+				window: models.PostSortWindowDay,
+				skip:   100,
+				limit:  100,
 			},
 			wantPostsPaginated: nil,
 			wantErr:            nil,
@@ -1524,10 +1758,1127 @@ func TestRepo_PostsPaginated(t *testing.T) {
 			db := setupPostgres(t, tt.fixtureFiles...)
 			pgrepo := postrepo.NewRepo(db)
 
-			gotPostsPaginated, gotErr := pgrepo.PostsPaginated(context.Background(), tt.args.skip, tt.args.limit)
+			gotPostsPaginated, gotErr := pgrepo.PostsPaginated(context.Background(), tt.args.sort, tt.args.window, tt.args.filter, tt.args.skip, tt.args.limit)
 
 			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
 			assertPaginatedPostsWithTimestampAndMedias(t, tt.wantPostsPaginated, gotPostsPaginated)
 		})
 	}
 }
+
+func TestRepo_PostsPaginatedSort(t *testing.T) {
+	type args struct {
+		sort   models.PostSort
+		window models.PostSortWindow
+		filter models.PostFilter
+		skip   int
+		limit  int
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantPostIDs []uuid.UUID
+		wantErr     error
+	}{
+		{
+			name: "invalid sort :NEG",
+			args: args{
+				sort:   models.PostSort("best"),
+				window: models.PostSortWindowAll,
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: nil,
+			wantErr:     postrepo.ErrPostInvalidSort,
+		},
+		{
+			name: "invalid sort window :NEG",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindow("decade"),
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: nil,
+			wantErr:     postrepo.ErrPostInvalidSortWindow,
+		},
+		{
+			name: "hot :POS",
+			args: args{
+				sort:  models.PostSortHot,
+				skip:  0,
+				limit: 10,
+			},
+			wantPostIDs: postIDs(2, 1, 6, 3, 4, 5),
+		},
+		{
+			name: "new :POS",
+			args: args{
+				sort:  models.PostSortNew,
+				skip:  0,
+				limit: 10,
+			},
+			wantPostIDs: postIDs(2, 6, 1, 3, 4, 5),
+		},
+		{
+			name: "rising :POS",
+			args: args{
+				sort:  models.PostSortRising,
+				skip:  0,
+				limit: 10,
+			},
+			wantPostIDs: postIDs(2, 1, 6),
+		},
+		{
+			name: "top of the day :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowDay,
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(1, 2, 6),
+		},
+		{
+			name: "top of the week :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowWeek,
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(3, 1, 2, 6),
+		},
+		{
+			name: "top of the month :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowMonth,
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(3, 1, 2, 6),
+		},
+		{
+			name: "top of the year :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowYear,
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(4, 3, 1, 2, 6),
+		},
+		{
+			name: "top of all time :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowAll,
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(5, 4, 3, 1, 2, 6),
+		},
+		{
+			name: "top of all time skip 1 limit 2 :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowAll,
+				skip:   1,
+				limit:  2,
+			},
+			wantPostIDs: postIDs(4, 3),
+		},
+		{
+			name: "controversial of the day :POS",
+			args: args{
+				sort:   models.PostSortControversial,
+				window: models.PostSortWindowDay,
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(6, 1, 2),
+		},
+		{
+			name: "controversial of all time :POS",
+			args: args{
+				sort:   models.PostSortControversial,
+				window: models.PostSortWindowAll,
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(6, 1, 2, 3, 4, 5),
+		},
+		{
+			name: "new in voxsphere 1 :POS",
+			args: args{
+				sort:   models.PostSortNew,
+				filter: models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(1, 3, 5),
+		},
+		{
+			name: "top of all time in voxsphere 2 :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowAll,
+				filter: models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(4, 2, 6),
+		},
+		{
+			name: "new in unknown voxsphere :NEG",
+			args: args{
+				sort:   models.PostSortNew,
+				filter: models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009")},
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: nil,
+			wantErr:     postrepo.ErrPostVoxsphereNotFound,
+		},
+		{
+			name: "top of all time by author :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowAll,
+				filter: models.PostFilter{AuthorName: "Jane Doe"},
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: postIDs(4, 2, 6),
+		},
+		{
+			name: "new by unknown author :NEG",
+			args: args{
+				sort:   models.PostSortNew,
+				filter: models.PostFilter{AuthorName: "Nobody"},
+				skip:   0,
+				limit:  10,
+			},
+			wantPostIDs: nil,
+			wantErr:     postrepo.ErrPostAuthorNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts_sorted.yml", "comments_sorted.yml")
+			pgrepo := postrepo.NewRepo(db)
+
+			gotPostsPaginated, gotErr := pgrepo.PostsPaginated(context.Background(), tt.args.sort, tt.args.window, tt.args.filter, tt.args.skip, tt.args.limit)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			var gotPostIDs []uuid.UUID
+			for _, post := range gotPostsPaginated {
+				gotPostIDs = append(gotPostIDs, post.ID)
+			}
+			assert.Equal(t, tt.wantPostIDs, gotPostIDs, "expect post order to match")
+		})
+	}
+}
+
+func TestRepo_PostsControversialDownvoted(t *testing.T) {
+	db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts_sorted.yml", "comments_sorted.yml", "posts_downvoted.yml", "comments_downvoted.yml")
+	pgrepo := postrepo.NewRepo(db)
+	wantPostIDs := postIDs(6, 1, 7, 2, 3, 4, 5)
+
+	gotPostsPaginated, gotErr := pgrepo.PostsPaginated(context.Background(), models.PostSortControversial, models.PostSortWindowAll, models.PostFilter{}, 0, 10)
+	assert.NoError(t, gotErr, "expect no error")
+
+	var gotPostIDs []uuid.UUID
+	for _, post := range gotPostsPaginated {
+		gotPostIDs = append(gotPostIDs, post.ID)
+	}
+	assert.Equal(t, wantPostIDs, gotPostIDs, "expect offset paginated post order to match")
+
+	gotFeed, gotErr := pgrepo.PostsAfter(context.Background(), models.PostSortControversial, models.PostSortWindowAll, models.PostFilter{}, nil, 10)
+	assert.NoError(t, gotErr, "expect no error")
+
+	gotPostIDs = nil
+	for _, post := range gotFeed.Posts {
+		gotPostIDs = append(gotPostIDs, post.ID)
+	}
+	assert.Equal(t, wantPostIDs, gotPostIDs, "expect cursor paginated post order to match")
+}
+
+func TestRepo_PostsFilter(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts_paginated.yml", "post_medias.yml", "post_flairs.yml", "post_post_flairs.yml", "voxsphere_members.yml", "hidden_posts.yml"}
+
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		filter       models.PostFilter
+		wantPostIDs  []uuid.UUID
+	}{
+		{
+			name:         "no filter :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{},
+			wantPostIDs:  postIDs(5, 4, 3, 2, 1),
+		},
+		{
+			name:         "media types :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{MediaTypes: []models.MediaType{models.MediaTypeImage, models.MediaTypeGallery}},
+			wantPostIDs:  postIDs(3, 1),
+		},
+		{
+			name:         "posts without media are text posts :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts_paginated.yml"},
+			filter:       models.PostFilter{MediaTypes: []models.MediaType{models.MediaTypeText}},
+			wantPostIDs:  postIDs(5, 4, 3, 2, 1),
+		},
+		{
+			name:         "no text posts :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{MediaTypes: []models.MediaType{models.MediaTypeText}},
+			wantPostIDs:  nil,
+		},
+		{
+			name:         "media type is not interpolated :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{MediaTypes: []models.MediaType{"image') OR TRUE --"}},
+			wantPostIDs:  nil,
+		},
+		{
+			name:         "nsfw include :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{NSFW: models.PostNSFWInclude},
+			wantPostIDs:  postIDs(5, 4, 3, 2, 1),
+		},
+		{
+			name:         "nsfw exclude :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{NSFW: models.PostNSFWExclude},
+			wantPostIDs:  postIDs(5, 3, 1),
+		},
+		{
+			name:         "nsfw only :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{NSFW: models.PostNSFWOnly},
+			wantPostIDs:  postIDs(4, 2),
+		},
+		{
+			name:         "spoiler exclude :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{ExcludeSpoilers: true},
+			wantPostIDs:  postIDs(4, 3, 1),
+		},
+		{
+			name:         "flair :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{FlairID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+			wantPostIDs:  postIDs(2),
+		},
+		{
+			name:         "unknown flair :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{FlairID: uuid.MustParse("00000000-0000-0000-0000-000000000009")},
+			wantPostIDs:  nil,
+		},
+		{
+			name:         "voxsphere :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{VoxsphereIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")}},
+			wantPostIDs:  postIDs(4, 1),
+		},
+		{
+			name:         "voxspheres :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{VoxsphereIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001"), uuid.MustParse("00000000-0000-0000-0000-000000000002")}},
+			wantPostIDs:  postIDs(5, 4, 3, 2, 1),
+		},
+		{
+			name:         "joined voxspheres :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{MemberID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			wantPostIDs:  postIDs(4, 1),
+		},
+		{
+			name:         "no joined voxspheres :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{MemberID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+			wantPostIDs:  nil,
+		},
+		{
+			name:         "posts hidden by viewer :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{ViewerID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			wantPostIDs:  postIDs(5, 3, 2, 1),
+		},
+		{
+			name:         "posts hidden by other viewer :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{ViewerID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+			wantPostIDs:  postIDs(5, 4, 3, 2, 1),
+		},
+		{
+			name:         "combined filters :POS",
+			fixtureFiles: fixtureFiles,
+			filter:       models.PostFilter{VoxsphereIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000002")}, NSFW: models.PostNSFWExclude, ExcludeSpoilers: true},
+			wantPostIDs:  postIDs(3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := postrepo.NewRepo(db)
+
+			gotPostsPaginated, gotErr := pgrepo.PostsPaginated(context.Background(), models.PostSortNew, models.PostSortWindowAll, tt.filter, 0, 10)
+			assert.NoError(t, gotErr, "expect no error")
+
+			var gotPostIDs []uuid.UUID
+			for _, post := range gotPostsPaginated {
+				gotPostIDs = append(gotPostIDs, post.ID)
+			}
+			assert.Equal(t, tt.wantPostIDs, gotPostIDs, "expect offset paginated posts to match")
+
+			gotFeed, gotErr := pgrepo.PostsAfter(context.Background(), models.PostSortNew, models.PostSortWindowAll, tt.filter, nil, 10)
+			assert.NoError(t, gotErr, "expect no error")
+
+			gotPostIDs = nil
+			for _, post := range gotFeed.Posts {
+				gotPostIDs = append(gotPostIDs, post.ID)
+			}
+			assert.Equal(t, tt.wantPostIDs, gotPostIDs, "expect cursor paginated posts to match")
+		})
+	}
+}
+
+func TestRepo_PostsSaved(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts_paginated.yml", "saved_posts.yml"}
+
+	tests := []struct {
+		name             string
+		viewerID         uuid.UUID
+		wantSavedPostIDs []uuid.UUID
+	}{
+		{
+			name:             "anonymous viewer :NEG",
+			viewerID:         uuid.Nil,
+			wantSavedPostIDs: nil,
+		},
+		{
+			name:             "posts saved by viewer :POS",
+			viewerID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantSavedPostIDs: postIDs(2),
+		},
+		{
+			name:             "posts saved by other viewer :NEG",
+			viewerID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantSavedPostIDs: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := postrepo.NewRepo(db)
+			filter := models.PostFilter{ViewerID: tt.viewerID}
+
+			gotPostsPaginated, gotErr := pgrepo.PostsPaginated(context.Background(), models.PostSortNew, models.PostSortWindowAll, filter, 0, 10)
+			assert.NoError(t, gotErr, "expect no error")
+
+			var gotSavedPostIDs []uuid.UUID
+			for _, post := range gotPostsPaginated {
+				if post.Saved {
+					gotSavedPostIDs = append(gotSavedPostIDs, post.ID)
+				}
+			}
+			assert.Equal(t, tt.wantSavedPostIDs, gotSavedPostIDs, "expect offset paginated saved posts to match")
+
+			gotFeed, gotErr := pgrepo.PostsAfter(context.Background(), models.PostSortNew, models.PostSortWindowAll, filter, nil, 10)
+			assert.NoError(t, gotErr, "expect no error")
+
+			gotSavedPostIDs = nil
+			for _, post := range gotFeed.Posts {
+				if post.Saved {
+					gotSavedPostIDs = append(gotSavedPostIDs, post.ID)
+				}
+			}
+			assert.Equal(t, tt.wantSavedPostIDs, gotSavedPostIDs, "expect cursor paginated saved posts to match")
+
+			gotPostDetail, gotErr := pgrepo.PostDetailByID(context.Background(), postIDs(2)[0], tt.viewerID)
+			assert.NoError(t, gotErr, "expect no error")
+			assert.Equal(t, tt.wantSavedPostIDs != nil, gotPostDetail.Saved, "expect post detail saved state to match")
+		})
+	}
+}
+
+func TestRepo_PostsByIDs(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts_paginated.yml", "saved_posts.yml"}
+
+	tests := []struct {
+		name             string
+		IDs              []uuid.UUID
+		viewerID         uuid.UUID
+		wantPostIDs      []uuid.UUID
+		wantSavedPostIDs []uuid.UUID
+	}{
+		{
+			name:        "no ids :NEG",
+			IDs:         nil,
+			viewerID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantPostIDs: []uuid.UUID{},
+		},
+		{
+			name:             "posts by ids :POS",
+			IDs:              append(postIDs(1, 2), uuid.MustParse("00000000-0000-0000-0000-000000000009")),
+			viewerID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantPostIDs:      postIDs(1, 2),
+			wantSavedPostIDs: postIDs(2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := postrepo.NewRepo(db)
+
+			gotPosts, gotErr := pgrepo.PostsByIDs(context.Background(), tt.IDs, tt.viewerID)
+			assert.NoError(t, gotErr, "expect no error")
+
+			gotPostIDs := []uuid.UUID{}
+			var gotSavedPostIDs []uuid.UUID
+			for _, post := range gotPosts {
+				gotPostIDs = append(gotPostIDs, post.ID)
+				if post.Saved {
+					gotSavedPostIDs = append(gotSavedPostIDs, post.ID)
+				}
+			}
+			assert.ElementsMatch(t, tt.wantPostIDs, gotPostIDs, "expect posts to match")
+			assert.Equal(t, tt.wantSavedPostIDs, gotSavedPostIDs, "expect saved posts to match")
+		})
+	}
+}
+
+func TestRepo_PostsAfter(t *testing.T) {
+	type args struct {
+		sort   models.PostSort
+		window models.PostSortWindow
+		filter models.PostFilter
+		limit  int
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantPostPages [][]uuid.UUID
+	}{
+		{
+			name: "hot limit 4 :POS",
+			args: args{
+				sort:  models.PostSortHot,
+				limit: 4,
+			},
+			wantPostPages: [][]uuid.UUID{postIDs(2, 1, 6, 3), postIDs(4, 5)},
+		},
+		{
+			name: "new limit 3 :POS",
+			args: args{
+				sort:  models.PostSortNew,
+				limit: 3,
+			},
+			wantPostPages: [][]uuid.UUID{postIDs(2, 6, 1), postIDs(3, 4, 5)},
+		},
+		{
+			name: "top of all time limit 2 :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowAll,
+				limit:  2,
+			},
+			wantPostPages: [][]uuid.UUID{postIDs(5, 4), postIDs(3, 1), postIDs(2, 6)},
+		},
+		{
+			name: "top of the week limit 3 :POS",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowWeek,
+				limit:  3,
+			},
+			wantPostPages: [][]uuid.UUID{postIDs(3, 1, 2), postIDs(6)},
+		},
+		{
+			name: "controversial of all time limit 5 :POS",
+			args: args{
+				sort:   models.PostSortControversial,
+				window: models.PostSortWindowAll,
+				limit:  5,
+			},
+			wantPostPages: [][]uuid.UUID{postIDs(6, 1, 2, 3, 4), postIDs(5)},
+		},
+		{
+			name: "hot in voxsphere 2 limit 2 :POS",
+			args: args{
+				sort:   models.PostSortHot,
+				filter: models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+				limit:  2,
+			},
+			wantPostPages: [][]uuid.UUID{postIDs(2, 6), postIDs(4)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts_sorted.yml", "comments_sorted.yml")
+			pgrepo := postrepo.NewRepo(db)
+
+			var after *models.PostCursor
+			for i, wantPostIDs := range tt.wantPostPages {
+				gotFeed, gotErr := pgrepo.PostsAfter(context.Background(), tt.args.sort, tt.args.window, tt.args.filter, after, tt.args.limit)
+				assert.NoError(t, gotErr, "expect no error")
+
+				var gotPostIDs []uuid.UUID
+				for _, post := range gotFeed.Posts {
+					gotPostIDs = append(gotPostIDs, post.ID)
+				}
+				assert.Equal(t, wantPostIDs, gotPostIDs, "expect page %d to match", i)
+
+				if i == len(tt.wantPostPages)-1 {
+					assert.Nil(t, gotFeed.NextCursor, "expect no next cursor on the last page")
+					break
+				}
+				if !assert.NotNil(t, gotFeed.NextCursor, "expect next cursor on page %d", i) {
+					return
+				}
+				cursor, err := models.DecodePostCursor(*gotFeed.NextCursor)
+				assert.NoError(t, err, "expect next cursor to decode")
+				after = &cursor
+			}
+		})
+	}
+}
+
+func TestRepo_PostsAfterInvalidCursor(t *testing.T) {
+	cursor := models.PostCursor{
+		Sort:     models.PostSortNew,
+		Window:   models.PostSortWindowAll,
+		Filter:   models.PostFilter{}.Key(),
+		RankedAt: time.Now().Unix(),
+		SortKey:  []string{"1725091100"},
+		ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	}
+
+	tests := []struct {
+		name   string
+		sort   models.PostSort
+		window models.PostSortWindow
+		filter models.PostFilter
+	}{
+		{
+			name:   "cursor of another sort :NEG",
+			sort:   models.PostSortTop,
+			window: models.PostSortWindowAll,
+			filter: models.PostFilter{},
+		},
+		{
+			name:   "cursor of another window :NEG",
+			sort:   models.PostSortNew,
+			window: models.PostSortWindowDay,
+			filter: models.PostFilter{},
+		},
+		{
+			name:   "cursor of another filter :NEG",
+			sort:   models.PostSortNew,
+			window: models.PostSortWindowAll,
+			filter: models.PostFilter{NSFW: models.PostNSFWExclude},
+		},
+		{
+			name:   "cursor of another voxsphere :NEG",
+			sort:   models.PostSortNew,
+			window: models.PostSortWindowAll,
+			filter: models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts_sorted.yml")
+			pgrepo := postrepo.NewRepo(db)
+
+			gotFeed, gotErr := pgrepo.PostsAfter(context.Background(), tt.sort, tt.window, tt.filter, &cursor, 2)
+
+			assert.ErrorIs(t, gotErr, models.ErrInvalidPostCursor, "expect error to match")
+			assert.Equal(t, models.PostFeed{}, gotFeed, "expect feed to be empty")
+		})
+	}
+}
+
+func TestRepo_PostsAfterRisingRankedAt(t *testing.T) {
+	db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts_sorted.yml", "comments_sorted.yml")
+	pgrepo := postrepo.NewRepo(db)
+
+	// walk the rising feed one post at a time, every page ranked as of the
+	// time of the first one
+	var rankedAt int64
+	seen := make(map[uuid.UUID]bool)
+	var after *models.PostCursor
+	for {
+		gotFeed, gotErr := pgrepo.PostsAfter(context.Background(), models.PostSortRising, models.PostSortWindowAll, models.PostFilter{}, after, 1)
+		assert.NoError(t, gotErr, "expect no error")
+		for _, post := range gotFeed.Posts {
+			assert.False(t, seen[post.ID], "expect post %s to be shown once", post.ID)
+			seen[post.ID] = true
+		}
+		if gotFeed.NextCursor == nil {
+			break
+		}
+		cursor, err := models.DecodePostCursor(*gotFeed.NextCursor)
+		assert.NoError(t, err, "expect next cursor to decode")
+		if rankedAt == 0 {
+			rankedAt = cursor.RankedAt
+			assert.InDelta(t, time.Now().Unix(), rankedAt, 60, "expect first page to be ranked now")
+		}
+		assert.Equal(t, rankedAt, cursor.RankedAt, "expect every page to be ranked as of the first one")
+		after = &cursor
+	}
+}
+
+func TestRepo_PostsPinnedFirst(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts_paginated.yml"}
+
+	tests := []struct {
+		name        string
+		filter      models.PostFilter
+		wantPostIDs []uuid.UUID
+	}{
+		{
+			name:        "voxsphere feed :POS",
+			filter:      models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+			wantPostIDs: postIDs(2, 5, 3),
+		},
+		{
+			name:        "pinned posts stay in place outside their voxsphere :POS",
+			filter:      models.PostFilter{},
+			wantPostIDs: postIDs(5, 4, 3, 2, 1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := postrepo.NewRepo(db)
+
+			if _, err := db.NewUpdate().Model((*models.Post)(nil)).Set("pinned_at = NOW()").Where("id = ?", postIDs(2)[0]).Exec(context.Background()); err != nil {
+				t.Fatal("failed to pin post", err)
+			}
+
+			gotPostsPaginated, gotErr := pgrepo.PostsPaginated(context.Background(), models.PostSortNew, models.PostSortWindowAll, tt.filter, 0, 10)
+			assert.NoError(t, gotErr, "expect no error")
+
+			var gotPostIDs []uuid.UUID
+			for _, post := range gotPostsPaginated {
+				gotPostIDs = append(gotPostIDs, post.ID)
+			}
+			assert.Equal(t, tt.wantPostIDs, gotPostIDs, "expect offset paginated posts to match")
+
+			// walk the feed one post at a time to check the keyset of the pin
+			gotPostIDs = nil
+			var after *models.PostCursor
+			for {
+				gotFeed, gotErr := pgrepo.PostsAfter(context.Background(), models.PostSortNew, models.PostSortWindowAll, tt.filter, after, 1)
+				assert.NoError(t, gotErr, "expect no error")
+				for _, post := range gotFeed.Posts {
+					gotPostIDs = append(gotPostIDs, post.ID)
+				}
+				if gotFeed.NextCursor == nil {
+					break
+				}
+				cursor, err := models.DecodePostCursor(*gotFeed.NextCursor)
+				assert.NoError(t, err, "expect cursor to decode")
+				after = &cursor
+			}
+			assert.Equal(t, tt.wantPostIDs, gotPostIDs, "expect cursor paginated posts to match")
+		})
+	}
+}
+
+func TestRepo_PostDetailByIDRemoved(t *testing.T) {
+	db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts_paginated.yml")
+	pgrepo := postrepo.NewRepo(db)
+
+	if _, err := db.NewUpdate().Model((*models.Post)(nil)).Set("removed_at = NOW()").Where("id = ?", postIDs(1)[0]).Exec(context.Background()); err != nil {
+		t.Fatal("failed to remove post", err)
+	}
+
+	gotPostDetail, gotErr := pgrepo.PostDetailByID(context.Background(), postIDs(1)[0], uuid.Nil)
+
+	assert.ErrorIs(t, gotErr, postrepo.ErrPostNotFound, "expect removed post not to be found")
+	assert.Equal(t, models.PostDetail{}, gotPostDetail, "expect post detail to be empty")
+}
+
+func postIDs(ids ...int) []uuid.UUID {
+	postIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		postIDs = append(postIDs, uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%012d", id)))
+	}
+	return postIDs
+}
+
+func TestRepo_PostsPaginatedFlairs(t *testing.T) {
+	fixtureFiles := []string{
+		"topics.yml",
+		"voxspheres.yml",
+		"users.yml",
+		"posts_paginated.yml",
+		"emojis.yml",
+		"custom_emojis.yml",
+		"post_flairs.yml",
+		"post_post_flairs.yml",
+		"post_flair_descriptions.yml",
+		"post_flair_emojis.yml",
+		"post_flair_custom_emojis.yml",
+	}
+
+	wantFlairs := map[uuid.UUID][]models.PostFlairRendered{
+		uuid.MustParse("00000000-0000-0000-0000-000000000001"): {
+			{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				FullText:        "desc1 :1f600::ce1:",
+				BackgroundColor: "#FFFFFF",
+				Richtext: []models.FlairRichtext{
+					{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc1 "},
+					{Type: models.FlairRichtextTypeEmoji, OrderIndex: 1, Text: "😀"},
+					{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 2, Text: ":ce1:", Url: ptrof("https://example.com/ce1.png")},
+				},
+			},
+		},
+		uuid.MustParse("00000000-0000-0000-0000-000000000002"): {
+			{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				FullText:        "desc2",
+				BackgroundColor: "#000000",
+				Richtext: []models.FlairRichtext{
+					{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc2"},
+				},
+			},
+		},
+	}
+
+	db := setupPostgres(t, fixtureFiles...)
+	pgrepo := postrepo.NewRepo(db)
+
+	gotPostsPaginated, gotErr := pgrepo.PostsPaginated(context.Background(), models.PostSortNew, models.PostSortWindowAll, models.PostFilter{}, 0, 10)
+	assert.NoError(t, gotErr, "expect no error")
+	for _, post := range gotPostsPaginated {
+		assert.Equal(t, wantFlairs[post.ID], post.PostFlairs, "expect offset paginated post flairs to match")
+	}
+
+	gotFeed, gotErr := pgrepo.PostsAfter(context.Background(), models.PostSortNew, models.PostSortWindowAll, models.PostFilter{}, nil, 10)
+	assert.NoError(t, gotErr, "expect no error")
+	for _, post := range gotFeed.Posts {
+		assert.Equal(t, wantFlairs[post.ID], post.PostFlairs, "expect cursor paginated post flairs to match")
+	}
+}
+
+func TestRepo_PostDetailByID(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+	tests := []struct {
+		name           string
+		fixtureFiles   []string
+		args           args
+		wantPostDetail models.PostDetail
+		wantErr        error
+	}{
+		{
+			name: "post not found :NEG",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts_paginated.yml",
+			},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			wantPostDetail: models.PostDetail{},
+			wantErr:        postrepo.ErrPostNotFound,
+		},
+		{
+			name: "post with medias, flairs and awards :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts_paginated.yml",
+				"post_medias.yml",
+				"images.yml",
+				"image_metadatas.yml",
+				"emojis.yml",
+				"custom_emojis.yml",
+				"post_flairs.yml",
+				"post_post_flairs.yml",
+				"post_flair_descriptions.yml",
+				"post_flair_emojis.yml",
+				"post_flair_custom_emojis.yml",
+				"awards.yml",
+				"post_awards.yml",
+				"award_grants.yml",
+			},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantPostDetail: models.PostDetail{
+				PostPaginated: models.PostPaginated{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Author:      "John Doe",
+					AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Voxsphere:   "v/foo",
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:       "Example Post Title 1",
+					Text:        "This is an example post text 1.",
+					TextHtml:    "This is an example post text 1 in HTML.",
+					MediaType:   models.MediaTypeImage,
+					Medias: models.Medias{
+						models.ImageMetadata{
+							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							ImageID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Height:        720,
+							Width:         1280,
+							Url:           "https://example.com/image2.jpg",
+							CreatedAt:     time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+							CreatedAtUnix: 1725091101,
+							UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+						},
+						models.ImageMetadata{
+							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							ImageID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Height:        1080,
+							Width:         1920,
+							Url:           "https://example.com/image1.jpg",
+							CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+							CreatedAtUnix: 1725091100,
+							UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						},
+					},
+					Ups:         10,
+					NumComments: 0,
+					NumAwards:   3,
+					AwardCounts: []models.AwardCount{
+						{
+							Award: models.Award{
+								ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+								Title:     "award_foo",
+								ImageLink: "https://example.com/award_foo.png",
+								Price:     100,
+							},
+							Count: 2,
+						},
+						{
+							Award: models.Award{
+								ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								Title:     "award_bar",
+								ImageLink: "https://example.com/award_bar.png",
+								Price:     100,
+							},
+							Count: 1,
+						},
+					},
+					Over18:        false,
+					Spoiler:       false,
+					CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					CreatedAtUnix: 1725091100,
+					UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					PostFlairs: []models.PostFlairRendered{
+						{
+							ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							FullText:        "desc1 :1f600::ce1:",
+							BackgroundColor: "#FFFFFF",
+							Richtext: []models.FlairRichtext{
+								{
+									Type:       models.FlairRichtextTypeText,
+									OrderIndex: 0,
+									Text:       "desc1 ",
+								},
+								{
+									Type:       models.FlairRichtextTypeEmoji,
+									OrderIndex: 1,
+									Text:       "😀",
+								},
+								{
+									Type:       models.FlairRichtextTypeCustomEmoji,
+									OrderIndex: 2,
+									Text:       ":ce1:",
+									Url:        ptrof("https://example.com/ce1.png"),
+								},
+							},
+						},
+					},
+				},
+				Awards: []models.Award{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Title:     "award_bar",
+						ImageLink: "https://example.com/award_bar.png",
+						Price:     100,
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Title:     "award_foo",
+						ImageLink: "https://example.com/award_foo.png",
+						Price:     100,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "post with multi medias :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts_paginated.yml",
+				"multi_post_medias.yml",
+				"multi_videos.yml",
+				"multi_galleries.yml",
+				"multi_gallery_metadatas.yml",
+				"multi_medias.yml",
+			},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+			wantPostDetail: models.PostDetail{
+				PostPaginated: models.PostPaginated{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Author:      "Jane Doe",
+					AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Voxsphere:   "v/bar",
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Title:       "Example Post Title 3",
+					Text:        "This is an example post text 3.",
+					TextHtml:    "This is an example post text 3 in HTML.",
+					MediaType:   models.MediaTypeMulti,
+					Medias: models.Medias{
+						models.MultiMedia{
+							ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+							ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							ChildType:  models.MediaTypeVideo,
+							OrderIndex: 0,
+							Medias: models.Medias{
+								models.Video{
+									ID:            uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+									MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+									Url:           "https://example.com/multi.mp4",
+									Height:        720,
+									Width:         1280,
+									CreatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+									CreatedAtUnix: 1725091140,
+									UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+								},
+							},
+						},
+						models.MultiMedia{
+							ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							MediaID:    uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+							ChildID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+							ChildType:  models.MediaTypeGallery,
+							OrderIndex: 1,
+							Medias: models.Medias{
+								models.GalleryMetadata{
+									ID:            uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+									GalleryID:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+									OrderIndex:    0,
+									Height:        720,
+									Width:         1280,
+									Url:           "https://example.com/multi11.jpg",
+									CreatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+									CreatedAtUnix: 1725091140,
+									UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+								},
+								models.GalleryMetadata{
+									ID:            uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+									GalleryID:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+									OrderIndex:    1,
+									Height:        720,
+									Width:         1280,
+									Url:           "https://example.com/multi12.jpg",
+									CreatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+									CreatedAtUnix: 1725091140,
+									UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+								},
+							},
+						},
+					},
+					Ups:           30,
+					Over18:        false,
+					Spoiler:       false,
+					CreatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+					CreatedAtUnix: 1725091140,
+					UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+				},
+				Awards: nil,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "post without flairs and awards :POS",
+			fixtureFiles: []string{
+				"topics.yml",
+				"voxspheres.yml",
+				"users.yml",
+				"posts_paginated.yml",
+			},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+			wantPostDetail: models.PostDetail{
+				PostPaginated: models.PostPaginated{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Author:        "Jane Doe",
+					AuthorID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Voxsphere:     "v/bar",
+					VoxsphereID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Title:         "Example Post Title 3",
+					Text:          "This is an example post text 3.",
+					TextHtml:      "This is an example post text 3 in HTML.",
+					MediaType:     models.MediaTypeText,
+					Medias:        nil,
+					Ups:           30,
+					Over18:        false,
+					Spoiler:       false,
+					CreatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+					CreatedAtUnix: 1725091140,
+					UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+				},
+				Awards: nil,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := postrepo.NewRepo(db)
+
+			gotPostDetail, gotErr := pgrepo.PostDetailByID(context.Background(), tt.args.ID, uuid.Nil)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantPostDetail, gotPostDetail, "expect post detail to match")
+				return
+			}
+			assert.Equal(t, tt.wantPostDetail.Author, gotPostDetail.Author, "expect author to match")
+			assert.Equal(t, tt.wantPostDetail.Voxsphere, gotPostDetail.Voxsphere, "expect voxsphere to match")
+			assert.Equal(t, tt.wantPostDetail.NumComments, gotPostDetail.NumComments, "expect num comments to match")
+			assert.Equal(t, tt.wantPostDetail.NumAwards, gotPostDetail.NumAwards, "expect num awards to match")
+			assert.Equal(t, tt.wantPostDetail.AwardCounts, gotPostDetail.AwardCounts, "expect award counts to match")
+			assertPaginatedPosts(t, tt.wantPostDetail.PostPaginated, gotPostDetail.PostPaginated)
+			if tt.wantPostDetail.Medias != nil {
+				assertPostMedias(t, tt.wantPostDetail.Medias, gotPostDetail.Medias, gotPostDetail.MediaType)
+			}
+			assert.Equal(t, tt.wantPostDetail.PostFlairs, gotPostDetail.PostFlairs, "expect post flairs to match")
+			assert.Equal(t, tt.wantPostDetail.Awards, gotPostDetail.Awards, "expect awards to match")
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
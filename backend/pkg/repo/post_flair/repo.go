@@ -6,6 +6,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/glowfi/voxpopuli/backend/internal/flairtext"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -21,6 +22,8 @@ var (
 	ErrPostFlairNotFound                  = errors.New("post flair not found")
 	ErrPostFlairDuplicateID               = errors.New("post flair duplicate id")
 	ErrPostFlairParentTableRecordNotFound = errors.New("record does not exist in the parent table")
+	ErrPostFlairPostNotFound              = errors.New("post not found")
+	ErrPostFlairNotInVoxsphere            = errors.New("post flair is not a flair of the voxsphere of the post")
 )
 
 type PostFlairRepository interface {
@@ -29,6 +32,9 @@ type PostFlairRepository interface {
 	AddPostFlairs(ctx context.Context, postFlair ...models.PostFlair) (models.PostFlair, error)
 	UpdatePostFlair(ctx context.Context, postFlair models.PostFlair) (models.PostFlair, error)
 	DeletePostFlair(ctx context.Context, ID uuid.UUID) error
+	VoxspherePostFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.PostFlairRendered, error)
+	SetPostFlair(ctx context.Context, postID, postFlairID uuid.UUID) error
+	ClearPostFlair(ctx context.Context, postID uuid.UUID) error
 }
 
 type Repo struct {
@@ -186,3 +192,112 @@ func (r *Repo) DeletePostFlair(ctx context.Context, ID uuid.UUID) error {
 	}
 	return nil
 }
+
+// VoxspherePostFlairs returns the post flairs of the voxsphere of voxsphereID
+// ordered by text, each with its descriptions, emojis and custom emojis
+// merged by order index.
+func (r *Repo) VoxspherePostFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.PostFlairRendered, error) {
+	flairs := []models.PostFlairRendered{}
+
+	query := `
+                SELECT
+                    pf.id,
+                    pf.voxsphere_id,
+                    pf.full_text,
+                    pf.background_color,
+                    ` + flairtext.PostQuery + ` AS richtext
+                FROM
+                    post_flairs pf
+                WHERE
+                    pf.voxsphere_id = ?
+                ORDER BY
+                    pf.full_text,
+                    pf.id;
+            `
+	_, err := r.db.NewRaw(query, voxsphereID).Exec(ctx, &flairs)
+	if err != nil {
+		return []models.PostFlairRendered{}, err
+	}
+
+	for i := range flairs {
+		flairtext.RenderEmojis(flairs[i].Richtext)
+	}
+	return flairs, nil
+}
+
+// SetPostFlair replaces the flair of the post of postID with the post flair
+// of postFlairID, which has to be a flair of the voxsphere of the post.
+func (r *Repo) SetPostFlair(ctx context.Context, postID, postFlairID uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var postVoxsphereID uuid.UUID
+		postQuery := `
+                SELECT
+                    p.voxsphere_id
+                FROM
+                    posts p
+                WHERE
+                    p.id = ?
+                FOR UPDATE;
+            `
+		if err := tx.NewRaw(postQuery, postID).Scan(ctx, &postVoxsphereID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrPostFlairPostNotFound
+			}
+			return err
+		}
+
+		var flairVoxsphereID uuid.UUID
+		flairQuery := `
+                SELECT
+                    pf.voxsphere_id
+                FROM
+                    post_flairs pf
+                WHERE
+                    pf.id = ?;
+            `
+		if err := tx.NewRaw(flairQuery, postFlairID).Scan(ctx, &flairVoxsphereID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrPostFlairNotFound
+			}
+			return err
+		}
+		if flairVoxsphereID != postVoxsphereID {
+			return ErrPostFlairNotInVoxsphere
+		}
+
+		clearQuery := `
+                DELETE FROM
+                    post_post_flairs
+                WHERE
+                    post_id = ?;
+            `
+		if _, err := tx.NewRaw(clearQuery, postID).Exec(ctx); err != nil {
+			return err
+		}
+
+		setQuery := `
+                INSERT INTO
+                    post_post_flairs (
+                        post_id,
+                        post_flair_id
+                    )
+                VALUES
+                    (?, ?);
+            `
+		_, err := tx.NewRaw(setQuery, postID, postFlairID).Exec(ctx)
+		return err
+	})
+}
+
+// ClearPostFlair removes the flair of the post of postID. Clearing a post
+// without a flair is not an error.
+func (r *Repo) ClearPostFlair(ctx context.Context, postID uuid.UUID) error {
+	query := `
+                DELETE FROM
+                    post_post_flairs
+                WHERE
+                    post_id = ?;
+            `
+	_, err := r.db.NewRaw(query, postID).Exec(ctx)
+	return err
+}
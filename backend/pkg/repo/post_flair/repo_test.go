@@ -46,6 +46,13 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	db.RegisterModel((*models.Voxsphere)(nil))
 	db.RegisterModel((*models.User)(nil))
 	db.RegisterModel((*models.PostFlair)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Emoji)(nil))
+	db.RegisterModel((*models.CustomEmoji)(nil))
+	db.RegisterModel((*models.PostPostFlair)(nil))
+	db.RegisterModel((*models.PostFlairDescription)(nil))
+	db.RegisterModel((*models.PostFlairEmoji)(nil))
+	db.RegisterModel((*models.PostFlairCustomEmoji)(nil))
 
 	// drop all rows of the topic,voxsphere,post,post_flairs table
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.Topic)(nil)).Exec(context.Background()); err != nil {
@@ -60,6 +67,9 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.PostFlair)(nil)).Exec(context.Background()); err != nil {
 		t.Fatal("truncate table failed:", err)
 	}
+	if _, err := db.NewTruncateTable().Cascade().Model((*models.Emoji)(nil)).Exec(context.Background()); err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
 
 	// load fixture
 	fixture := dbfixture.New(db)
@@ -532,3 +542,171 @@ func TestRepo_ForeignKeyCascade(t *testing.T) {
 		assertPostFlairs(t, wantPostFlairs, gotPostFlairs)
 	})
 }
+
+func TestRepo_VoxspherePostFlairs(t *testing.T) {
+	fixtureFiles := []string{
+		"topics.yml",
+		"voxspheres.yml",
+		"users.yml",
+		"post_flairs.yml",
+		"emojis.yml",
+		"custom_emojis.yml",
+		"post_flair_descriptions.yml",
+		"post_flair_emojis.yml",
+		"post_flair_custom_emojis.yml",
+	}
+
+	tests := []struct {
+		name        string
+		voxsphereID uuid.UUID
+		wantFlairs  []models.PostFlairRendered
+	}{
+		{
+			name:        "voxsphere without flairs :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantFlairs:  []models.PostFlairRendered{},
+		},
+		{
+			name:        "flair with text only :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantFlairs: []models.PostFlairRendered{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					FullText:        "Flair 2",
+					BackgroundColor: "#000000",
+					Richtext: []models.FlairRichtext{
+						{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc2"},
+					},
+				},
+			},
+		},
+		{
+			name:        "flair with text and emojis in order :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantFlairs: []models.PostFlairRendered{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					FullText:        "Flair 1",
+					BackgroundColor: "#FFFFFF",
+					Richtext: []models.FlairRichtext{
+						{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc1 "},
+						{Type: models.FlairRichtextTypeEmoji, OrderIndex: 1, Text: "😀"},
+						{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 2, Text: ":ce1:", Url: ptrof("https://example.com/ce1.png")},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := postflaireRepo.NewRepo(db)
+
+			gotFlairs, gotErr := pgrepo.VoxspherePostFlairs(context.Background(), tt.voxsphereID)
+			assert.NoError(t, gotErr, "expect no error")
+			assert.Equal(t, tt.wantFlairs, gotFlairs, "expect flairs to match")
+		})
+	}
+}
+
+func TestRepo_SetPostFlair(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "post_flairs.yml", "post_post_flairs.yml"}
+
+	tests := []struct {
+		name        string
+		postID      uuid.UUID
+		postFlairID uuid.UUID
+		wantFlairs  []models.PostPostFlair
+		wantErr     error
+	}{
+		{
+			name:        "post not found :NEG",
+			postID:      uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			postFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantErr:     postflaireRepo.ErrPostFlairPostNotFound,
+		},
+		{
+			name:        "post flair not found :NEG",
+			postID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			postFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantErr:     postflaireRepo.ErrPostFlairNotFound,
+		},
+		{
+			name:        "flair of another voxsphere :NEG",
+			postID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			postFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantErr:     postflaireRepo.ErrPostFlairNotInVoxsphere,
+		},
+		{
+			name:        "flair a post :POS",
+			postID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			postFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantFlairs: []models.PostPostFlair{
+				{PostID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), PostFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			},
+		},
+		{
+			name:        "set the flair again :POS",
+			postID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			postFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantFlairs: []models.PostPostFlair{
+				{PostID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), PostFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := postflaireRepo.NewRepo(db)
+
+			gotErr := pgrepo.SetPostFlair(context.Background(), tt.postID, tt.postFlairID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr != nil {
+				return
+			}
+
+			var gotFlairs []models.PostPostFlair
+			if err := db.NewSelect().Model(&gotFlairs).Where("post_id = ?", tt.postID).Scan(context.Background()); err != nil {
+				t.Fatal("failed to fetch post flairs:", err)
+			}
+			assert.Equal(t, tt.wantFlairs, gotFlairs, "expect a single flair on the post")
+		})
+	}
+}
+
+func TestRepo_ClearPostFlair(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "post_flairs.yml", "post_post_flairs.yml"}
+
+	tests := []struct {
+		name   string
+		postID uuid.UUID
+	}{
+		{
+			name:   "flaired post :POS",
+			postID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:   "post without flair :POS",
+			postID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := postflaireRepo.NewRepo(db)
+
+			gotErr := pgrepo.ClearPostFlair(context.Background(), tt.postID)
+			assert.NoError(t, gotErr, "expect no error")
+
+			gotCount, err := db.NewSelect().Model((*models.PostPostFlair)(nil)).Where("post_id = ?", tt.postID).Count(context.Background())
+			assert.NoError(t, err, "expect post flairs to be counted")
+			assert.Equal(t, 0, gotCount, "expect post to have no flair")
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
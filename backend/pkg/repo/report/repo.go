@@ -0,0 +1,252 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const (
+	pgUniqueViolation     = "23505"
+	pgConstraintViolation = "23503"
+)
+
+var (
+	ErrReportPostNotFound       = errors.New("post not found")
+	ErrReportCommentNotFound    = errors.New("comment not found")
+	ErrReportRuleNotInVoxsphere = errors.New("rule is not a rule of the voxsphere")
+	ErrReportDuplicate          = errors.New("already reported")
+	ErrReportReporterNotFound   = errors.New("reporter not found")
+)
+
+type ReportRepository interface {
+	AddReport(context.Context, models.Report) (models.Report, error)
+	ReportQueue(context.Context, uuid.UUID, int, int) ([]models.ReportQueueItem, error)
+}
+
+type Repo struct {
+	db *bun.DB
+}
+
+func NewRepo(db *bun.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// AddReport files report against its post or comment, in the voxsphere of
+// that post or comment. The rule of a report has to be a rule of that
+// voxsphere, and a reporter cannot have two open reports on the same target.
+func (r *Repo) AddReport(ctx context.Context, report models.Report) (models.Report, error) {
+	report.CreatedAt = time.Now()
+	report.CreatedAtUnix = report.CreatedAt.Unix()
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		voxsphereQuery := `
+            SELECT
+                p.voxsphere_id
+            FROM
+                posts p
+            WHERE
+                p.id = ?;
+        `
+		target, notFound := report.PostID, ErrReportPostNotFound
+		if report.CommentID != uuid.Nil {
+			voxsphereQuery = `
+                SELECT
+                    p.voxsphere_id
+                FROM
+                    comments c
+                JOIN
+                    posts p ON p.id = c.post_id
+                WHERE
+                    c.id = ?;
+            `
+			target, notFound = report.CommentID, ErrReportCommentNotFound
+		}
+		if err := tx.NewRaw(voxsphereQuery, target).Scan(ctx, &report.VoxsphereID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return notFound
+			}
+			return err
+		}
+
+		if report.RuleID != uuid.Nil {
+			var ruleInVoxsphere bool
+			ruleQuery := `
+                SELECT
+                    EXISTS (
+                        SELECT
+                            1
+                        FROM
+                            rules ru
+                        WHERE
+                            ru.id = ?
+                            AND ru.voxsphere_id = ?
+                    );
+            `
+			if err := tx.NewRaw(ruleQuery, report.RuleID, report.VoxsphereID).Scan(ctx, &ruleInVoxsphere); err != nil {
+				return err
+			}
+			if !ruleInVoxsphere {
+				return ErrReportRuleNotInVoxsphere
+			}
+		}
+
+		insertQuery := `
+            INSERT INTO reports
+                (
+                    id,
+                    voxsphere_id,
+                    reporter_id,
+                    post_id,
+                    comment_id,
+                    rule_id,
+                    reason,
+                    created_at,
+                    created_at_unix
+                )
+            VALUES
+                (?, ?, ?, ?, ?, ?, ?, ?, ?);
+        `
+		if _, err := tx.NewRaw(insertQuery,
+			report.ID,
+			report.VoxsphereID,
+			report.ReporterID,
+			bun.NullZero(report.PostID),
+			bun.NullZero(report.CommentID),
+			bun.NullZero(report.RuleID),
+			report.Reason,
+			report.CreatedAt,
+			report.CreatedAtUnix,
+		).Exec(ctx); err != nil {
+			var pgdriverErr pgdriver.Error
+			if errors.As(err, &pgdriverErr) {
+				switch pgdriverErr.Field('C') {
+				case pgUniqueViolation:
+					return ErrReportDuplicate
+				case pgConstraintViolation:
+					return constraintError(pgdriverErr.Field('n'), err)
+				}
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Report{}, err
+	}
+	return report, nil
+}
+
+// constraintError returns the error of a report breaking the foreign key
+// constraint of name, which the target or rule of the report may break when
+// it is deleted while the report is filed.
+func constraintError(name string, err error) error {
+	switch name {
+	case "fk_reporter_id":
+		return ErrReportReporterNotFound
+	case "fk_post_id":
+		return ErrReportPostNotFound
+	case "fk_comment_id":
+		return ErrReportCommentNotFound
+	case "fk_rule_id":
+		return ErrReportRuleNotInVoxsphere
+	default:
+		return err
+	}
+}
+
+// ReportQueue returns the open reports of the voxsphere of voxsphereID grouped
+// by the post or comment they were filed against. The most reported targets
+// come first, and the reasons of a target are counted by rule and free text.
+func (r *Repo) ReportQueue(ctx context.Context, voxsphereID uuid.UUID, skip, limit int) ([]models.ReportQueueItem, error) {
+	queue := []models.ReportQueueItem{}
+
+	query := `
+        WITH
+          open_reports AS (
+            SELECT
+              CASE WHEN r.post_id IS NOT NULL THEN 'post' ELSE 'comment' END AS target_type,
+              COALESCE(r.post_id, r.comment_id) AS target_id,
+              r.rule_id,
+              COALESCE(ru.short_name, '') AS rule,
+              r.reason,
+              r.created_at
+            FROM
+              reports r
+              LEFT JOIN rules ru ON ru.id = r.rule_id
+            WHERE
+              r.voxsphere_id = ?
+              AND r.resolved_at IS NULL
+          ),
+          reasons AS (
+            SELECT
+              o.target_type,
+              o.target_id,
+              o.rule_id,
+              o.rule,
+              o.reason,
+              count(*) AS count
+            FROM
+              open_reports o
+            GROUP BY
+              o.target_type,
+              o.target_id,
+              o.rule_id,
+              o.rule,
+              o.reason
+          )
+        SELECT
+          o.target_type,
+          o.target_id,
+          count(*) AS report_count,
+          (
+            SELECT
+              JSON_AGG(
+                JSON_BUILD_OBJECT(
+                  'rule_id',
+                  rs.rule_id,
+                  'rule',
+                  rs.rule,
+                  'reason',
+                  rs.reason,
+                  'count',
+                  rs.count
+                )
+                ORDER BY
+                  rs.count DESC,
+                  rs.rule,
+                  rs.reason
+              )
+            FROM
+              reasons rs
+            WHERE
+              rs.target_type = o.target_type
+              AND rs.target_id = o.target_id
+          ) AS reasons,
+          MIN(o.created_at) AS first_reported_at,
+          MAX(o.created_at) AS last_reported_at
+        FROM
+          open_reports o
+        GROUP BY
+          o.target_type,
+          o.target_id
+        ORDER BY
+          report_count DESC,
+          last_reported_at DESC,
+          o.target_id
+        LIMIT
+          ?
+        OFFSET
+          ?;
+    `
+	if _, err := r.db.NewRaw(query, voxsphereID, limit, skip).Exec(ctx, &queue); err != nil {
+		return []models.ReportQueueItem{}, err
+	}
+	return queue, nil
+}
@@ -0,0 +1,254 @@
+package report_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	reportrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/report"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dbfixture"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+)
+
+func connectPostgres(user, password, address, dbName string) *bun.DB {
+	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, address, dbName)
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
+	db := bun.NewDB(sqldb, pgdialect.New())
+	return db
+}
+
+func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
+	db := connectPostgres("postgres", "postgres", "127.0.0.1:5432", "voxpopuli")
+
+	if err := db.Ping(); err != nil {
+		t.Fatal("db error:", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Log("db close error:", err)
+		}
+	})
+
+	// add query logging hook
+	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
+
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
+	db.RegisterModel((*models.Rule)(nil))
+	db.RegisterModel((*models.Report)(nil))
+
+	// drop all rows of the reported tables
+	for _, model := range []interface{}{
+		(*models.Topic)(nil),
+		(*models.Voxsphere)(nil),
+		(*models.User)(nil),
+		(*models.Post)(nil),
+		(*models.Comment)(nil),
+		(*models.Rule)(nil),
+		(*models.Report)(nil),
+	} {
+		if _, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background()); err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
+	}
+
+	// load fixture
+	fixture := dbfixture.New(db)
+	if err := fixture.Load(context.Background(), os.DirFS("testdata"), fixtureFiles...); err != nil {
+		t.Fatal("failed to load fixtures", err)
+	}
+
+	return db
+}
+
+func TestRepo_AddReport(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml", "rules.yml", "reports.yml"}
+
+	tests := []struct {
+		name          string
+		report        models.Report
+		wantVoxsphere uuid.UUID
+		wantErr       error
+	}{
+		{
+			name: "post not found :NEG",
+			report: models.Report{
+				ReporterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:     uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				Reason:     "spam",
+			},
+			wantErr: reportrepo.ErrReportPostNotFound,
+		},
+		{
+			name: "comment not found :NEG",
+			report: models.Report{
+				ReporterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CommentID:  uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				Reason:     "spam",
+			},
+			wantErr: reportrepo.ErrReportCommentNotFound,
+		},
+		{
+			name: "rule of another voxsphere :NEG",
+			report: models.Report{
+				ReporterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				RuleID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantErr: reportrepo.ErrReportRuleNotInVoxsphere,
+		},
+		{
+			name: "reporter not found :NEG",
+			report: models.Report{
+				ReporterID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				PostID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Reason:     "spam",
+			},
+			wantErr: reportrepo.ErrReportReporterNotFound,
+		},
+		{
+			name: "open report on the same post :NEG",
+			report: models.Report{
+				ReporterID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				PostID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Reason:     "spam",
+			},
+			wantErr: reportrepo.ErrReportDuplicate,
+		},
+		{
+			name: "resolved report on the same post :POS",
+			report: models.Report{
+				ReporterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Reason:     "spam",
+			},
+			wantVoxsphere: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name: "report post by rule :POS",
+			report: models.Report{
+				ReporterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PostID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				RuleID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantVoxsphere: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name: "report comment :POS",
+			report: models.Report{
+				ReporterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CommentID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Reason:     "spam",
+			},
+			wantVoxsphere: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := reportrepo.NewRepo(db)
+
+			tt.report.ID = uuid.New()
+			gotReport, gotErr := pgrepo.AddReport(context.Background(), tt.report)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, models.Report{}, gotReport, "expect no report")
+				return
+			}
+
+			assert.Equal(t, tt.wantVoxsphere, gotReport.VoxsphereID, "expect voxsphere of the target")
+			assert.False(t, gotReport.CreatedAt.IsZero(), "expect created at to be set")
+		})
+	}
+}
+
+func TestRepo_ReportQueue(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml", "rules.yml", "reports.yml"}
+
+	tests := []struct {
+		name        string
+		voxsphereID uuid.UUID
+		skip        int
+		limit       int
+		wantQueue   []models.ReportQueueItem
+	}{
+		{
+			name:        "no reports :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			skip:        0,
+			limit:       10,
+			wantQueue:   []models.ReportQueueItem{},
+		},
+		{
+			name:        "open reports grouped by target :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			skip:        0,
+			limit:       10,
+			wantQueue: []models.ReportQueueItem{
+				{
+					TargetType:  models.ReportTargetPost,
+					TargetID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ReportCount: 2,
+					Reasons: []models.ReportReason{
+						{RuleID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Rule: "rule_foo", Count: 1},
+						{RuleID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Rule: "rule_foo", Reason: "links to a scam", Count: 1},
+					},
+				},
+				{
+					TargetType:  models.ReportTargetComment,
+					TargetID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ReportCount: 1,
+					Reasons: []models.ReportReason{
+						{Reason: "rude", Count: 1},
+					},
+				},
+			},
+		},
+		{
+			name:        "skip the most reported :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			skip:        1,
+			limit:       10,
+			wantQueue: []models.ReportQueueItem{
+				{
+					TargetType:  models.ReportTargetComment,
+					TargetID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ReportCount: 1,
+					Reasons: []models.ReportReason{
+						{Reason: "rude", Count: 1},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := reportrepo.NewRepo(db)
+
+			gotQueue, gotErr := pgrepo.ReportQueue(context.Background(), tt.voxsphereID, tt.skip, tt.limit)
+			assert.NoError(t, gotErr, "expect no error")
+
+			// compare the grouping only, the report times come from the fixture
+			for i := range gotQueue {
+				assert.False(t, gotQueue[i].LastReportedAt.Before(gotQueue[i].FirstReportedAt), "expect last report after first report")
+				gotQueue[i].FirstReportedAt = time.Time{}
+				gotQueue[i].LastReportedAt = time.Time{}
+			}
+			assert.Equal(t, tt.wantQueue, gotQueue, "expect report queue to match")
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package saved
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const (
+	pgConstraintViolation = "23503"
+)
+
+var (
+	ErrSavedPostNotFound    = errors.New("saved post not found")
+	ErrSavedCommentNotFound = errors.New("saved comment not found")
+	ErrHiddenPostNotFound   = errors.New("hidden post not found")
+	ErrSavedUserNotFound    = errors.New("saved user not found")
+)
+
+type SavedRepository interface {
+	SavePost(context.Context, uuid.UUID, uuid.UUID) error
+	UnsavePost(context.Context, uuid.UUID, uuid.UUID) error
+	SaveComment(context.Context, uuid.UUID, uuid.UUID) error
+	UnsaveComment(context.Context, uuid.UUID, uuid.UUID) error
+	HidePost(context.Context, uuid.UUID, uuid.UUID) error
+	UnhidePost(context.Context, uuid.UUID, uuid.UUID) error
+	SavedRefs(context.Context, uuid.UUID, int, int) ([]models.SavedRef, error)
+}
+
+type Repo struct {
+	db *bun.DB
+}
+
+func NewRepo(db *bun.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// listTable is a table linking users to the posts or comments they listed,
+// along with the column of the listed item and the error of a missing item.
+type listTable struct {
+	name        string
+	column      string
+	errNotFound error
+}
+
+var (
+	savedPosts    = listTable{name: "saved_posts", column: "post_id", errNotFound: ErrSavedPostNotFound}
+	savedComments = listTable{name: "saved_comments", column: "comment_id", errNotFound: ErrSavedCommentNotFound}
+	hiddenPosts   = listTable{name: "hidden_posts", column: "post_id", errNotFound: ErrHiddenPostNotFound}
+)
+
+// SavePost bookmarks the post of postID for the user of userID. Saving a
+// saved post does nothing.
+func (r *Repo) SavePost(ctx context.Context, userID, postID uuid.UUID) error {
+	return r.add(ctx, savedPosts, userID, postID)
+}
+
+// UnsavePost removes the bookmark of the user of userID on the post of
+// postID, if any.
+func (r *Repo) UnsavePost(ctx context.Context, userID, postID uuid.UUID) error {
+	return r.remove(ctx, savedPosts, userID, postID)
+}
+
+// SaveComment bookmarks the comment of commentID for the user of userID.
+// Saving a saved comment does nothing.
+func (r *Repo) SaveComment(ctx context.Context, userID, commentID uuid.UUID) error {
+	return r.add(ctx, savedComments, userID, commentID)
+}
+
+// UnsaveComment removes the bookmark of the user of userID on the comment of
+// commentID, if any.
+func (r *Repo) UnsaveComment(ctx context.Context, userID, commentID uuid.UUID) error {
+	return r.remove(ctx, savedComments, userID, commentID)
+}
+
+// HidePost hides the post of postID from the feeds of the user of userID.
+// Hiding a hidden post does nothing.
+func (r *Repo) HidePost(ctx context.Context, userID, postID uuid.UUID) error {
+	return r.add(ctx, hiddenPosts, userID, postID)
+}
+
+// UnhidePost shows the post of postID in the feeds of the user of userID
+// again.
+func (r *Repo) UnhidePost(ctx context.Context, userID, postID uuid.UUID) error {
+	return r.remove(ctx, hiddenPosts, userID, postID)
+}
+
+func (r *Repo) add(ctx context.Context, table listTable, userID, ID uuid.UUID) error {
+	query := fmt.Sprintf(`
+                INSERT INTO
+                    %s (
+                        user_id,
+                        %s
+                    )
+                VALUES
+                    (?, ?)
+                ON CONFLICT DO NOTHING;
+            `, table.name, table.column)
+
+	if _, err := r.db.NewRaw(query, userID, ID).Exec(ctx); err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+			if pgdriverErr.Field('n') == "fk_user_id" {
+				return ErrSavedUserNotFound
+			}
+			return table.errNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *Repo) remove(ctx context.Context, table listTable, userID, ID uuid.UUID) error {
+	query := fmt.Sprintf(`
+                DELETE FROM
+                    %s
+                WHERE
+                    user_id = ?
+                    AND %s = ?;
+            `, table.name, table.column)
+
+	_, err := r.db.NewRaw(query, userID, ID).Exec(ctx)
+	return err
+}
+
+// SavedRefs returns the posts and comments saved by the user of userID, most
+// recently saved first. Saved items which were since removed or deleted are
+// left out before paging, so that every page is full.
+func (r *Repo) SavedRefs(ctx context.Context, userID uuid.UUID, skip, limit int) ([]models.SavedRef, error) {
+	refs := []models.SavedRef{}
+
+	query := `
+        SELECT
+            *
+        FROM
+            (
+                SELECT
+                    'post' AS type,
+                    sp.post_id AS id,
+                    sp.created_at AS saved_at
+                FROM
+                    saved_posts sp
+                    JOIN posts p ON p.id = sp.post_id
+                WHERE
+                    sp.user_id = ?
+                    AND p.removed_at IS NULL
+                UNION ALL
+                SELECT
+                    'comment' AS type,
+                    sc.comment_id AS id,
+                    sc.created_at AS saved_at
+                FROM
+                    saved_comments sc
+                    JOIN comments c ON c.id = sc.comment_id
+                WHERE
+                    sc.user_id = ?
+                    AND c.deleted_at IS NULL
+                    AND c.removed_at IS NULL
+            ) AS saved
+        ORDER BY
+            saved.saved_at DESC,
+            saved.id DESC
+        LIMIT ?
+        OFFSET ?;
+    `
+
+	if _, err := r.db.NewRaw(query, userID, userID, limit, skip).Exec(ctx, &refs); err != nil {
+		return []models.SavedRef{}, err
+	}
+	return refs, nil
+}
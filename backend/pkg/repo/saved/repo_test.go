@@ -0,0 +1,415 @@
+package saved_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	savedrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/saved"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dbfixture"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+)
+
+func connectPostgres(user, password, address, dbName string) *bun.DB {
+	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, address, dbName)
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
+	db := bun.NewDB(sqldb, pgdialect.New())
+	return db
+}
+
+func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
+	db := connectPostgres("postgres", "postgres", "127.0.0.1:5432", "voxpopuli")
+
+	if err := db.Ping(); err != nil {
+		t.Fatal("db error:", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Log("db close error:", err)
+		}
+	})
+
+	// add query logging hook
+	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
+
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
+	db.RegisterModel((*models.SavedPost)(nil))
+	db.RegisterModel((*models.SavedComment)(nil))
+	db.RegisterModel((*models.HiddenPost)(nil))
+
+	// drop all rows of the topics,users tables
+	for _, model := range []interface{}{
+		(*models.Topic)(nil),
+		(*models.User)(nil),
+	} {
+		_, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background())
+		if err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
+	}
+
+	// load fixture
+	fixture := dbfixture.New(db)
+	if err := fixture.Load(context.Background(), os.DirFS("testdata"), fixtureFiles...); err != nil {
+		t.Fatal("failed to load fixtures", err)
+	}
+
+	return db
+}
+
+var baseFixtures = []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"}
+
+func withBaseFixtures(files ...string) []string {
+	return append(append([]string{}, baseFixtures...), files...)
+}
+
+func countRows(t *testing.T, db *bun.DB, table, column string, userID, ID uuid.UUID) int {
+	t.Helper()
+
+	var count int
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE user_id = ? AND %s = ?", table, column)
+	if err := db.NewRaw(query, userID, ID).Scan(context.Background(), &count); err != nil {
+		t.Fatal("count rows failed:", err)
+	}
+	return count
+}
+
+func TestRepo_SavePost(t *testing.T) {
+	type args struct {
+		userID uuid.UUID
+		postID uuid.UUID
+	}
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantCount    int
+		wantErr      error
+	}{
+		{
+			name:         "post not found :NEG",
+			fixtureFiles: withBaseFixtures(),
+			args: args{
+				userID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			wantCount: 0,
+			wantErr:   savedrepo.ErrSavedPostNotFound,
+		},
+		{
+			name:         "user not found :NEG",
+			fixtureFiles: withBaseFixtures(),
+			args: args{
+				userID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantCount: 0,
+			wantErr:   savedrepo.ErrSavedUserNotFound,
+		},
+		{
+			name:         "save post :POS",
+			fixtureFiles: withBaseFixtures(),
+			args: args{
+				userID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantCount: 1,
+			wantErr:   nil,
+		},
+		{
+			name:         "save saved post :POS",
+			fixtureFiles: withBaseFixtures("saved_posts.yml"),
+			args: args{
+				userID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				postID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantCount: 1,
+			wantErr:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := savedrepo.NewRepo(db)
+
+			gotErr := pgrepo.SavePost(context.Background(), tt.args.userID, tt.args.postID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantCount, countRows(t, db, "saved_posts", "post_id", tt.args.userID, tt.args.postID), "expect saved posts to match")
+		})
+	}
+}
+
+func TestRepo_UnsavePost(t *testing.T) {
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		postID       uuid.UUID
+	}{
+		{
+			name:         "unsave post not saved :POS",
+			fixtureFiles: withBaseFixtures(),
+			postID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:         "unsave saved post :POS",
+			fixtureFiles: withBaseFixtures("saved_posts.yml"),
+			postID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := savedrepo.NewRepo(db)
+			userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+			gotErr := pgrepo.UnsavePost(context.Background(), userID, tt.postID)
+
+			assert.NoError(t, gotErr, "expect error to be nil")
+			assert.Equal(t, 0, countRows(t, db, "saved_posts", "post_id", userID, tt.postID), "expect post to be unsaved")
+		})
+	}
+}
+
+func TestRepo_SaveComment(t *testing.T) {
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		commentID    uuid.UUID
+		wantCount    int
+		wantErr      error
+	}{
+		{
+			name:         "comment not found :NEG",
+			fixtureFiles: withBaseFixtures(),
+			commentID:    uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantCount:    0,
+			wantErr:      savedrepo.ErrSavedCommentNotFound,
+		},
+		{
+			name:         "save comment :POS",
+			fixtureFiles: withBaseFixtures(),
+			commentID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantCount:    1,
+			wantErr:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := savedrepo.NewRepo(db)
+			userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+			gotErr := pgrepo.SaveComment(context.Background(), userID, tt.commentID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantCount, countRows(t, db, "saved_comments", "comment_id", userID, tt.commentID), "expect saved comments to match")
+
+			gotErr = pgrepo.UnsaveComment(context.Background(), userID, tt.commentID)
+
+			assert.NoError(t, gotErr, "expect error to be nil")
+			assert.Equal(t, 0, countRows(t, db, "saved_comments", "comment_id", userID, tt.commentID), "expect comment to be unsaved")
+		})
+	}
+}
+
+func TestRepo_HidePost(t *testing.T) {
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		postID       uuid.UUID
+		wantCount    int
+		wantErr      error
+	}{
+		{
+			name:         "post not found :NEG",
+			fixtureFiles: withBaseFixtures(),
+			postID:       uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantCount:    0,
+			wantErr:      savedrepo.ErrHiddenPostNotFound,
+		},
+		{
+			name:         "hide post :POS",
+			fixtureFiles: withBaseFixtures(),
+			postID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantCount:    1,
+			wantErr:      nil,
+		},
+		{
+			name:         "hide hidden post :POS",
+			fixtureFiles: withBaseFixtures("hidden_posts.yml"),
+			postID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantCount:    1,
+			wantErr:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := savedrepo.NewRepo(db)
+			userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+			gotErr := pgrepo.HidePost(context.Background(), userID, tt.postID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantCount, countRows(t, db, "hidden_posts", "post_id", userID, tt.postID), "expect hidden posts to match")
+
+			gotErr = pgrepo.UnhidePost(context.Background(), userID, tt.postID)
+
+			assert.NoError(t, gotErr, "expect error to be nil")
+			assert.Equal(t, 0, countRows(t, db, "hidden_posts", "post_id", userID, tt.postID), "expect post to be unhidden")
+		})
+	}
+}
+
+func TestRepo_SavedRefs(t *testing.T) {
+	type args struct {
+		userID uuid.UUID
+		skip   int
+		limit  int
+	}
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantRefs     []models.SavedRef
+		wantErr      error
+	}{
+		{
+			name:         "nothing saved :NEG",
+			fixtureFiles: withBaseFixtures(),
+			args: args{
+				userID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				skip:   0,
+				limit:  10,
+			},
+			wantRefs: []models.SavedRef{},
+			wantErr:  nil,
+		},
+		{
+			name:         "saved refs most recent first :POS",
+			fixtureFiles: withBaseFixtures("saved_posts.yml", "saved_comments.yml"),
+			args: args{
+				userID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				skip:   0,
+				limit:  10,
+			},
+			wantRefs: []models.SavedRef{
+				{
+					Type:    models.SavedItemTypeComment,
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					SavedAt: time.Date(2024, 10, 10, 10, 10, 40, 0, time.UTC),
+				},
+				{
+					Type:    models.SavedItemTypePost,
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					SavedAt: time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "saved refs paginated :POS",
+			fixtureFiles: withBaseFixtures("saved_posts.yml", "saved_comments.yml"),
+			args: args{
+				userID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				skip:   1,
+				limit:  1,
+			},
+			wantRefs: []models.SavedRef{
+				{
+					Type:    models.SavedItemTypePost,
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					SavedAt: time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "saved refs of other user :NEG",
+			fixtureFiles: withBaseFixtures("saved_posts.yml", "saved_comments.yml"),
+			args: args{
+				userID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				skip:   0,
+				limit:  10,
+			},
+			wantRefs: []models.SavedRef{},
+			wantErr:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := savedrepo.NewRepo(db)
+
+			gotRefs, gotErr := pgrepo.SavedRefs(context.Background(), tt.args.userID, tt.args.skip, tt.args.limit)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Len(t, gotRefs, len(tt.wantRefs), "expect refs length to match")
+			for i := range tt.wantRefs {
+				assert.Equal(t, tt.wantRefs[i].Type, gotRefs[i].Type, "expect ref type to match")
+				assert.Equal(t, tt.wantRefs[i].ID, gotRefs[i].ID, "expect ref id to match")
+				assert.True(t, tt.wantRefs[i].SavedAt.Equal(gotRefs[i].SavedAt), "expect ref saved at to match")
+			}
+		})
+	}
+}
+
+func TestRepo_SavedRefsSkipsRemoved(t *testing.T) {
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name     string
+		query    string
+		wantType models.SavedItemType
+		wantID   uuid.UUID
+	}{
+		{
+			name:     "removed comment :NEG",
+			query:    "UPDATE comments SET removed_at = NOW() WHERE id = '00000000-0000-0000-0000-000000000001'",
+			wantType: models.SavedItemTypePost,
+			wantID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		},
+		{
+			name:     "deleted comment :NEG",
+			query:    "UPDATE comments SET deleted_at = NOW() WHERE id = '00000000-0000-0000-0000-000000000001'",
+			wantType: models.SavedItemTypePost,
+			wantID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		},
+		{
+			name:     "removed post :NEG",
+			query:    "UPDATE posts SET removed_at = NOW() WHERE id = '00000000-0000-0000-0000-000000000002'",
+			wantType: models.SavedItemTypeComment,
+			wantID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, withBaseFixtures("saved_posts.yml", "saved_comments.yml")...)
+			pgrepo := savedrepo.NewRepo(db)
+			if _, err := db.NewRaw(tt.query).Exec(context.Background()); err != nil {
+				t.Fatalf("error removing saved item: %+v", err)
+			}
+
+			gotRefs, gotErr := pgrepo.SavedRefs(context.Background(), userID, 0, 1)
+			assert.NoError(t, gotErr, "expect no error")
+			if assert.Len(t, gotRefs, 1, "expect page to be full") {
+				assert.Equal(t, tt.wantType, gotRefs[0].Type, "expect ref type to match")
+				assert.Equal(t, tt.wantID, gotRefs[0].ID, "expect ref id to match")
+			}
+		})
+	}
+}
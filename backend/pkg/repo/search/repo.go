@@ -0,0 +1,187 @@
+package search
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glowfi/voxpopuli/backend/internal/render"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/uptrace/bun"
+)
+
+var ErrSearchInvalidType = errors.New("invalid search type")
+
+// searchHeadlineOptions configures the snippets of ts_headline. Matches are
+// set between plain text markers, the snippet is turned into html by
+// render.Highlight once it is read.
+const searchHeadlineOptions = "StartSel=\"" + render.HighlightStart + "\", StopSel=\"" + render.HighlightStop + "\", MinWords=10, MaxWords=30, MaxFragments=2, FragmentDelimiter=\" ... \""
+
+// searchQueries holds the query of each search type. Every query takes the
+// search text as ?0, the headline options as ?1, the limit as ?2 and the
+// offset as ?3. Names and titles are indexed without stemming, so voxspheres
+// and users are matched against both an english and a simple query.
+var searchQueries = map[models.SearchType]string{
+	models.SearchTypePost: `
+        WITH
+          q AS (
+            SELECT
+              WEBSEARCH_TO_TSQUERY('english', ?0) AS query
+          )
+        SELECT
+          'post' AS type,
+          p.id,
+          p.title,
+          TS_HEADLINE('english', COALESCE(NULLIF(p.text, ''), p.title), q.query, ?1) AS snippet,
+          TS_RANK(p.search_vector, q.query) AS rank,
+          NULL::UUID AS post_id,
+          v.title AS voxsphere,
+          u.name AS author,
+          p.created_at
+        FROM
+          posts p
+          CROSS JOIN q
+          JOIN voxspheres v ON v.id = p.voxsphere_id
+          JOIN users u ON u.id = p.author_id
+        WHERE
+          p.search_vector @@ q.query
+          AND p.removed_at IS NULL
+        ORDER BY
+          rank DESC,
+          p.created_at DESC,
+          p.id DESC
+        LIMIT
+          ?2
+        OFFSET
+          ?3;
+    `,
+	models.SearchTypeComment: `
+        WITH
+          q AS (
+            SELECT
+              WEBSEARCH_TO_TSQUERY('english', ?0) AS query
+          )
+        SELECT
+          'comment' AS type,
+          c.id,
+          p.title,
+          TS_HEADLINE('english', c.body, q.query, ?1) AS snippet,
+          TS_RANK(c.search_vector, q.query) AS rank,
+          c.post_id,
+          v.title AS voxsphere,
+          u.name AS author,
+          c.created_at
+        FROM
+          comments c
+          CROSS JOIN q
+          JOIN posts p ON p.id = c.post_id
+          JOIN voxspheres v ON v.id = p.voxsphere_id
+          JOIN users u ON u.id = c.author_id
+        WHERE
+          c.search_vector @@ q.query
+          AND c.removed_at IS NULL
+          AND c.deleted_at IS NULL
+          AND p.removed_at IS NULL
+        ORDER BY
+          rank DESC,
+          c.created_at DESC,
+          c.id DESC
+        LIMIT
+          ?2
+        OFFSET
+          ?3;
+    `,
+	models.SearchTypeVoxsphere: `
+        WITH
+          q AS (
+            SELECT
+              WEBSEARCH_TO_TSQUERY('english', ?0) AS query,
+              WEBSEARCH_TO_TSQUERY('simple', ?0) AS simple_query
+          )
+        SELECT
+          'voxsphere' AS type,
+          v.id,
+          v.title,
+          TS_HEADLINE('english', COALESCE(v.public_description, ''), q.query, ?1) AS snippet,
+          GREATEST(TS_RANK(v.search_vector, q.query), TS_RANK(v.search_vector, q.simple_query)) AS rank,
+          NULL::UUID AS post_id,
+          NULL::TEXT AS voxsphere,
+          NULL::TEXT AS author,
+          v.created_at
+        FROM
+          voxspheres v
+          CROSS JOIN q
+        WHERE
+          v.search_vector @@ q.query
+          OR v.search_vector @@ q.simple_query
+        ORDER BY
+          rank DESC,
+          v.created_at DESC,
+          v.id DESC
+        LIMIT
+          ?2
+        OFFSET
+          ?3;
+    `,
+	models.SearchTypeUser: `
+        WITH
+          q AS (
+            SELECT
+              WEBSEARCH_TO_TSQUERY('english', ?0) AS query,
+              WEBSEARCH_TO_TSQUERY('simple', ?0) AS simple_query
+          )
+        SELECT
+          'user' AS type,
+          u.id,
+          u.name AS title,
+          TS_HEADLINE('english', COALESCE(u.public_description, ''), q.query, ?1) AS snippet,
+          GREATEST(TS_RANK(u.search_vector, q.query), TS_RANK(u.search_vector, q.simple_query)) AS rank,
+          NULL::UUID AS post_id,
+          NULL::TEXT AS voxsphere,
+          NULL::TEXT AS author,
+          u.created_at
+        FROM
+          users u
+          CROSS JOIN q
+        WHERE
+          u.search_vector @@ q.query
+          OR u.search_vector @@ q.simple_query
+        ORDER BY
+          rank DESC,
+          u.created_at DESC,
+          u.id DESC
+        LIMIT
+          ?2
+        OFFSET
+          ?3;
+    `,
+}
+
+type SearchRepository interface {
+	Search(context.Context, string, models.SearchType, int, int) ([]models.SearchResult, error)
+}
+
+type Repo struct {
+	db *bun.DB
+}
+
+func NewRepo(db *bun.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// Search returns the entities of searchType matching the web search syntax
+// query, best ranked first.
+func (r *Repo) Search(ctx context.Context, query string, searchType models.SearchType, skip, limit int) ([]models.SearchResult, error) {
+	searchQuery, ok := searchQueries[searchType]
+	if !ok {
+		return []models.SearchResult{}, ErrSearchInvalidType
+	}
+
+	results := []models.SearchResult{}
+	if _, err := r.db.NewRaw(searchQuery, query, searchHeadlineOptions, limit, skip).Exec(ctx, &results); err != nil {
+		return []models.SearchResult{}, err
+	}
+	for i := range results {
+		results[i].Snippet = render.Highlight(results[i].Snippet)
+	}
+	return results, nil
+}
@@ -0,0 +1,349 @@
+package search_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	searchrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/search"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dbfixture"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+)
+
+func connectPostgres(user, password, address, dbName string) *bun.DB {
+	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, address, dbName)
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
+	db := bun.NewDB(sqldb, pgdialect.New())
+	return db
+}
+
+func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
+	db := connectPostgres("postgres", "postgres", "127.0.0.1:5432", "voxpopuli")
+
+	if err := db.Ping(); err != nil {
+		t.Fatal("db error:", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Log("db close error:", err)
+		}
+	})
+
+	// add query logging hook
+	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
+
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
+
+	// drop all rows of the searched tables
+	for _, model := range []interface{}{
+		(*models.Topic)(nil),
+		(*models.Voxsphere)(nil),
+		(*models.User)(nil),
+		(*models.Post)(nil),
+		(*models.Comment)(nil),
+	} {
+		if _, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background()); err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
+	}
+
+	// load fixture
+	fixture := dbfixture.New(db)
+	if err := fixture.Load(context.Background(), os.DirFS("testdata"), fixtureFiles...); err != nil {
+		t.Fatal("failed to load fixtures", err)
+	}
+
+	return db
+}
+
+func TestRepo_Search(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"}
+
+	type args struct {
+		query      string
+		searchType models.SearchType
+		skip       int
+		limit      int
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		wantResults []models.SearchResult
+		wantErr     error
+	}{
+		{
+			name: "invalid search type :NEG",
+			args: args{
+				query:      "generics",
+				searchType: models.SearchType("award"),
+				skip:       0,
+				limit:      10,
+			},
+			wantResults: []models.SearchResult{},
+			wantErr:     searchrepo.ErrSearchInvalidType,
+		},
+		{
+			name: "no matching posts :POS",
+			args: args{
+				query:      "kubernetes",
+				searchType: models.SearchTypePost,
+				skip:       0,
+				limit:      10,
+			},
+			wantResults: []models.SearchResult{},
+			wantErr:     nil,
+		},
+		{
+			name: "posts ranked by title over text :POS",
+			args: args{
+				query:      "generics",
+				searchType: models.SearchTypePost,
+				skip:       0,
+				limit:      10,
+			},
+			wantResults: []models.SearchResult{
+				{
+					Type:      models.SearchTypePost,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:     "Learning Go generics",
+					Snippet:   "<mark>Generics</mark> make containers reusable.",
+					Voxsphere: ptrof("v/foo"),
+					Author:    ptrof("John Doe"),
+					CreatedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+				},
+				{
+					Type:      models.SearchTypePost,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Title:     "Rust ownership explained",
+					Snippet:   "Borrowing rules and <mark>generics</mark> in Rust.",
+					Voxsphere: ptrof("v/bar"),
+					Author:    ptrof("Jane Doe"),
+					CreatedAt: time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "posts skip 1 limit 1 :POS",
+			args: args{
+				query:      "generics",
+				searchType: models.SearchTypePost,
+				skip:       1,
+				limit:      1,
+			},
+			wantResults: []models.SearchResult{
+				{
+					Type:      models.SearchTypePost,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Title:     "Rust ownership explained",
+					Snippet:   "Borrowing rules and <mark>generics</mark> in Rust.",
+					Voxsphere: ptrof("v/bar"),
+					Author:    ptrof("Jane Doe"),
+					CreatedAt: time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "post without text snippets the title :POS",
+			args: args{
+				query:      "hiking",
+				searchType: models.SearchTypePost,
+				skip:       0,
+				limit:      10,
+			},
+			wantResults: []models.SearchResult{
+				{
+					Type:      models.SearchTypePost,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Title:     "Weekend hiking photos",
+					Snippet:   "Weekend <mark>hiking</mark> photos",
+					Voxsphere: ptrof("v/foo"),
+					Author:    ptrof("Jane Doe"),
+					CreatedAt: time.Date(2024, 10, 10, 10, 10, 30, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "comments :POS",
+			args: args{
+				query:      "container",
+				searchType: models.SearchTypeComment,
+				skip:       0,
+				limit:      10,
+			},
+			wantResults: []models.SearchResult{
+				{
+					Type:      models.SearchTypeComment,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:     "Learning Go generics",
+					Snippet:   "Generics are great for <mark>containers</mark>",
+					PostID:    ptrof(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Voxsphere: ptrof("v/foo"),
+					Author:    ptrof("Jane Doe"),
+					CreatedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "voxspheres :POS",
+			args: args{
+				query:      "foo",
+				searchType: models.SearchTypeVoxsphere,
+				skip:       0,
+				limit:      10,
+			},
+			wantResults: []models.SearchResult{
+				{
+					Type:      models.SearchTypeVoxsphere,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:     "v/foo",
+					Snippet:   "<mark>foo</mark> PublicDescription",
+					CreatedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "users by name :POS",
+			args: args{
+				query:      "jane",
+				searchType: models.SearchTypeUser,
+				skip:       0,
+				limit:      10,
+			},
+			wantResults: []models.SearchResult{
+				{
+					Type:      models.SearchTypeUser,
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Title:     "Jane Doe",
+					Snippet:   "This is another public description",
+					CreatedAt: time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := searchrepo.NewRepo(db)
+
+			gotResults, gotErr := pgrepo.Search(context.Background(), tt.args.query, tt.args.searchType, tt.args.skip, tt.args.limit)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			// ranks depend on the text search configuration, only their order
+			// is asserted
+			for i := range gotResults {
+				if i > 0 {
+					assert.GreaterOrEqual(t, gotResults[i-1].Rank, gotResults[i].Rank, "expect results to be ordered by rank")
+				}
+				gotResults[i].Rank = 0
+			}
+			assert.Equal(t, tt.wantResults, gotResults, "expect search results to match")
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
+
+func TestRepo_SearchEscapesSnippets(t *testing.T) {
+	db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml")
+	pgrepo := searchrepo.NewRepo(db)
+
+	gotResults, gotErr := pgrepo.Search(context.Background(), "gadgets", models.SearchTypePost, 0, 10)
+
+	assert.NoError(t, gotErr, "expect no error")
+	if !assert.Len(t, gotResults, 1, "expect one search result") {
+		return
+	}
+	snippet := gotResults[0].Snippet
+	assert.Contains(t, snippet, "<mark>gadgets</mark>", "expect match to be marked")
+
+	unmarked := strings.NewReplacer("<mark>", "", "</mark>", "").Replace(snippet)
+	assert.NotContains(t, unmarked, "<", "expect snippet to hold no html but the marks")
+	assert.NotContains(t, unmarked, ">", "expect snippet to hold no html but the marks")
+}
+
+func TestRepo_SearchSkipsRemoved(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"}
+
+	tests := []struct {
+		name       string
+		update     string
+		searchType models.SearchType
+		hiddenID   uuid.UUID
+	}{
+		{
+			name:       "removed post :POS",
+			update:     "UPDATE posts SET removed_at = NOW() WHERE id = '00000000-0000-0000-0000-000000000001'",
+			searchType: models.SearchTypePost,
+			hiddenID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:       "comment of removed post :POS",
+			update:     "UPDATE posts SET removed_at = NOW() WHERE id = '00000000-0000-0000-0000-000000000001'",
+			searchType: models.SearchTypeComment,
+			hiddenID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:       "removed comment :POS",
+			update:     "UPDATE comments SET removed_at = NOW() WHERE id = '00000000-0000-0000-0000-000000000001'",
+			searchType: models.SearchTypeComment,
+			hiddenID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:       "deleted comment :POS",
+			update:     "UPDATE comments SET deleted_at = NOW() WHERE id = '00000000-0000-0000-0000-000000000001'",
+			searchType: models.SearchTypeComment,
+			hiddenID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := searchrepo.NewRepo(db)
+
+			gotResults, gotErr := pgrepo.Search(context.Background(), "generics", tt.searchType, 0, 10)
+			assert.NoError(t, gotErr, "expect no error")
+			assert.True(t, containsResult(gotResults, tt.hiddenID), "expect result to be found before it is hidden")
+
+			if _, err := db.ExecContext(context.Background(), tt.update); err != nil {
+				t.Fatal("failed to hide result", err)
+			}
+
+			gotResults, gotErr = pgrepo.Search(context.Background(), "generics", tt.searchType, 0, 10)
+			assert.NoError(t, gotErr, "expect no error")
+			assert.False(t, containsResult(gotResults, tt.hiddenID), "expect hidden result not to be found")
+		})
+	}
+}
+
+func containsResult(results []models.SearchResult, ID uuid.UUID) bool {
+	for _, result := range results {
+		if result.ID == ID {
+			return true
+		}
+	}
+	return false
+}
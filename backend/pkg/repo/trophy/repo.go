@@ -6,6 +6,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/glowfi/voxpopuli/backend/internal/karma"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -13,12 +14,14 @@ import (
 )
 
 const (
-	pgUniqueViolation = "23505"
+	pgUniqueViolation     = "23505"
+	pgConstraintViolation = "23503"
 )
 
 var (
 	ErrTrophyNotFound           = errors.New("trophy not found")
 	ErrTrophyDuplicateIDorTitle = errors.New("trophy duplicate id or title")
+	ErrTrophyUserNotFound       = errors.New("trophy user not found")
 )
 
 type TrophyRepository interface {
@@ -27,6 +30,10 @@ type TrophyRepository interface {
 	AddTrophies(context.Context, ...models.Trophy) ([]models.Trophy, error)
 	UpdateTrophy(context.Context, models.Trophy) (models.Trophy, error)
 	DeleteTrophy(context.Context, uuid.UUID) error
+	TrophyCriteria(context.Context) ([]models.TrophyCriteria, error)
+	SetTrophyCriteria(context.Context, models.TrophyCriteria) (models.TrophyCriteria, error)
+	UserAchievements(context.Context, uuid.UUID) (models.UserAchievements, error)
+	UsersAchievementsAfter(context.Context, uuid.UUID, int) ([]models.UserAchievements, error)
 }
 
 type Repo struct {
@@ -170,3 +177,159 @@ func (r *Repo) DeleteTrophy(ctx context.Context, ID uuid.UUID) error {
 	}
 	return nil
 }
+
+// TrophyCriteria returns the criteria of every trophy the trophy engine
+// grants.
+func (r *Repo) TrophyCriteria(ctx context.Context) ([]models.TrophyCriteria, error) {
+	var criteria []models.TrophyCriteria
+
+	query := `
+                SELECT
+                    trophy_id,
+                    criteria,
+                    updated_at
+                FROM
+                    trophy_criteria;
+            `
+
+	_, err := r.db.NewRaw(query).Exec(ctx, &criteria)
+	if err != nil {
+		return []models.TrophyCriteria{}, err
+	}
+	return criteria, nil
+}
+
+// SetTrophyCriteria replaces the criteria of the trophy of
+// criteria.TrophyID.
+func (r *Repo) SetTrophyCriteria(ctx context.Context, criteria models.TrophyCriteria) (models.TrophyCriteria, error) {
+	query := `
+                INSERT INTO
+                    trophy_criteria (
+                        trophy_id,
+                        criteria
+                    )
+                VALUES
+                    (?, ?)
+                ON CONFLICT (trophy_id) DO UPDATE
+                SET
+                    criteria = EXCLUDED.criteria
+                RETURNING *;
+            `
+
+	if _, err := r.db.NewRaw(query, criteria.TrophyID, criteria.Criteria).Exec(ctx, &criteria); err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+			return models.TrophyCriteria{}, ErrTrophyNotFound
+		}
+		return models.TrophyCriteria{}, err
+	}
+	return criteria, nil
+}
+
+// userAchievementsQuery selects the achievements of the users matching
+// where, which refers to the users table aliased as u. The top post of a
+// voxsphere is its most upvoted post that was not removed, the oldest one
+// winning a tie.
+func userAchievementsQuery(where string) string {
+	return `
+        WITH
+          top_posts AS (
+            SELECT DISTINCT ON (p.voxsphere_id)
+              p.voxsphere_id,
+              p.author_id
+            FROM
+              posts p
+            WHERE
+              p.removed_at IS NULL
+            ORDER BY
+              p.voxsphere_id,
+              p.ups DESC,
+              p.created_at,
+              p.id
+          )
+        SELECT
+          u.id AS user_id,
+          u.created_at,
+          ` + karma.Query("u.id") + ` AS karma,
+          (
+            SELECT
+              count(*)
+            FROM
+              posts p
+            WHERE
+              p.author_id = u.id
+          ) AS num_posts,
+          (
+            SELECT
+              count(*)
+            FROM
+              top_posts tp
+            WHERE
+              tp.author_id = u.id
+          ) AS num_top_posts,
+          (
+            SELECT
+              count(*)
+            FROM
+              post_awards pa
+              JOIN posts p ON p.id = pa.post_id
+            WHERE
+              p.author_id = u.id
+          ) + (
+            SELECT
+              count(*)
+            FROM
+              award_grants ag
+              LEFT JOIN posts p ON p.id = ag.post_id
+              LEFT JOIN comments c ON c.id = ag.comment_id
+            WHERE
+              COALESCE(p.author_id, c.author_id) = u.id
+          ) AS awards_received,
+          (
+            SELECT
+              JSON_AGG(ut.trophy_id)
+            FROM
+              user_trophies ut
+            WHERE
+              ut.user_id = u.id
+          ) AS trophy_ids
+        FROM
+          users u
+        WHERE
+          ` + where + `
+        ORDER BY
+          u.id
+    `
+}
+
+// UserAchievements returns the achievements of the user of userID.
+func (r *Repo) UserAchievements(ctx context.Context, userID uuid.UUID) (models.UserAchievements, error) {
+	var achievements models.UserAchievements
+
+	query := userAchievementsQuery("u.id = ?") + ";"
+
+	if _, err := r.db.NewRaw(query, userID).Exec(ctx, &achievements); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.UserAchievements{}, ErrTrophyUserNotFound
+		}
+		return models.UserAchievements{}, err
+	}
+	return achievements, nil
+}
+
+// UsersAchievementsAfter returns the achievements of up to limit users, in
+// the order of their IDs, starting after the user of afterID. uuid.Nil starts
+// from the first user.
+func (r *Repo) UsersAchievementsAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]models.UserAchievements, error) {
+	var achievements []models.UserAchievements
+
+	query := userAchievementsQuery("u.id > ?") + `
+        LIMIT
+          ?;
+    `
+
+	if _, err := r.db.NewRaw(query, afterID, limit).Exec(ctx, &achievements); err != nil {
+		return []models.UserAchievements{}, err
+	}
+	return achievements, nil
+}
@@ -3,10 +3,12 @@ package trophy_test
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"os"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
 	trophyrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/trophy"
@@ -41,12 +43,28 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	// add query logging hook
 	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
 
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
+	db.RegisterModel((*models.Award)(nil))
+	db.RegisterModel((*models.PostAward)(nil))
+	db.RegisterModel((*models.AwardGrant)(nil))
 	db.RegisterModel((*models.Trophy)(nil))
-
-	// drop all rows of the trophy table
-	_, err := db.NewTruncateTable().Cascade().Model((*models.Trophy)(nil)).Exec(context.Background())
-	if err != nil {
-		t.Fatal("truncate table failed:", err)
+	db.RegisterModel((*models.UserTrophy)(nil))
+
+	// drop all rows of the trophy,topics,users,awards tables
+	for _, model := range []interface{}{
+		(*models.Trophy)(nil),
+		(*models.Topic)(nil),
+		(*models.User)(nil),
+		(*models.Award)(nil),
+	} {
+		_, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background())
+		if err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
 	}
 
 	// load fixture
@@ -438,3 +456,174 @@ func TestRepo_DeleteTrophy(t *testing.T) {
 		})
 	}
 }
+
+var achievementsFixtureFiles = []string{
+	"topics.yml",
+	"voxspheres.yml",
+	"users.yml",
+	"posts.yml",
+	"comments.yml",
+	"awards.yml",
+	"post_awards.yml",
+	"award_grants.yml",
+	"trophies.yml",
+	"user_trophies.yml",
+}
+
+var (
+	johnAchievements = models.UserAchievements{
+		UserID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		CreatedAt:      time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+		Karma:          10,
+		NumPosts:       1,
+		NumTopPosts:    1,
+		AwardsReceived: 1,
+		TrophyIDs:      []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+	}
+	janeAchievements = models.UserAchievements{
+		UserID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		CreatedAt:      time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+		Karma:          9,
+		NumPosts:       1,
+		NumTopPosts:    0,
+		AwardsReceived: 1,
+		TrophyIDs:      nil,
+	}
+)
+
+func TestRepo_SetTrophyCriteria(t *testing.T) {
+	tests := []struct {
+		name         string
+		criteria     []models.TrophyCriteria
+		wantCriteria []models.TrophyCriteria
+		wantErr      error
+	}{
+		{
+			name: "trophy not found :NEG",
+			criteria: []models.TrophyCriteria{
+				{
+					TrophyID: uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+					Criteria: json.RawMessage(`{"first_post": true}`),
+				},
+			},
+			wantCriteria: nil,
+			wantErr:      trophyrepo.ErrTrophyNotFound,
+		},
+		{
+			name: "replace criteria :POS",
+			criteria: []models.TrophyCriteria{
+				{
+					TrophyID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Criteria: json.RawMessage(`{"first_post": true}`),
+				},
+				{
+					TrophyID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Criteria: json.RawMessage(`{"account_age_days": 365}`),
+				},
+			},
+			wantCriteria: []models.TrophyCriteria{
+				{
+					TrophyID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Criteria: json.RawMessage(`{"account_age_days": 365}`),
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "trophies.yml")
+			pgrepo := trophyrepo.NewRepo(db)
+
+			var gotErr error
+			for _, criteria := range tt.criteria {
+				_, gotErr = pgrepo.SetTrophyCriteria(context.Background(), criteria)
+			}
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			gotCriteria, err := pgrepo.TrophyCriteria(context.Background())
+			assert.NoError(t, err, "expect no error while getting criteria")
+			assert.Equal(t, len(tt.wantCriteria), len(gotCriteria), "expect number of criteria to match")
+			for i := range tt.wantCriteria {
+				assert.Equal(t, tt.wantCriteria[i].TrophyID, gotCriteria[i].TrophyID, "expect trophy id to match")
+				assert.JSONEq(t, string(tt.wantCriteria[i].Criteria), string(gotCriteria[i].Criteria), "expect criteria to match")
+			}
+		})
+	}
+}
+
+func TestRepo_UserAchievements(t *testing.T) {
+	tests := []struct {
+		name             string
+		userID           uuid.UUID
+		wantAchievements models.UserAchievements
+		wantErr          error
+	}{
+		{
+			name:             "user not found :NEG",
+			userID:           uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			wantAchievements: models.UserAchievements{},
+			wantErr:          trophyrepo.ErrTrophyUserNotFound,
+		},
+		{
+			name:             "top poster holding a trophy :POS",
+			userID:           johnAchievements.UserID,
+			wantAchievements: johnAchievements,
+		},
+		{
+			name:             "commenter awarded on a comment :POS",
+			userID:           janeAchievements.UserID,
+			wantAchievements: janeAchievements,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, achievementsFixtureFiles...)
+			pgrepo := trophyrepo.NewRepo(db)
+
+			gotAchievements, gotErr := pgrepo.UserAchievements(context.Background(), tt.userID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantAchievements, gotAchievements, "expect achievements to match")
+		})
+	}
+}
+
+func TestRepo_UsersAchievementsAfter(t *testing.T) {
+	tests := []struct {
+		name             string
+		afterID          uuid.UUID
+		limit            int
+		wantAchievements []models.UserAchievements
+	}{
+		{
+			name:             "first page :POS",
+			afterID:          uuid.Nil,
+			limit:            1,
+			wantAchievements: []models.UserAchievements{johnAchievements},
+		},
+		{
+			name:             "next page :POS",
+			afterID:          johnAchievements.UserID,
+			limit:            1,
+			wantAchievements: []models.UserAchievements{janeAchievements},
+		},
+		{
+			name:             "last page :POS",
+			afterID:          janeAchievements.UserID,
+			limit:            1,
+			wantAchievements: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, achievementsFixtureFiles...)
+			pgrepo := trophyrepo.NewRepo(db)
+
+			gotAchievements, gotErr := pgrepo.UsersAchievementsAfter(context.Background(), tt.afterID, tt.limit)
+
+			assert.NoError(t, gotErr, "expect no error")
+			assert.Equal(t, tt.wantAchievements, gotAchievements, "expect achievements to match")
+		})
+	}
+}
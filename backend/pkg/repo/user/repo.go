@@ -7,6 +7,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/glowfi/voxpopuli/backend/internal/flairtext"
+	"github.com/glowfi/voxpopuli/backend/internal/karma"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -25,6 +27,10 @@ var (
 type UserRepository interface {
 	Users(context.Context) ([]models.User, error)
 	UserByID(context.Context, uuid.UUID) (models.User, error)
+	UserByName(context.Context, string) (models.User, error)
+	TrophiesByUserID(context.Context, uuid.UUID) ([]models.Trophy, error)
+	UserFlairsByUserID(context.Context, uuid.UUID) ([]models.UserFlairRendered, error)
+	KarmaByUserID(context.Context, uuid.UUID) (models.UserKarma, error)
 	AddUsers(context.Context, ...models.User) ([]models.User, error)
 	UpdateUser(context.Context, models.User) (models.User, error)
 	DeleteUser(context.Context, uuid.UUID) error
@@ -100,6 +106,117 @@ func (r *Repo) UserByID(ctx context.Context, ID uuid.UUID) (models.User, error)
 	return user, nil
 }
 
+func (r *Repo) UserByName(ctx context.Context, name string) (models.User, error) {
+	var user models.User
+
+	query := `
+                SELECT
+                    id,
+                    name,
+                    public_description,
+                    avatar_img,
+                    banner_img,
+                    iconcolor,
+                    keycolor,
+                    primarycolor,
+                    over18,
+                    suspended,
+                    created_at,
+                    created_at_unix,
+                    updated_at
+                FROM
+                    users
+                WHERE
+                    name = ?;
+            `
+	_, err := r.db.NewRaw(query, name).Exec(ctx, &user)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.User{}, ErrUserNotFound
+		}
+		return models.User{}, err
+	}
+	return user, nil
+}
+
+func (r *Repo) TrophiesByUserID(ctx context.Context, ID uuid.UUID) ([]models.Trophy, error) {
+	trophies := []models.Trophy{}
+
+	query := `
+                SELECT
+                    t.id,
+                    t.title,
+                    t.description,
+                    t.image_link
+                FROM
+                    trophies t
+                JOIN
+                    user_trophies ut ON ut.trophy_id = t.id
+                WHERE
+                    ut.user_id = ?
+                ORDER BY
+                    t.title;
+            `
+	_, err := r.db.NewRaw(query, ID).Exec(ctx, &trophies)
+	if err != nil {
+		return []models.Trophy{}, err
+	}
+	return trophies, nil
+}
+
+// UserFlairsByUserID returns the flairs a user picked in every voxsphere, with
+// their descriptions, emojis and custom emojis merged into ordered rich text.
+func (r *Repo) UserFlairsByUserID(ctx context.Context, ID uuid.UUID) ([]models.UserFlairRendered, error) {
+	flairs := []models.UserFlairRendered{}
+
+	query := `
+                SELECT
+                    uf.id,
+                    uf.voxsphere_id,
+                    v.title AS voxsphere,
+                    uf.full_text,
+                    COALESCE(uf.background_color, '') AS background_color,
+                    ` + flairtext.UserQuery + ` AS richtext
+                FROM
+                    user_flairs uf
+                JOIN
+                    user_user_flairs uuf ON uuf.user_flair_id = uf.id
+                JOIN
+                    voxspheres v ON v.id = uf.voxsphere_id
+                WHERE
+                    uuf.user_id = ?
+                ORDER BY
+                    v.title;
+            `
+	_, err := r.db.NewRaw(query, ID).Exec(ctx, &flairs)
+	if err != nil {
+		return []models.UserFlairRendered{}, err
+	}
+
+	for i := range flairs {
+		flairtext.RenderEmojis(flairs[i].Richtext)
+	}
+	return flairs, nil
+}
+
+// KarmaByUserID sums the ups of the posts and the score of the comments of a
+// user.
+func (r *Repo) KarmaByUserID(ctx context.Context, ID uuid.UUID) (models.UserKarma, error) {
+	var userKarma models.UserKarma
+
+	query := `
+                SELECT
+                    ` + karma.PostQuery("?0") + ` AS post_karma,
+                    ` + karma.CommentQuery("?0") + ` AS comment_karma;
+            `
+	_, err := r.db.NewRaw(query, ID).Exec(ctx, &userKarma)
+	if err != nil {
+		return models.UserKarma{}, err
+	}
+	userKarma.Total = userKarma.PostKarma + userKarma.CommentKarma
+	return userKarma, nil
+}
+
 func (r *Repo) AddUsers(ctx context.Context, users ...models.User) ([]models.User, error) {
 	query := `
         INSERT INTO
@@ -148,7 +265,7 @@ func (r *Repo) AddUsers(ctx context.Context, users ...models.User) ([]models.Use
 	}
 
 	query += strings.Join(placeholders, ", ")
-	query += " RETURNING *"
+	query += " RETURNING id, name, public_description, avatar_img, banner_img, iconcolor, keycolor, primarycolor, over18, suspended, created_at, created_at_unix, updated_at"
 
 	if _, err := r.db.NewRaw(
 		query, args...).Exec(ctx, &users); err != nil {
@@ -179,7 +296,7 @@ func (r *Repo) UpdateUser(ctx context.Context, user models.User) (models.User, e
                     updated_at = ?
                 WHERE
                     id = ?
-                RETURNING *
+                RETURNING id, name, public_description, avatar_img, banner_img, iconcolor, keycolor, primarycolor, over18, suspended, created_at, created_at_unix, updated_at
             `
 
 	user.UpdatedAt = time.Now()
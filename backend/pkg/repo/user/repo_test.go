@@ -42,13 +42,32 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	// add query logging hook
 	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
 
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
 	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
+	db.RegisterModel((*models.Trophy)(nil))
+	db.RegisterModel((*models.UserTrophy)(nil))
+	db.RegisterModel((*models.Emoji)(nil))
+	db.RegisterModel((*models.CustomEmoji)(nil))
+	db.RegisterModel((*models.UserFlair)(nil))
+	db.RegisterModel((*models.UserUserFlair)(nil))
+	db.RegisterModel((*models.UserFlairDescription)(nil))
+	db.RegisterModel((*models.UserFlairEmoji)(nil))
+	db.RegisterModel((*models.UserFlairCustomEmoji)(nil))
 
 	// drop all rows of the user table
 	_, err := db.NewTruncateTable().Cascade().Model((*models.User)(nil)).Exec(context.Background())
 	if err != nil {
 		t.Fatal("truncate table failed:", err)
 	}
+	// drop all rows of the topic, voxsphere, trophy and emoji tables
+	for _, model := range []interface{}{(*models.Topic)(nil), (*models.Voxsphere)(nil), (*models.Trophy)(nil), (*models.Emoji)(nil)} {
+		if _, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background()); err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
+	}
 
 	// load fixture
 	fixture := dbfixture.New(db)
@@ -234,6 +253,275 @@ func TestRepo_UserByID(t *testing.T) {
 	}
 }
 
+func TestRepo_UserByName(t *testing.T) {
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantUser     models.User
+		wantErr      error
+	}{
+		{
+			name:         "user not found :NEG",
+			fixtureFiles: []string{"users.yml"},
+			args: args{
+				name: "Nobody",
+			},
+			wantUser: models.User{},
+			wantErr:  userrepo.ErrUserNotFound,
+		},
+		{
+			name:         "user found :POS",
+			fixtureFiles: []string{"users.yml"},
+			args: args{
+				name: "Jane Doe",
+			},
+			wantUser: models.User{
+				ID:                uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Name:              "Jane Doe",
+				PublicDescription: ptrof("This is another public description"),
+				AvatarImg:         ptrof("https://example.com/avatar2.jpg"),
+				BannerImg:         ptrof("https://example.com/banner2.jpg"),
+				Iconcolor:         ptrof("#FFFF00"),
+				Keycolor:          ptrof("#FF00FF"),
+				Primarycolor:      ptrof("#00FFFF"),
+				Over18:            true,
+				Suspended:         false,
+				CreatedAt:         time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+				CreatedAtUnix:     1725091101,
+				UpdatedAt:         time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := userrepo.NewRepo(db)
+
+			gotUser, gotErr := pgrepo.UserByName(context.Background(), tt.args.name)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantUser, gotUser, "expect user to match")
+		})
+	}
+}
+
+func TestRepo_TrophiesByUserID(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantTrophies []models.Trophy
+		wantErr      error
+	}{
+		{
+			name:         "user without trophies :POS",
+			fixtureFiles: []string{"users.yml", "trophies.yml", "user_trophies.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantTrophies: []models.Trophy{},
+			wantErr:      nil,
+		},
+		{
+			name:         "user trophies ordered by title :POS",
+			fixtureFiles: []string{"users.yml", "trophies.yml", "user_trophies.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantTrophies: []models.Trophy{
+				{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Title:       "One-Year Club",
+					Description: "Member for a year",
+					ImageLink:   "https://example.com/trophy2.png",
+				},
+				{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:       "Verified Email",
+					Description: "Verified the email address of the account",
+					ImageLink:   "https://example.com/trophy1.png",
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := userrepo.NewRepo(db)
+
+			gotTrophies, gotErr := pgrepo.TrophiesByUserID(context.Background(), tt.args.ID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantTrophies, gotTrophies, "expect trophies to match")
+		})
+	}
+}
+
+func TestRepo_UserFlairsByUserID(t *testing.T) {
+	fixtureFiles := []string{
+		"topics.yml",
+		"voxspheres.yml",
+		"users.yml",
+		"emojis.yml",
+		"custom_emojis.yml",
+		"user_flairs.yml",
+		"user_user_flairs.yml",
+		"user_flair_descriptions.yml",
+		"user_flair_emojis.yml",
+		"user_flair_custom_emojis.yml",
+	}
+
+	type args struct {
+		ID uuid.UUID
+	}
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantFlairs   []models.UserFlairRendered
+		wantErr      error
+	}{
+		{
+			name:         "user without flairs :POS",
+			fixtureFiles: fixtureFiles,
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantFlairs: []models.UserFlairRendered{},
+			wantErr:    nil,
+		},
+		{
+			name:         "user flairs with rendered richtext :POS",
+			fixtureFiles: fixtureFiles,
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantFlairs: []models.UserFlairRendered{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Voxsphere:       "v/bar",
+					FullText:        "bar fan :ce1:",
+					BackgroundColor: "#00ff00",
+					Richtext: []models.FlairRichtext{
+						{
+							Type:       models.FlairRichtextTypeText,
+							OrderIndex: 1,
+							Text:       "bar fan ",
+						},
+						{
+							Type:       models.FlairRichtextTypeCustomEmoji,
+							OrderIndex: 2,
+							Text:       ":ce1:",
+							Url:        ptrof("https://example.com/ce1.png"),
+						},
+					},
+				},
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Voxsphere:       "v/foo",
+					FullText:        ":1f600: foo fan",
+					BackgroundColor: "#ff0000",
+					Richtext: []models.FlairRichtext{
+						{
+							Type:       models.FlairRichtextTypeEmoji,
+							OrderIndex: 1,
+							Text:       "😀",
+						},
+						{
+							Type:       models.FlairRichtextTypeText,
+							OrderIndex: 2,
+							Text:       " foo fan",
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := userrepo.NewRepo(db)
+
+			gotFlairs, gotErr := pgrepo.UserFlairsByUserID(context.Background(), tt.args.ID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantFlairs, gotFlairs, "expect user flairs to match")
+		})
+	}
+}
+
+func TestRepo_KarmaByUserID(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantKarma    models.UserKarma
+		wantErr      error
+	}{
+		{
+			name:         "user without posts or comments :POS",
+			fixtureFiles: []string{"users.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantKarma: models.UserKarma{},
+			wantErr:   nil,
+		},
+		{
+			name:         "karma of post ups and comment scores :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantKarma: models.UserKarma{
+				PostKarma:    5,
+				CommentKarma: 2,
+				Total:        7,
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "karma of another user :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantKarma: models.UserKarma{
+				PostKarma:    30,
+				CommentKarma: 4,
+				Total:        34,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := userrepo.NewRepo(db)
+
+			gotKarma, gotErr := pgrepo.KarmaByUserID(context.Background(), tt.args.ID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantKarma, gotKarma, "expect karma to match")
+		})
+	}
+}
+
 func TestRepo_AddUsers(t *testing.T) {
 	type args struct {
 		users []models.User
@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/glowfi/voxpopuli/backend/internal/flairtext"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -21,14 +23,26 @@ var (
 	ErrUserFlairNotFound                  = errors.New("user flair not found")
 	ErrUserFlairDuplicateID               = errors.New("user flair duplicate id")
 	ErrUserFlairParentTableRecordNotFound = errors.New("record does not exist in the parent table")
+	ErrUserFlairVoxsphereNotFound         = errors.New("voxsphere not found")
+	ErrUserFlairNotInVoxsphere            = errors.New("user flair is not a flair of the voxsphere")
+	ErrUserFlairEmojiNotFound             = errors.New("emoji not found in the voxsphere")
+	ErrUserFlairInvalidPiece              = errors.New("invalid user flair piece")
 )
 
+// maxFullTextLength is the length of the full_text column of user_flairs.
+const maxFullTextLength = 255
+
 type UserFlairRepository interface {
 	UserFlairs(context.Context) ([]models.UserFlair, error)
 	UserFlairByID(context.Context, uuid.UUID) (models.UserFlair, error)
 	AddUserFlairs(context.Context, ...models.UserFlair) ([]models.UserFlair, error)
 	UpdateUserFlair(context.Context, models.UserFlair) (models.UserFlair, error)
 	DeleteUserFlair(context.Context, uuid.UUID) error
+	VoxsphereUserFlairs(context.Context, uuid.UUID) ([]models.UserFlairRendered, error)
+	AddUserFlairTemplate(context.Context, models.UserFlair, []models.FlairRichtextPiece) (models.UserFlairRendered, error)
+	UpdateUserFlairTemplate(context.Context, models.UserFlair, []models.FlairRichtextPiece) (models.UserFlairRendered, error)
+	SetUserFlair(ctx context.Context, userID, voxsphereID, userFlairID uuid.UUID) error
+	ClearUserFlair(ctx context.Context, userID, voxsphereID uuid.UUID) error
 }
 
 type Repo struct {
@@ -186,3 +200,342 @@ func (r *Repo) DeleteUserFlair(ctx context.Context, ID uuid.UUID) error {
 	}
 	return nil
 }
+
+// userFlairsRenderedQuery selects user flairs with their descriptions, emojis
+// and custom emojis merged into ordered rich text. It expects a WHERE clause
+// on the flair aliased as uf to be appended.
+var userFlairsRenderedQuery = `
+                SELECT
+                    uf.id,
+                    uf.voxsphere_id,
+                    v.title AS voxsphere,
+                    uf.full_text,
+                    COALESCE(uf.background_color, '') AS background_color,
+                    ` + flairtext.UserQuery + ` AS richtext
+                FROM
+                    user_flairs uf
+                JOIN
+                    voxspheres v ON v.id = uf.voxsphere_id
+            `
+
+// VoxsphereUserFlairs returns the user flairs members of the voxsphere of
+// voxsphereID can pick, ordered by text.
+func (r *Repo) VoxsphereUserFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.UserFlairRendered, error) {
+	flairs := []models.UserFlairRendered{}
+
+	query := userFlairsRenderedQuery + `
+                WHERE
+                    uf.voxsphere_id = ?
+                ORDER BY
+                    uf.full_text,
+                    uf.id;
+            `
+	if _, err := r.db.NewRaw(query, voxsphereID).Exec(ctx, &flairs); err != nil {
+		return []models.UserFlairRendered{}, err
+	}
+
+	for i := range flairs {
+		flairtext.RenderEmojis(flairs[i].Richtext)
+	}
+	return flairs, nil
+}
+
+// userFlairRenderedByID returns the user flair of ID with its rich text.
+func userFlairRenderedByID(ctx context.Context, db bun.IDB, ID uuid.UUID) (models.UserFlairRendered, error) {
+	var flair models.UserFlairRendered
+
+	query := userFlairsRenderedQuery + `
+                WHERE
+                    uf.id = ?;
+            `
+	if _, err := db.NewRaw(query, ID).Exec(ctx, &flair); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.UserFlairRendered{}, ErrUserFlairNotFound
+		}
+		return models.UserFlairRendered{}, err
+	}
+
+	flairtext.RenderEmojis(flair.Richtext)
+	return flair, nil
+}
+
+// AddUserFlairTemplate adds userFlair to its voxsphere with richtext as its
+// pieces. The full text of the flair is derived from the pieces.
+func (r *Repo) AddUserFlairTemplate(ctx context.Context, userFlair models.UserFlair, richtext []models.FlairRichtextPiece) (models.UserFlairRendered, error) {
+	var flair models.UserFlairRendered
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		query := `
+                INSERT INTO
+                    user_flairs (
+                        id,
+                        voxsphere_id,
+                        full_text,
+                        background_color
+                    )
+                VALUES
+                    (?, ?, '', ?);
+            `
+		if _, err := tx.NewRaw(query, userFlair.ID, userFlair.VoxsphereID, bun.NullZero(userFlair.BackgroundColor)).Exec(ctx); err != nil {
+			var pgdriverErr pgdriver.Error
+			if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgUniqueViolation {
+				return ErrUserFlairDuplicateID
+			}
+			if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+				return ErrUserFlairVoxsphereNotFound
+			}
+			return err
+		}
+
+		if err := addUserFlairPieces(ctx, tx, userFlair, richtext); err != nil {
+			return err
+		}
+
+		var err error
+		flair, err = userFlairRenderedByID(ctx, tx, userFlair.ID)
+		return err
+	})
+	if err != nil {
+		return models.UserFlairRendered{}, err
+	}
+	return flair, nil
+}
+
+// UpdateUserFlairTemplate replaces the background color and the pieces of
+// userFlair, which has to be a flair of its voxsphere.
+func (r *Repo) UpdateUserFlairTemplate(ctx context.Context, userFlair models.UserFlair, richtext []models.FlairRichtextPiece) (models.UserFlairRendered, error) {
+	var flair models.UserFlairRendered
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		query := `
+                UPDATE
+                    user_flairs
+                SET
+                    background_color = ?
+                WHERE
+                    id = ?
+                    AND voxsphere_id = ?;
+            `
+		res, err := tx.NewRaw(query, bun.NullZero(userFlair.BackgroundColor), userFlair.ID, userFlair.VoxsphereID).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrUserFlairNotFound
+		}
+
+		for _, table := range []string{"user_flair_descriptions", "user_flair_emojis", "user_flair_custom_emojis"} {
+			clearQuery := fmt.Sprintf(`
+                DELETE FROM
+                    %s
+                WHERE
+                    user_flair_id = ?;
+            `, table)
+			if _, err := tx.NewRaw(clearQuery, userFlair.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		if err := addUserFlairPieces(ctx, tx, userFlair, richtext); err != nil {
+			return err
+		}
+
+		flair, err = userFlairRenderedByID(ctx, tx, userFlair.ID)
+		return err
+	})
+	if err != nil {
+		return models.UserFlairRendered{}, err
+	}
+	return flair, nil
+}
+
+// addUserFlairPieces stores richtext as the pieces of userFlair in order and
+// derives its full text from them. Emojis have to exist and custom emojis
+// have to belong to the voxsphere of the flair.
+func addUserFlairPieces(ctx context.Context, tx bun.Tx, userFlair models.UserFlair, richtext []models.FlairRichtextPiece) error {
+	for i, piece := range richtext {
+		var (
+			query string
+			args  []interface{}
+		)
+		switch piece.Type {
+		case models.FlairRichtextTypeText:
+			query = `
+                INSERT INTO
+                    user_flair_descriptions (
+                        user_flair_id,
+                        order_index,
+                        description
+                    )
+                VALUES
+                    (?, ?, ?);
+            `
+			args = []interface{}{userFlair.ID, i, piece.Text}
+		case models.FlairRichtextTypeEmoji:
+			query = `
+                INSERT INTO
+                    user_flair_emojis (
+                        emoji_id,
+                        user_flair_id,
+                        order_index
+                    )
+                SELECT
+                    e.id,
+                    ?,
+                    ?
+                FROM
+                    emojis e
+                WHERE
+                    e.id = ?;
+            `
+			args = []interface{}{userFlair.ID, i, piece.EmojiID}
+		case models.FlairRichtextTypeCustomEmoji:
+			query = `
+                INSERT INTO
+                    user_flair_custom_emojis (
+                        custom_emoji_id,
+                        user_flair_id,
+                        order_index
+                    )
+                SELECT
+                    ce.id,
+                    ?,
+                    ?
+                FROM
+                    custom_emojis ce
+                WHERE
+                    ce.id = ?
+                    AND ce.voxsphere_id = ?;
+            `
+			args = []interface{}{userFlair.ID, i, piece.CustomEmojiID, userFlair.VoxsphereID}
+		default:
+			return ErrUserFlairInvalidPiece
+		}
+
+		res, err := tx.NewRaw(query, args...).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrUserFlairEmojiNotFound
+		}
+	}
+
+	// standard emojis are spelled as their code points between colons and
+	// custom emojis as their titles, the way the full text of scraped flairs
+	// reads
+	query := `
+                UPDATE
+                    user_flairs
+                SET
+                    full_text = LEFT(
+                        (
+                            SELECT
+                                COALESCE(STRING_AGG(piece.text, '' ORDER BY piece.order_index), '')
+                            FROM
+                                (
+                                    SELECT
+                                        ufd.order_index,
+                                        ufd.description AS text
+                                    FROM
+                                        user_flair_descriptions ufd
+                                    WHERE
+                                        ufd.user_flair_id = ?0
+                                    UNION ALL
+                                    SELECT
+                                        ufe.order_index,
+                                        ':' || e.title || ':' AS text
+                                    FROM
+                                        user_flair_emojis ufe
+                                        JOIN emojis e ON e.id = ufe.emoji_id
+                                    WHERE
+                                        ufe.user_flair_id = ?0
+                                    UNION ALL
+                                    SELECT
+                                        ufce.order_index,
+                                        ce.title AS text
+                                    FROM
+                                        user_flair_custom_emojis ufce
+                                        JOIN custom_emojis ce ON ce.id = ufce.custom_emoji_id
+                                    WHERE
+                                        ufce.user_flair_id = ?0
+                                ) piece
+                        ),
+                        ?1
+                    )
+                WHERE
+                    id = ?0;
+            `
+	_, err := tx.NewRaw(query, userFlair.ID, maxFullTextLength).Exec(ctx)
+	return err
+}
+
+// SetUserFlair makes the user of userID wear the user flair of userFlairID in
+// the voxsphere of voxsphereID, replacing the flair the user wore there.
+func (r *Repo) SetUserFlair(ctx context.Context, userID, voxsphereID, userFlairID uuid.UUID) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var flairVoxsphereID uuid.UUID
+		flairQuery := `
+                SELECT
+                    uf.voxsphere_id
+                FROM
+                    user_flairs uf
+                WHERE
+                    uf.id = ?;
+            `
+		if err := tx.NewRaw(flairQuery, userFlairID).Scan(ctx, &flairVoxsphereID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrUserFlairNotFound
+			}
+			return err
+		}
+		if flairVoxsphereID != voxsphereID {
+			return ErrUserFlairNotInVoxsphere
+		}
+
+		if err := clearUserFlair(ctx, tx, userID, voxsphereID); err != nil {
+			return err
+		}
+
+		setQuery := `
+                INSERT INTO
+                    user_user_flairs (
+                        user_id,
+                        user_flair_id
+                    )
+                VALUES
+                    (?, ?);
+            `
+		_, err := tx.NewRaw(setQuery, userID, userFlairID).Exec(ctx)
+		return err
+	})
+}
+
+// ClearUserFlair takes off the flair the user of userID wears in the
+// voxsphere of voxsphereID. Clearing without a flair is not an error.
+func (r *Repo) ClearUserFlair(ctx context.Context, userID, voxsphereID uuid.UUID) error {
+	return clearUserFlair(ctx, r.db, userID, voxsphereID)
+}
+
+func clearUserFlair(ctx context.Context, db bun.IDB, userID, voxsphereID uuid.UUID) error {
+	query := `
+                DELETE FROM
+                    user_user_flairs uuf
+                USING
+                    user_flairs uf
+                WHERE
+                    uf.id = uuf.user_flair_id
+                    AND uuf.user_id = ?
+                    AND uf.voxsphere_id = ?;
+            `
+	_, err := db.NewRaw(query, userID, voxsphereID).Exec(ctx)
+	return err
+}
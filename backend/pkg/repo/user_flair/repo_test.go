@@ -45,6 +45,13 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	db.RegisterModel((*models.Topic)(nil))
 	db.RegisterModel((*models.Voxsphere)(nil))
 	db.RegisterModel((*models.UserFlair)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Emoji)(nil))
+	db.RegisterModel((*models.CustomEmoji)(nil))
+	db.RegisterModel((*models.UserUserFlair)(nil))
+	db.RegisterModel((*models.UserFlairDescription)(nil))
+	db.RegisterModel((*models.UserFlairEmoji)(nil))
+	db.RegisterModel((*models.UserFlairCustomEmoji)(nil))
 
 	// drop all rows of the topic,voxsphere,user,user_flairs table
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.Topic)(nil)).Exec(context.Background()); err != nil {
@@ -56,6 +63,12 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.UserFlair)(nil)).Exec(context.Background()); err != nil {
 		t.Fatal("truncate table failed:", err)
 	}
+	if _, err := db.NewTruncateTable().Cascade().Model((*models.User)(nil)).Exec(context.Background()); err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
+	if _, err := db.NewTruncateTable().Cascade().Model((*models.Emoji)(nil)).Exec(context.Background()); err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
 
 	// load fixture
 	fixture := dbfixture.New(db)
@@ -527,3 +540,244 @@ func TestRepo_ForeignKeyCascade(t *testing.T) {
 		assertUserFlairs(t, wantUserFlairs, gotUserFlairs)
 	})
 }
+
+func TestRepo_VoxsphereUserFlairs(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "user_flairs.yml"}
+
+	tests := []struct {
+		name        string
+		voxsphereID uuid.UUID
+		wantFlairs  []models.UserFlairRendered
+	}{
+		{
+			name:        "flairs of the voxsphere :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantFlairs: []models.UserFlairRendered{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Voxsphere:       "v/bar",
+					FullText:        "Flair 2",
+					BackgroundColor: "#000000",
+				},
+			},
+		},
+		{
+			name:        "no flairs :POS",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantFlairs:  []models.UserFlairRendered{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := userflaireRepo.NewRepo(db)
+
+			gotFlairs, gotErr := pgrepo.VoxsphereUserFlairs(context.Background(), tt.voxsphereID)
+			assert.NoError(t, gotErr, "expect no error")
+			assert.Equal(t, tt.wantFlairs, gotFlairs, "expect flairs to match")
+		})
+	}
+}
+
+func TestRepo_AddUserFlairTemplate(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "emojis.yml", "custom_emojis.yml"}
+
+	tests := []struct {
+		name      string
+		userFlair models.UserFlair
+		richtext  []models.FlairRichtextPiece
+		wantFlair models.UserFlairRendered
+		wantErr   error
+	}{
+		{
+			name: "voxsphere not found :NEG",
+			userFlair: models.UserFlair{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			richtext: []models.FlairRichtextPiece{{Type: models.FlairRichtextTypeText, Text: "foo"}},
+			wantErr:  userflaireRepo.ErrUserFlairVoxsphereNotFound,
+		},
+		{
+			name: "custom emoji of another voxsphere :NEG",
+			userFlair: models.UserFlair{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			richtext: []models.FlairRichtextPiece{
+				{Type: models.FlairRichtextTypeCustomEmoji, CustomEmojiID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			},
+			wantErr: userflaireRepo.ErrUserFlairEmojiNotFound,
+		},
+		{
+			name: "rich flair :POS",
+			userFlair: models.UserFlair{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				BackgroundColor: "#FFFFFF",
+			},
+			richtext: []models.FlairRichtextPiece{
+				{Type: models.FlairRichtextTypeText, Text: "foo "},
+				{Type: models.FlairRichtextTypeEmoji, EmojiID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+				{Type: models.FlairRichtextTypeCustomEmoji, CustomEmojiID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			},
+			wantFlair: models.UserFlairRendered{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Voxsphere:       "v/foo",
+				FullText:        "foo :1f600::ce1:",
+				BackgroundColor: "#FFFFFF",
+				Richtext: []models.FlairRichtext{
+					{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "foo "},
+					{Type: models.FlairRichtextTypeEmoji, OrderIndex: 1, Text: "😀"},
+					{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 2, Text: ":ce1:", Url: ptrof("https://example.com/ce1.png")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := userflaireRepo.NewRepo(db)
+
+			gotFlair, gotErr := pgrepo.AddUserFlairTemplate(context.Background(), tt.userFlair, tt.richtext)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantFlair, gotFlair, "expect flair to match")
+		})
+	}
+}
+
+func TestRepo_UpdateUserFlairTemplate(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "user_flairs.yml", "emojis.yml", "custom_emojis.yml"}
+
+	tests := []struct {
+		name      string
+		userFlair models.UserFlair
+		richtext  []models.FlairRichtextPiece
+		wantFlair models.UserFlairRendered
+		wantErr   error
+	}{
+		{
+			name: "flair of another voxsphere :NEG",
+			userFlair: models.UserFlair{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			richtext: []models.FlairRichtextPiece{{Type: models.FlairRichtextTypeText, Text: "foo"}},
+			wantErr:  userflaireRepo.ErrUserFlairNotFound,
+		},
+		{
+			name: "replace the pieces :POS",
+			userFlair: models.UserFlair{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			richtext: []models.FlairRichtextPiece{
+				{Type: models.FlairRichtextTypeEmoji, EmojiID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+				{Type: models.FlairRichtextTypeText, Text: " bar"},
+			},
+			wantFlair: models.UserFlairRendered{
+				ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Voxsphere:   "v/foo",
+				FullText:    ":1f600: bar",
+				Richtext: []models.FlairRichtext{
+					{Type: models.FlairRichtextTypeEmoji, OrderIndex: 0, Text: "😀"},
+					{Type: models.FlairRichtextTypeText, OrderIndex: 1, Text: " bar"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := userflaireRepo.NewRepo(db)
+
+			gotFlair, gotErr := pgrepo.UpdateUserFlairTemplate(context.Background(), tt.userFlair, tt.richtext)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantFlair, gotFlair, "expect flair to match")
+		})
+	}
+}
+
+func TestRepo_SetUserFlair(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "user_flairs.yml", "user_user_flairs.yml"}
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		voxsphereID uuid.UUID
+		userFlairID uuid.UUID
+		wantFlairs  []models.UserUserFlair
+		wantErr     error
+	}{
+		{
+			name:        "user flair not found :NEG",
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantErr:     userflaireRepo.ErrUserFlairNotFound,
+		},
+		{
+			name:        "flair of another voxsphere :NEG",
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantErr:     userflaireRepo.ErrUserFlairNotInVoxsphere,
+		},
+		{
+			name:        "pick a flair :POS",
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			userFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantFlairs: []models.UserUserFlair{
+				{UserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), UserFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+			},
+		},
+		{
+			name:        "pick the flair again :POS",
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantFlairs: []models.UserUserFlair{
+				{UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), UserFlairID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := userflaireRepo.NewRepo(db)
+
+			gotErr := pgrepo.SetUserFlair(context.Background(), tt.userID, tt.voxsphereID, tt.userFlairID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr != nil {
+				return
+			}
+
+			var gotFlairs []models.UserUserFlair
+			if err := db.NewSelect().Model(&gotFlairs).Where("user_id = ?", tt.userID).Scan(context.Background()); err != nil {
+				t.Fatal("failed to fetch user flairs:", err)
+			}
+			assert.Equal(t, tt.wantFlairs, gotFlairs, "expect a single flair in the voxsphere")
+		})
+	}
+}
+
+func TestRepo_ClearUserFlair(t *testing.T) {
+	db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "user_flairs.yml", "user_user_flairs.yml")
+	pgrepo := userflaireRepo.NewRepo(db)
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	err := pgrepo.ClearUserFlair(context.Background(), userID, uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+	assert.NoError(t, err, "expect no error")
+
+	count, err := db.NewSelect().Model((*models.UserUserFlair)(nil)).Where("user_id = ?", userID).Count(context.Background())
+	assert.NoError(t, err, "expect no error while counting user flairs")
+	assert.Equal(t, 0, count, "expect the flair to be cleared")
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
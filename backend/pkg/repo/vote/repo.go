@@ -0,0 +1,184 @@
+package vote
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const pgConstraintViolation = "23503"
+
+var (
+	ErrVotePostNotFound    = errors.New("post not found")
+	ErrVoteCommentNotFound = errors.New("comment not found")
+	ErrVoteUserNotFound    = errors.New("user not found")
+)
+
+// voteTarget describes the table a vote is cast on and the table its votes
+// are kept in. scoreUpdate sets the score of the target given the score delta
+// as ?0 and the ups delta as ?1, and returns the new score.
+type voteTarget struct {
+	table       string
+	votesTable  string
+	votesColumn string
+	score       string
+	scoreUpdate string
+	notFound    error
+}
+
+var (
+	postVoteTarget = voteTarget{
+		table:       "posts",
+		votesTable:  "post_votes",
+		votesColumn: "post_id",
+		score:       "ups",
+		scoreUpdate: "ups = ups + ?0",
+		notFound:    ErrVotePostNotFound,
+	}
+	commentVoteTarget = voteTarget{
+		table:       "comments",
+		votesTable:  "comment_votes",
+		votesColumn: "comment_id",
+		score:       "score",
+		scoreUpdate: "score = score + ?0, ups = ups + ?1",
+		notFound:    ErrVoteCommentNotFound,
+	}
+)
+
+type Repo struct {
+	db *bun.DB
+}
+
+func NewRepo(db *bun.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// VotePost casts the vote of the user of userID on the post of postID. The ups
+// of a post are its score.
+func (r *Repo) VotePost(ctx context.Context, postID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error) {
+	return r.vote(ctx, postVoteTarget, postID, userID, direction)
+}
+
+// VoteComment casts the vote of the user of userID on the comment of
+// commentID. The ups of a comment count its upvotes.
+func (r *Repo) VoteComment(ctx context.Context, commentID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error) {
+	return r.vote(ctx, commentVoteTarget, commentID, userID, direction)
+}
+
+// vote replaces the vote of a user on the target of ID with direction and
+// moves the score of the target by the difference between the two votes.
+// The target row stays locked until the vote commits, so concurrent votes on
+// a target apply one after another and each sees the vote left by the
+// previous one.
+func (r *Repo) vote(ctx context.Context, target voteTarget, ID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error) {
+	vote := models.Vote{ID: ID, Direction: direction}
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		lockQuery := fmt.Sprintf(`
+            SELECT
+                %s
+            FROM
+                %s
+            WHERE
+                id = ?
+            FOR UPDATE;
+        `, target.score, target.table)
+		if err := tx.NewRaw(lockQuery, ID).Scan(ctx, &vote.Score); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return target.notFound
+			}
+			return err
+		}
+
+		var previous int16
+		previousQuery := fmt.Sprintf(`
+            SELECT
+                direction
+            FROM
+                %s
+            WHERE
+                user_id = ?
+                AND %s = ?;
+        `, target.votesTable, target.votesColumn)
+		if err := tx.NewRaw(previousQuery, userID, ID).Scan(ctx, &previous); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		current := direction.Value()
+		if current == previous {
+			return nil
+		}
+
+		if current == 0 {
+			deleteQuery := fmt.Sprintf(`
+                DELETE FROM
+                    %s
+                WHERE
+                    user_id = ?
+                    AND %s = ?;
+            `, target.votesTable, target.votesColumn)
+			if _, err := tx.NewRaw(deleteQuery, userID, ID).Exec(ctx); err != nil {
+				return err
+			}
+		} else {
+			timestamp := time.Now()
+			upsertQuery := fmt.Sprintf(`
+                INSERT INTO
+                    %[1]s (
+                        user_id,
+                        %[2]s,
+                        direction,
+                        created_at,
+                        updated_at
+                    )
+                VALUES
+                    (?, ?, ?, ?, ?)
+                ON CONFLICT (user_id, %[2]s) DO UPDATE
+                SET
+                    direction = EXCLUDED.direction,
+                    updated_at = EXCLUDED.updated_at;
+            `, target.votesTable, target.votesColumn)
+			if _, err := tx.NewRaw(upsertQuery, userID, ID, current, timestamp, timestamp).Exec(ctx); err != nil {
+				var pgdriverErr pgdriver.Error
+				if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+					return ErrVoteUserNotFound
+				}
+				return err
+			}
+		}
+
+		scoreQuery := fmt.Sprintf(`
+            UPDATE
+                %s
+            SET
+                %s
+            WHERE
+                id = ?2
+            RETURNING
+                %s;
+        `, target.table, target.scoreUpdate, target.score)
+		if err := tx.NewRaw(scoreQuery, current-previous, upvotes(current)-upvotes(previous), ID).Scan(ctx, &vote.Score); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Vote{}, err
+	}
+	return vote, nil
+}
+
+// upvotes returns the upvotes counted by a vote of value.
+func upvotes(value int16) int16 {
+	if value > 0 {
+		return 1
+	}
+	return 0
+}
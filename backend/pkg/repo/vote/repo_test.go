@@ -0,0 +1,395 @@
+package vote_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	voterepo "github.com/glowfi/voxpopuli/backend/pkg/repo/vote"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dbfixture"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+)
+
+func connectPostgres(user, password, address, dbName string) *bun.DB {
+	dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, address, dbName)
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
+	db := bun.NewDB(sqldb, pgdialect.New())
+	return db
+}
+
+func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
+	db := connectPostgres("postgres", "postgres", "127.0.0.1:5432", "voxpopuli")
+
+	if err := db.Ping(); err != nil {
+		t.Fatal("db error:", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Log("db close error:", err)
+		}
+	})
+
+	// add query logging hook
+	db.AddQueryHook(bundebug.NewQueryHook(bundebug.WithVerbose(true)))
+
+	db.RegisterModel((*models.Topic)(nil))
+	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.Post)(nil))
+	db.RegisterModel((*models.Comment)(nil))
+	db.RegisterModel((*models.PostVote)(nil))
+	db.RegisterModel((*models.CommentVote)(nil))
+
+	// drop all rows of the voted tables
+	for _, model := range []interface{}{
+		(*models.Topic)(nil),
+		(*models.Voxsphere)(nil),
+		(*models.User)(nil),
+		(*models.Post)(nil),
+		(*models.Comment)(nil),
+		(*models.PostVote)(nil),
+		(*models.CommentVote)(nil),
+	} {
+		if _, err := db.NewTruncateTable().Cascade().Model(model).Exec(context.Background()); err != nil {
+			t.Fatal("truncate table failed:", err)
+		}
+	}
+
+	// load fixture
+	fixture := dbfixture.New(db)
+	if err := fixture.Load(context.Background(), os.DirFS("testdata"), fixtureFiles...); err != nil {
+		t.Fatal("failed to load fixtures", err)
+	}
+
+	return db
+}
+
+// voteDirection returns the direction of the vote of userID stored in table
+// for the target of ID, zero when there is none.
+func voteDirection(t *testing.T, db *bun.DB, table, column string, ID, userID uuid.UUID) int16 {
+	t.Helper()
+
+	var direction int16
+	query := fmt.Sprintf("SELECT direction FROM %s WHERE user_id = ? AND %s = ?", table, column)
+	if err := db.NewRaw(query, userID, ID).Scan(context.Background(), &direction); err != nil && err != sql.ErrNoRows {
+		t.Fatal("failed to get vote:", err)
+	}
+	return direction
+}
+
+func TestRepo_VotePost(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "post_votes.yml"}
+
+	type args struct {
+		postID    uuid.UUID
+		userID    uuid.UUID
+		direction models.VoteDirection
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantVote      models.Vote
+		wantUps       int32
+		wantDirection int16
+		wantErr       error
+	}{
+		{
+			name: "post not found :NEG",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				direction: models.VoteDirectionUp,
+			},
+			wantVote:      models.Vote{},
+			wantUps:       10,
+			wantDirection: 0,
+			wantErr:       voterepo.ErrVotePostNotFound,
+		},
+		{
+			name: "user not found :NEG",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				direction: models.VoteDirectionUp,
+			},
+			wantVote:      models.Vote{},
+			wantUps:       10,
+			wantDirection: 0,
+			wantErr:       voterepo.ErrVoteUserNotFound,
+		},
+		{
+			name: "first upvote :POS",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				direction: models.VoteDirectionUp,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionUp,
+				Score:     11,
+			},
+			wantUps:       11,
+			wantDirection: 1,
+			wantErr:       nil,
+		},
+		{
+			name: "repeated upvote is not counted twice :POS",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				direction: models.VoteDirectionUp,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionUp,
+				Score:     10,
+			},
+			wantUps:       10,
+			wantDirection: 1,
+			wantErr:       nil,
+		},
+		{
+			name: "downvote turned into upvote :POS",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				direction: models.VoteDirectionUp,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionUp,
+				Score:     12,
+			},
+			wantUps:       12,
+			wantDirection: 1,
+			wantErr:       nil,
+		},
+		{
+			name: "upvote cleared :POS",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				direction: models.VoteDirectionClear,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionClear,
+				Score:     9,
+			},
+			wantUps:       9,
+			wantDirection: 0,
+			wantErr:       nil,
+		},
+		{
+			name: "clear without a vote :POS",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				direction: models.VoteDirectionClear,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionClear,
+				Score:     10,
+			},
+			wantUps:       10,
+			wantDirection: 0,
+			wantErr:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := voterepo.NewRepo(db)
+
+			gotVote, gotErr := pgrepo.VotePost(context.Background(), tt.args.postID, tt.args.userID, tt.args.direction)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantVote, gotVote, "expect vote to match")
+
+			var gotUps int32
+			if err := db.NewRaw("SELECT ups FROM posts WHERE id = ?", uuid.MustParse("00000000-0000-0000-0000-000000000001")).Scan(context.Background(), &gotUps); err != nil {
+				t.Fatal("failed to get post ups:", err)
+			}
+			assert.Equal(t, tt.wantUps, gotUps, "expect post ups to match")
+			assert.Equal(t, tt.wantDirection, voteDirection(t, db, "post_votes", "post_id", tt.args.postID, tt.args.userID), "expect stored vote to match")
+		})
+	}
+}
+
+func TestRepo_VoteComment(t *testing.T) {
+	fixtureFiles := []string{"topics.yml", "voxspheres.yml", "users.yml", "posts.yml", "comments.yml", "comment_votes.yml"}
+
+	type args struct {
+		commentID uuid.UUID
+		userID    uuid.UUID
+		direction models.VoteDirection
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantVote      models.Vote
+		wantUps       int32
+		wantScore     int32
+		wantDirection int16
+		wantErr       error
+	}{
+		{
+			name: "comment not found :NEG",
+			args: args{
+				commentID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				direction: models.VoteDirectionUp,
+			},
+			wantVote:      models.Vote{},
+			wantUps:       5,
+			wantScore:     3,
+			wantDirection: 0,
+			wantErr:       voterepo.ErrVoteCommentNotFound,
+		},
+		{
+			name: "user not found :NEG",
+			args: args{
+				commentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				direction: models.VoteDirectionDown,
+			},
+			wantVote:      models.Vote{},
+			wantUps:       5,
+			wantScore:     3,
+			wantDirection: 0,
+			wantErr:       voterepo.ErrVoteUserNotFound,
+		},
+		{
+			name: "first upvote :POS",
+			args: args{
+				commentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				direction: models.VoteDirectionUp,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionUp,
+				Score:     4,
+			},
+			wantUps:       6,
+			wantScore:     4,
+			wantDirection: 1,
+			wantErr:       nil,
+		},
+		{
+			name: "downvote turned into upvote :POS",
+			args: args{
+				commentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				direction: models.VoteDirectionUp,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionUp,
+				Score:     5,
+			},
+			wantUps:       6,
+			wantScore:     5,
+			wantDirection: 1,
+			wantErr:       nil,
+		},
+		{
+			name: "upvote turned into downvote :POS",
+			args: args{
+				commentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				direction: models.VoteDirectionDown,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionDown,
+				Score:     1,
+			},
+			wantUps:       4,
+			wantScore:     1,
+			wantDirection: -1,
+			wantErr:       nil,
+		},
+		{
+			name: "downvote cleared :POS",
+			args: args{
+				commentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				direction: models.VoteDirectionClear,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionClear,
+				Score:     4,
+			},
+			wantUps:       5,
+			wantScore:     4,
+			wantDirection: 0,
+			wantErr:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, fixtureFiles...)
+			pgrepo := voterepo.NewRepo(db)
+
+			gotVote, gotErr := pgrepo.VoteComment(context.Background(), tt.args.commentID, tt.args.userID, tt.args.direction)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantVote, gotVote, "expect vote to match")
+
+			var gotComment models.Comment
+			if err := db.NewRaw("SELECT ups, score FROM comments WHERE id = ?", uuid.MustParse("00000000-0000-0000-0000-000000000001")).Scan(context.Background(), &gotComment.Ups, &gotComment.Score); err != nil {
+				t.Fatal("failed to get comment score:", err)
+			}
+			assert.Equal(t, tt.wantUps, gotComment.Ups, "expect comment ups to match")
+			assert.Equal(t, tt.wantScore, gotComment.Score, "expect comment score to match")
+			assert.Equal(t, tt.wantDirection, voteDirection(t, db, "comment_votes", "comment_id", tt.args.commentID, tt.args.userID), "expect stored vote to match")
+		})
+	}
+}
+
+func TestRepo_VotePostConcurrently(t *testing.T) {
+	t.Run("concurrent votes of a user are counted once :POS", func(t *testing.T) {
+		db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "posts.yml")
+		pgrepo := voterepo.NewRepo(db)
+
+		postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		userIDs := []uuid.UUID{
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		}
+
+		wg := new(sync.WaitGroup)
+		for range 10 {
+			for _, userID := range userIDs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := pgrepo.VotePost(context.Background(), postID, userID, models.VoteDirectionUp); err != nil {
+						t.Error("failed to vote:", err)
+					}
+				}()
+			}
+		}
+		wg.Wait()
+
+		var gotUps int32
+		if err := db.NewRaw("SELECT ups FROM posts WHERE id = ?", postID).Scan(context.Background(), &gotUps); err != nil {
+			t.Fatal("failed to get post ups:", err)
+		}
+		assert.Equal(t, int32(13), gotUps, "expect every user to be counted once")
+	})
+}
@@ -27,6 +27,12 @@ var (
 type VoxsphereRepository interface {
 	Voxspheres(context.Context) ([]models.Voxsphere, error)
 	VoxsphereByID(context.Context, uuid.UUID) (models.Voxsphere, error)
+	ModeratorsByVoxsphereID(context.Context, uuid.UUID) ([]models.User, error)
+	MemberCountByVoxsphereID(context.Context, uuid.UUID) (int64, error)
+	IsVoxsphereMember(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	VoxspheresByMemberID(context.Context, uuid.UUID) ([]models.Voxsphere, error)
+	JoinVoxsphere(context.Context, uuid.UUID, uuid.UUID) error
+	LeaveVoxsphere(context.Context, uuid.UUID, uuid.UUID) error
 	AddVoxspheres(context.Context, ...models.Voxsphere) ([]models.Voxsphere, error)
 	UpdateVoxsphere(context.Context, models.Voxsphere) (models.Voxsphere, error)
 	DeleteVoxsphere(context.Context, uuid.UUID) error
@@ -112,6 +118,177 @@ func (r *Repo) VoxsphereByID(ctx context.Context, ID uuid.UUID) (models.Voxspher
 	return voxsphere, nil
 }
 
+func (r *Repo) ModeratorsByVoxsphereID(ctx context.Context, ID uuid.UUID) ([]models.User, error) {
+	moderators := []models.User{}
+
+	query := `
+	        SELECT
+	            u.id,
+	            u.name,
+	            u.public_description,
+	            u.avatar_img,
+	            u.banner_img,
+	            u.iconcolor,
+	            u.keycolor,
+	            u.primarycolor,
+	            u.over18,
+	            u.suspended,
+	            u.created_at,
+	            u.created_at_unix,
+	            u.updated_at
+	        FROM
+	            voxsphere_moderators vm
+	        JOIN
+	            users u ON vm.user_id = u.id
+	        WHERE
+	            vm.voxsphere_id = ?
+	        ORDER BY
+	            u.name;
+	    `
+	_, err := r.db.NewRaw(query, ID).Exec(ctx, &moderators)
+	if err != nil {
+		return []models.User{}, err
+	}
+	return moderators, nil
+}
+
+func (r *Repo) MemberCountByVoxsphereID(ctx context.Context, ID uuid.UUID) (int64, error) {
+	var memberCount int64
+
+	query := `
+	        SELECT
+	            count(*)
+	        FROM
+	            voxsphere_members vm
+	        WHERE
+	            vm.voxsphere_id = ?;
+	    `
+	if err := r.db.NewRaw(query, ID).Scan(ctx, &memberCount); err != nil {
+		return 0, err
+	}
+	return memberCount, nil
+}
+
+// IsVoxsphereMember reports whether the user of userID is a member of the
+// voxsphere of voxsphereID.
+func (r *Repo) IsVoxsphereMember(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error) {
+	var isMember bool
+
+	query := `
+	        SELECT
+	            EXISTS (
+	                SELECT
+	                    1
+	                FROM
+	                    voxsphere_members vm
+	                WHERE
+	                    vm.voxsphere_id = ?
+	                    AND vm.user_id = ?
+	            );
+	    `
+	if err := r.db.NewRaw(query, voxsphereID, userID).Scan(ctx, &isMember); err != nil {
+		return false, err
+	}
+	return isMember, nil
+}
+
+// VoxspheresByMemberID returns the voxspheres joined by the user of userID,
+// ordered by title.
+func (r *Repo) VoxspheresByMemberID(ctx context.Context, userID uuid.UUID) ([]models.Voxsphere, error) {
+	voxspheres := []models.Voxsphere{}
+
+	query := `
+	        SELECT
+	            v.id,
+	            v.title,
+	            v.topic_id,
+	            json_build_object('id', t.id,'name', t.name) as topic,
+	            v.public_description,
+	            v.community_icon,
+	            v.banner_background_image,
+	            v.banner_background_color,
+	            v.key_color,
+	            v.primary_color,
+	            v.over18,
+	            v.spoilers_enabled,
+	            v.created_at,
+	            v.created_at_unix,
+	            v.updated_at
+	        FROM
+	            voxsphere_members vm
+	        JOIN
+	            voxspheres v ON vm.voxsphere_id = v.id
+	        JOIN
+	            topics t ON v.topic_id = t.id
+	        WHERE
+	            vm.user_id = ?
+	        ORDER BY
+	            v.title;
+	    `
+	if _, err := r.db.NewRaw(query, userID).Exec(ctx, &voxspheres); err != nil {
+		return []models.Voxsphere{}, err
+	}
+	return voxspheres, nil
+}
+
+// JoinVoxsphere makes the user of userID a member of the voxsphere of
+// voxsphereID. Joining a voxsphere twice is not an error.
+func (r *Repo) JoinVoxsphere(ctx context.Context, voxsphereID, userID uuid.UUID) error {
+	query := `
+        INSERT INTO voxsphere_members
+            (
+                voxsphere_id,
+                user_id
+            )
+        VALUES
+            (?, ?)
+        ON CONFLICT (voxsphere_id, user_id) DO NOTHING
+    `
+	if _, err := r.db.NewRaw(query, voxsphereID, userID).Exec(ctx); err != nil {
+		var pgdriverErr pgdriver.Error
+		if errors.As(err, &pgdriverErr) && pgdriverErr.Field('C') == pgConstraintViolation {
+			if pgdriverErr.Field('n') == "fk_voxsphere_id" {
+				return ErrVoxsphereNotFound
+			}
+			return ErrVoxsphereParentTableRecordNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// LeaveVoxsphere ends the membership of the user of userID in the voxsphere of
+// voxsphereID. Leaving a voxsphere that was not joined is not an error.
+func (r *Repo) LeaveVoxsphere(ctx context.Context, voxsphereID, userID uuid.UUID) error {
+	query := `
+        DELETE FROM
+            voxsphere_members
+        WHERE
+            voxsphere_id = ?
+            AND user_id = ?
+    `
+	res, err := r.db.NewRaw(query, voxsphereID, userID).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 0 {
+		return nil
+	}
+
+	var voxsphereExists bool
+	if err := r.db.NewRaw(`SELECT EXISTS (SELECT 1 FROM voxspheres v WHERE v.id = ?)`, voxsphereID).Scan(ctx, &voxsphereExists); err != nil {
+		return err
+	}
+	if !voxsphereExists {
+		return ErrVoxsphereNotFound
+	}
+	return nil
+}
+
 func (r *Repo) AddVoxspheres(ctx context.Context, voxspheres ...models.Voxsphere) ([]models.Voxsphere, error) {
 	query := `
         INSERT INTO
@@ -162,7 +339,7 @@ func (r *Repo) AddVoxspheres(ctx context.Context, voxspheres ...models.Voxsphere
 	}
 
 	query += strings.Join(placeholders, ", ")
-	query += " RETURNING *"
+	query += " RETURNING id, topic_id, title, public_description, community_icon, banner_background_image, banner_background_color, key_color, primary_color, over18, spoilers_enabled, created_at, created_at_unix, updated_at"
 
 	if _, err := r.db.NewRaw(query, args...).Exec(ctx, &voxspheres); err != nil {
 		// if _, err := r.db.NewInsert().Model(&voxsphere).Exec(context.Background()); err != nil {
@@ -197,7 +374,7 @@ func (r *Repo) UpdateVoxsphere(ctx context.Context, voxsphere models.Voxsphere)
 	            updated_at = ?
 	        WHERE
 	            id = ?
-	        RETURNING *
+	        RETURNING id, topic_id, title, public_description, community_icon, banner_background_image, banner_background_color, key_color, primary_color, over18, spoilers_enabled, created_at, created_at_unix, updated_at
 	    `
 
 	voxsphere.UpdatedAt = time.Now()
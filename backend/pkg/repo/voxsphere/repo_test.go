@@ -45,6 +45,9 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 
 	db.RegisterModel((*models.Topic)(nil))
 	db.RegisterModel((*models.Voxsphere)(nil))
+	db.RegisterModel((*models.User)(nil))
+	db.RegisterModel((*models.VoxsphereMember)(nil))
+	db.RegisterModel((*models.VoxsphereModerator)(nil))
 
 	// drop all rows of the topics,voxspheres table
 	_, err := db.NewTruncateTable().Cascade().Model((*models.Topic)(nil)).Exec(context.Background())
@@ -54,6 +57,9 @@ func setupPostgres(t *testing.T, fixtureFiles ...string) *bun.DB {
 	if _, err := db.NewTruncateTable().Cascade().Model((*models.Voxsphere)(nil)).Exec(context.Background()); err != nil {
 		t.Fatal("truncate table failed:", err)
 	}
+	if _, err := db.NewTruncateTable().Cascade().Model((*models.User)(nil)).Exec(context.Background()); err != nil {
+		t.Fatal("truncate table failed:", err)
+	}
 
 	// load fixture
 	fixture := dbfixture.New(db)
@@ -277,6 +283,330 @@ func TestRepo_VoxsphereByID(t *testing.T) {
 	}
 }
 
+func TestRepo_ModeratorsByVoxsphereID(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+
+	tests := []struct {
+		name           string
+		fixtureFiles   []string
+		args           args
+		wantModerators []models.User
+		wantErr        error
+	}{
+		{
+			name:         "voxsphere without moderators :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_moderators.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantModerators: []models.User{},
+			wantErr:        nil,
+		},
+		{
+			name:         "voxsphere with moderators :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_moderators.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantModerators: []models.User{
+				{
+					ID:                uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Name:              "Jane Doe",
+					PublicDescription: ptrof("This is another public description"),
+					AvatarImg:         ptrof("https://example.com/avatar2.jpg"),
+					BannerImg:         ptrof("https://example.com/banner2.jpg"),
+					Iconcolor:         ptrof("#FFFF00"),
+					Keycolor:          ptrof("#FF00FF"),
+					Primarycolor:      ptrof("#00FFFF"),
+					Over18:            true,
+					Suspended:         false,
+					CreatedAt:         time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+					CreatedAtUnix:     1725091101,
+					UpdatedAt:         time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+				},
+				{
+					ID:                uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Name:              "John Doe",
+					PublicDescription: ptrof("This is a public description"),
+					AvatarImg:         ptrof("https://example.com/avatar1.jpg"),
+					BannerImg:         ptrof("https://example.com/banner1.jpg"),
+					Iconcolor:         ptrof("#FF0000"),
+					Keycolor:          ptrof("#00FF00"),
+					Primarycolor:      ptrof("#0000FF"),
+					Over18:            true,
+					Suspended:         false,
+					CreatedAt:         time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					CreatedAtUnix:     1725091100,
+					UpdatedAt:         time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := voxrepo.NewRepo(db)
+
+			gotModerators, gotErr := pgrepo.ModeratorsByVoxsphereID(context.Background(), tt.args.ID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantModerators, gotModerators, "expect moderators to match")
+		})
+	}
+}
+
+func TestRepo_MemberCountByVoxsphereID(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+
+	tests := []struct {
+		name            string
+		fixtureFiles    []string
+		args            args
+		wantMemberCount int64
+		wantErr         error
+	}{
+		{
+			name:         "voxsphere without members :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantMemberCount: 0,
+			wantErr:         nil,
+		},
+		{
+			name:         "voxsphere with members :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_members.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantMemberCount: 3,
+			wantErr:         nil,
+		},
+		{
+			name:         "voxsphere with a single member :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_members.yml"},
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantMemberCount: 1,
+			wantErr:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := voxrepo.NewRepo(db)
+
+			gotMemberCount, gotErr := pgrepo.MemberCountByVoxsphereID(context.Background(), tt.args.ID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantMemberCount, gotMemberCount, "expect member count to match")
+		})
+	}
+}
+
+func TestRepo_IsVoxsphereMember(t *testing.T) {
+	type args struct {
+		voxsphereID uuid.UUID
+		userID      uuid.UUID
+	}
+
+	tests := []struct {
+		name         string
+		fixtureFiles []string
+		args         args
+		wantIsMember bool
+		wantErr      error
+	}{
+		{
+			name:         "member :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_members.yml"},
+			args: args{
+				voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				userID:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+			wantIsMember: true,
+			wantErr:      nil,
+		},
+		{
+			name:         "not a member :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_members.yml"},
+			args: args{
+				voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				userID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantIsMember: false,
+			wantErr:      nil,
+		},
+		{
+			name:         "voxsphere does not exist :POS",
+			fixtureFiles: []string{"topics.yml", "voxspheres.yml", "users.yml", "voxsphere_members.yml"},
+			args: args{
+				voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				userID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			wantIsMember: false,
+			wantErr:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, tt.fixtureFiles...)
+			pgrepo := voxrepo.NewRepo(db)
+
+			gotIsMember, gotErr := pgrepo.IsVoxsphereMember(context.Background(), tt.args.voxsphereID, tt.args.userID)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantIsMember, gotIsMember, "expect membership to match")
+		})
+	}
+}
+
+func TestRepo_VoxspheresByMemberID(t *testing.T) {
+	tests := []struct {
+		name             string
+		userID           uuid.UUID
+		wantVoxsphereIDs []uuid.UUID
+	}{
+		{
+			name:   "member of two voxspheres :POS",
+			userID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			wantVoxsphereIDs: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+		},
+		{
+			name:             "member of no voxsphere :POS",
+			userID:           uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			wantVoxsphereIDs: []uuid.UUID{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "voxsphere_members.yml")
+			pgrepo := voxrepo.NewRepo(db)
+
+			gotVoxspheres, gotErr := pgrepo.VoxspheresByMemberID(context.Background(), tt.userID)
+			assert.NoError(t, gotErr, "expect no error")
+
+			gotVoxsphereIDs := []uuid.UUID{}
+			for _, voxsphere := range gotVoxspheres {
+				gotVoxsphereIDs = append(gotVoxsphereIDs, voxsphere.ID)
+			}
+			assert.Equal(t, tt.wantVoxsphereIDs, gotVoxsphereIDs, "expect voxspheres ordered by title")
+		})
+	}
+}
+
+func TestRepo_JoinVoxsphere(t *testing.T) {
+	tests := []struct {
+		name            string
+		voxsphereID     uuid.UUID
+		userID          uuid.UUID
+		wantErr         error
+		wantMemberCount int64
+	}{
+		{
+			name:            "join :POS",
+			voxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			userID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantErr:         nil,
+			wantMemberCount: 2,
+		},
+		{
+			name:            "join again :POS",
+			voxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			userID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			wantErr:         nil,
+			wantMemberCount: 1,
+		},
+		{
+			name:        "voxsphere does not exist :NEG",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantErr:     voxrepo.ErrVoxsphereNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "voxsphere_members.yml")
+			pgrepo := voxrepo.NewRepo(db)
+
+			gotErr := pgrepo.JoinVoxsphere(context.Background(), tt.voxsphereID, tt.userID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr != nil {
+				return
+			}
+
+			isMember, err := pgrepo.IsVoxsphereMember(context.Background(), tt.voxsphereID, tt.userID)
+			assert.NoError(t, err, "expect no error while checking membership")
+			assert.True(t, isMember, "expect user to be a member")
+
+			memberCount, err := pgrepo.MemberCountByVoxsphereID(context.Background(), tt.voxsphereID)
+			assert.NoError(t, err, "expect no error while counting members")
+			assert.Equal(t, tt.wantMemberCount, memberCount, "expect member count to match")
+		})
+	}
+}
+
+func TestRepo_LeaveVoxsphere(t *testing.T) {
+	tests := []struct {
+		name            string
+		voxsphereID     uuid.UUID
+		userID          uuid.UUID
+		wantErr         error
+		wantMemberCount int64
+	}{
+		{
+			name:            "leave :POS",
+			voxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			userID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantErr:         nil,
+			wantMemberCount: 2,
+		},
+		{
+			name:            "leave without joining :POS",
+			voxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			userID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantErr:         nil,
+			wantMemberCount: 1,
+		},
+		{
+			name:        "voxsphere does not exist :NEG",
+			voxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			userID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantErr:     voxrepo.ErrVoxsphereNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupPostgres(t, "topics.yml", "voxspheres.yml", "users.yml", "voxsphere_members.yml")
+			pgrepo := voxrepo.NewRepo(db)
+
+			gotErr := pgrepo.LeaveVoxsphere(context.Background(), tt.voxsphereID, tt.userID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			if tt.wantErr != nil {
+				return
+			}
+
+			isMember, err := pgrepo.IsVoxsphereMember(context.Background(), tt.voxsphereID, tt.userID)
+			assert.NoError(t, err, "expect no error while checking membership")
+			assert.False(t, isMember, "expect user not to be a member")
+
+			memberCount, err := pgrepo.MemberCountByVoxsphereID(context.Background(), tt.voxsphereID)
+			assert.NoError(t, err, "expect no error while counting members")
+			assert.Equal(t, tt.wantMemberCount, memberCount, "expect member count to match")
+		})
+	}
+}
+
 func TestRepo_AddVoxspheres(t *testing.T) {
 	type args struct {
 		voxspheres []models.Voxsphere
@@ -0,0 +1,249 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/token"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	authrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/auth"
+	userrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+
+	minPasswordLength = 8
+	// bcrypt ignores every byte of a password past the 72nd.
+	maxPasswordLength = 72
+)
+
+var (
+	ErrInvalidName        = errors.New("name must be 3 to 20 letters, digits, dashes or underscores")
+	ErrInvalidEmail       = errors.New("invalid email")
+	ErrInvalidPassword    = fmt.Errorf("password must be %d to %d bytes long", minPasswordLength, maxPasswordLength)
+	ErrInvalidCredentials = errors.New("invalid name or password")
+	ErrUserSuspended      = errors.New("user suspended")
+)
+
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{3,20}$`)
+
+type AuthService interface {
+	Register(ctx context.Context, name, email, password string) (models.Session, error)
+	Login(ctx context.Context, name, password string) (models.Session, error)
+	Refresh(ctx context.Context, refreshToken string) (models.Session, error)
+	Logout(ctx context.Context, refreshToken string) error
+	Authenticate(ctx context.Context, accessToken string) (models.User, error)
+}
+
+//counterfeiter:generate . AuthRepository
+type AuthRepository interface {
+	AddUserWithCredential(ctx context.Context, user models.User, credential models.UserCredential) (models.User, error)
+	CredentialByName(ctx context.Context, name string) (models.UserCredential, error)
+	AddRefreshToken(ctx context.Context, token models.RefreshToken) (models.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, ID uuid.UUID) (models.RefreshToken, error)
+}
+
+//counterfeiter:generate . UserRepository
+type UserRepository interface {
+	UserByID(ctx context.Context, ID uuid.UUID) (models.User, error)
+}
+
+//counterfeiter:generate . TokenSigner
+type TokenSigner interface {
+	Sign(claims token.Claims) (string, error)
+	Verify(signed string, kind token.Kind) (token.Claims, error)
+}
+
+type Service struct {
+	repo     AuthRepository
+	userRepo UserRepository
+	signer   TokenSigner
+}
+
+func NewService(repo AuthRepository, userRepo UserRepository, signer TokenSigner) *Service {
+	return &Service{
+		repo:     repo,
+		userRepo: userRepo,
+		signer:   signer,
+	}
+}
+
+// dummyHash is compared against the password of a login for an unknown name,
+// so that such a login takes as long as one with a wrong password.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("voxpopuli"), bcrypt.DefaultCost)
+
+func (s *Service) Register(ctx context.Context, name, email, password string) (models.Session, error) {
+	if !namePattern.MatchString(name) {
+		return models.Session{}, ErrInvalidName
+	}
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return models.Session{}, ErrInvalidEmail
+	}
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return models.Session{}, ErrInvalidPassword
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	user, err := s.repo.AddUserWithCredential(ctx,
+		models.User{
+			ID:   uuid.New(),
+			Name: name,
+		},
+		models.UserCredential{
+			Email:        email,
+			PasswordHash: string(passwordHash),
+		},
+	)
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	return s.session(ctx, user)
+}
+
+func (s *Service) Login(ctx context.Context, name, password string) (models.Session, error) {
+	credential, err := s.repo.CredentialByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, authrepo.ErrAuthCredentialNotFound) {
+			_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+			return models.Session{}, ErrInvalidCredentials
+		}
+		return models.Session{}, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(credential.PasswordHash), []byte(password)); err != nil {
+		return models.Session{}, ErrInvalidCredentials
+	}
+
+	user, err := s.userRepo.UserByID(ctx, credential.UserID)
+	if err != nil {
+		return models.Session{}, err
+	}
+	if user.Suspended {
+		return models.Session{}, ErrUserSuspended
+	}
+
+	return s.session(ctx, user)
+}
+
+// Refresh trades refreshToken for a new session. The refresh token is revoked
+// on the way, so each one can be traded once.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (models.Session, error) {
+	claims, err := s.signer.Verify(refreshToken, token.KindRefresh)
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	if _, err := s.repo.RevokeRefreshToken(ctx, claims.ID); err != nil {
+		if errors.Is(err, authrepo.ErrAuthRefreshTokenNotFound) {
+			return models.Session{}, token.ErrInvalidToken
+		}
+		return models.Session{}, err
+	}
+
+	user, err := s.user(ctx, claims.Subject)
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	return s.session(ctx, user)
+}
+
+// Logout revokes refreshToken. Logging out of a session that is already
+// revoked succeeds.
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	claims, err := s.signer.Verify(refreshToken, token.KindRefresh)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.repo.RevokeRefreshToken(ctx, claims.ID); err != nil && !errors.Is(err, authrepo.ErrAuthRefreshTokenNotFound) {
+		return err
+	}
+	return nil
+}
+
+// Authenticate returns the user accessToken was issued to.
+func (s *Service) Authenticate(ctx context.Context, accessToken string) (models.User, error) {
+	claims, err := s.signer.Verify(accessToken, token.KindAccess)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	return s.user(ctx, claims.Subject)
+}
+
+// user returns the user of ID when they may still be signed in. The tokens of
+// deleted and suspended users are no longer valid.
+func (s *Service) user(ctx context.Context, ID uuid.UUID) (models.User, error) {
+	user, err := s.userRepo.UserByID(ctx, ID)
+	if err != nil {
+		if errors.Is(err, userrepo.ErrUserNotFound) {
+			return models.User{}, token.ErrInvalidToken
+		}
+		return models.User{}, err
+	}
+	if user.Suspended {
+		return models.User{}, fmt.Errorf("%w: %w", token.ErrInvalidToken, ErrUserSuspended)
+	}
+	return user, nil
+}
+
+// session issues an access token and a refresh token to user.
+func (s *Service) session(ctx context.Context, user models.User) (models.Session, error) {
+	now := time.Now()
+	accessExpiresAt := now.Add(AccessTokenTTL)
+	refreshExpiresAt := now.Add(RefreshTokenTTL)
+
+	accessToken, err := s.signer.Sign(token.Claims{
+		ID:        uuid.New(),
+		Subject:   user.ID,
+		Kind:      token.KindAccess,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: accessExpiresAt.Unix(),
+	})
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	refresh, err := s.repo.AddRefreshToken(ctx, models.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		ExpiresAt: refreshExpiresAt,
+	})
+	if err != nil {
+		return models.Session{}, err
+	}
+	refreshToken, err := s.signer.Sign(token.Claims{
+		ID:        refresh.ID,
+		Subject:   user.ID,
+		Kind:      token.KindRefresh,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: refreshExpiresAt.Unix(),
+	})
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	return models.Session{
+		User: user,
+		AuthTokens: models.AuthTokens{
+			AccessToken:           accessToken,
+			AccessTokenExpiresAt:  time.Unix(accessExpiresAt.Unix(), 0).UTC(),
+			RefreshToken:          refreshToken,
+			RefreshTokenExpiresAt: time.Unix(refreshExpiresAt.Unix(), 0).UTC(),
+		},
+	}, nil
+}
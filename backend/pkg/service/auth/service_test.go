@@ -0,0 +1,352 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/token"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	authrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/auth"
+	userrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user"
+	authservice "github.com/glowfi/voxpopuli/backend/pkg/service/auth"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/auth/authfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testUser = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "john_doe",
+}
+
+// signedToken returns a token of kind issued to testUser, expiring after ttl.
+func signedToken(t *testing.T, signer *token.Signer, kind token.Kind, ttl time.Duration) string {
+	t.Helper()
+
+	signed, err := signer.Sign(token.Claims{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+		Subject:   testUser.ID,
+		Kind:      kind,
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+// assertSession asserts that session belongs to user and carries tokens
+// signed by signer.
+func assertSession(t *testing.T, signer *token.Signer, user models.User, session models.Session) {
+	t.Helper()
+
+	assert.Equal(t, user, session.User, "expect session user to match")
+
+	accessClaims, err := signer.Verify(session.AccessToken, token.KindAccess)
+	assert.NoError(t, err, "expect access token to be valid")
+	assert.Equal(t, user.ID, accessClaims.Subject, "expect access token subject to match")
+
+	refreshClaims, err := signer.Verify(session.RefreshToken, token.KindRefresh)
+	assert.NoError(t, err, "expect refresh token to be valid")
+	assert.Equal(t, user.ID, refreshClaims.Subject, "expect refresh token subject to match")
+}
+
+func TestService_Register(t *testing.T) {
+	type args struct {
+		name     string
+		email    string
+		password string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		repoErr  error
+		wantUser models.User
+		wantErr  error
+	}{
+		{
+			name:    "invalid name :NEG",
+			args:    args{name: "john doe", email: "john@example.com", password: "password"},
+			wantErr: authservice.ErrInvalidName,
+		},
+		{
+			name:    "invalid email :NEG",
+			args:    args{name: "john_doe", email: "John <john@example.com>", password: "password"},
+			wantErr: authservice.ErrInvalidEmail,
+		},
+		{
+			name:    "short password :NEG",
+			args:    args{name: "john_doe", email: "john@example.com", password: "secret"},
+			wantErr: authservice.ErrInvalidPassword,
+		},
+		{
+			name:    "duplicate name :NEG",
+			args:    args{name: "john_doe", email: "john@example.com", password: "password"},
+			repoErr: authrepo.ErrAuthDuplicateName,
+			wantErr: authrepo.ErrAuthDuplicateName,
+		},
+		{
+			name:     "new user :POS",
+			args:     args{name: "john_doe", email: "john@example.com", password: "password"},
+			wantUser: testUser,
+			wantErr:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := token.NewSigner([]byte("secret"))
+			fakeAuthRepo := authfakes.FakeAuthRepository{}
+			fakeAuthRepo.AddUserWithCredentialReturns(tt.wantUser, tt.repoErr)
+			fakeAuthRepo.AddRefreshTokenStub = func(_ context.Context, refreshToken models.RefreshToken) (models.RefreshToken, error) {
+				return refreshToken, nil
+			}
+			service := authservice.NewService(&fakeAuthRepo, &authfakes.FakeUserRepository{}, signer)
+
+			gotSession, gotErr := service.Register(context.Background(), tt.args.name, tt.args.email, tt.args.password)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, models.Session{}, gotSession, "expect no session")
+				return
+			}
+			assertSession(t, signer, tt.wantUser, gotSession)
+
+			_, gotUser, gotCredential := fakeAuthRepo.AddUserWithCredentialArgsForCall(0)
+			assert.Equal(t, tt.args.name, gotUser.Name, "expect user name to match")
+			assert.Equal(t, tt.args.email, gotCredential.Email, "expect email to match")
+			assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(gotCredential.PasswordHash), []byte(tt.args.password)), "expect password to be hashed")
+		})
+	}
+}
+
+func TestService_Login(t *testing.T) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	credential := models.UserCredential{
+		UserID:       testUser.ID,
+		Email:        "john@example.com",
+		PasswordHash: string(passwordHash),
+	}
+	suspendedUser := testUser
+	suspendedUser.Suspended = true
+
+	tests := []struct {
+		name          string
+		password      string
+		credential    models.UserCredential
+		credentialErr error
+		user          models.User
+		wantErr       error
+	}{
+		{
+			name:          "unknown name :NEG",
+			password:      "password",
+			credentialErr: authrepo.ErrAuthCredentialNotFound,
+			wantErr:       authservice.ErrInvalidCredentials,
+		},
+		{
+			name:       "wrong password :NEG",
+			password:   "passw0rd",
+			credential: credential,
+			wantErr:    authservice.ErrInvalidCredentials,
+		},
+		{
+			name:       "suspended user :NEG",
+			password:   "password",
+			credential: credential,
+			user:       suspendedUser,
+			wantErr:    authservice.ErrUserSuspended,
+		},
+		{
+			name:       "valid credentials :POS",
+			password:   "password",
+			credential: credential,
+			user:       testUser,
+			wantErr:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := token.NewSigner([]byte("secret"))
+			fakeAuthRepo := authfakes.FakeAuthRepository{}
+			fakeAuthRepo.CredentialByNameReturns(tt.credential, tt.credentialErr)
+			fakeAuthRepo.AddRefreshTokenStub = func(_ context.Context, refreshToken models.RefreshToken) (models.RefreshToken, error) {
+				return refreshToken, nil
+			}
+			fakeUserRepo := authfakes.FakeUserRepository{}
+			fakeUserRepo.UserByIDReturns(tt.user, nil)
+			service := authservice.NewService(&fakeAuthRepo, &fakeUserRepo, signer)
+
+			gotSession, gotErr := service.Login(context.Background(), testUser.Name, tt.password)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, models.Session{}, gotSession, "expect no session")
+				assert.Equal(t, 0, fakeAuthRepo.AddRefreshTokenCallCount(), "expect no refresh token to be issued")
+				return
+			}
+			assertSession(t, signer, tt.user, gotSession)
+		})
+	}
+}
+
+func TestService_Refresh(t *testing.T) {
+	signer := token.NewSigner([]byte("secret"))
+
+	tests := []struct {
+		name         string
+		refreshToken string
+		revokeErr    error
+		userErr      error
+		wantErr      error
+	}{
+		{
+			name:         "access token :NEG",
+			refreshToken: signedToken(t, signer, token.KindAccess, time.Hour),
+			wantErr:      token.ErrInvalidToken,
+		},
+		{
+			name:         "expired refresh token :NEG",
+			refreshToken: signedToken(t, signer, token.KindRefresh, -time.Hour),
+			wantErr:      token.ErrExpiredToken,
+		},
+		{
+			name:         "revoked refresh token :NEG",
+			refreshToken: signedToken(t, signer, token.KindRefresh, time.Hour),
+			revokeErr:    authrepo.ErrAuthRefreshTokenNotFound,
+			wantErr:      token.ErrInvalidToken,
+		},
+		{
+			name:         "deleted user :NEG",
+			refreshToken: signedToken(t, signer, token.KindRefresh, time.Hour),
+			userErr:      userrepo.ErrUserNotFound,
+			wantErr:      token.ErrInvalidToken,
+		},
+		{
+			name:         "valid refresh token :POS",
+			refreshToken: signedToken(t, signer, token.KindRefresh, time.Hour),
+			wantErr:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAuthRepo := authfakes.FakeAuthRepository{}
+			fakeAuthRepo.RevokeRefreshTokenReturns(models.RefreshToken{}, tt.revokeErr)
+			fakeAuthRepo.AddRefreshTokenStub = func(_ context.Context, refreshToken models.RefreshToken) (models.RefreshToken, error) {
+				return refreshToken, nil
+			}
+			fakeUserRepo := authfakes.FakeUserRepository{}
+			fakeUserRepo.UserByIDReturns(testUser, tt.userErr)
+			service := authservice.NewService(&fakeAuthRepo, &fakeUserRepo, signer)
+
+			gotSession, gotErr := service.Refresh(context.Background(), tt.refreshToken)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, models.Session{}, gotSession, "expect no session")
+				return
+			}
+			assertSession(t, signer, testUser, gotSession)
+
+			_, gotID := fakeAuthRepo.RevokeRefreshTokenArgsForCall(0)
+			assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000009"), gotID, "expect traded refresh token to be revoked")
+		})
+	}
+}
+
+func TestService_Logout(t *testing.T) {
+	signer := token.NewSigner([]byte("secret"))
+
+	tests := []struct {
+		name         string
+		refreshToken string
+		revokeErr    error
+		wantErr      error
+	}{
+		{
+			name:         "forged refresh token :NEG",
+			refreshToken: signedToken(t, token.NewSigner([]byte("other secret")), token.KindRefresh, time.Hour),
+			wantErr:      token.ErrInvalidToken,
+		},
+		{
+			name:         "revoked refresh token :POS",
+			refreshToken: signedToken(t, signer, token.KindRefresh, time.Hour),
+			revokeErr:    authrepo.ErrAuthRefreshTokenNotFound,
+			wantErr:      nil,
+		},
+		{
+			name:         "valid refresh token :POS",
+			refreshToken: signedToken(t, signer, token.KindRefresh, time.Hour),
+			wantErr:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAuthRepo := authfakes.FakeAuthRepository{}
+			fakeAuthRepo.RevokeRefreshTokenReturns(models.RefreshToken{}, tt.revokeErr)
+			service := authservice.NewService(&fakeAuthRepo, &authfakes.FakeUserRepository{}, signer)
+
+			gotErr := service.Logout(context.Background(), tt.refreshToken)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+		})
+	}
+}
+
+func TestService_Authenticate(t *testing.T) {
+	signer := token.NewSigner([]byte("secret"))
+	suspendedUser := testUser
+	suspendedUser.Suspended = true
+
+	tests := []struct {
+		name        string
+		accessToken string
+		user        models.User
+		userErr     error
+		wantUser    models.User
+		wantErr     error
+	}{
+		{
+			name:        "refresh token :NEG",
+			accessToken: signedToken(t, signer, token.KindRefresh, time.Hour),
+			wantUser:    models.User{},
+			wantErr:     token.ErrInvalidToken,
+		},
+		{
+			name:        "deleted user :NEG",
+			accessToken: signedToken(t, signer, token.KindAccess, time.Hour),
+			userErr:     userrepo.ErrUserNotFound,
+			wantUser:    models.User{},
+			wantErr:     token.ErrInvalidToken,
+		},
+		{
+			name:        "suspended user :NEG",
+			accessToken: signedToken(t, signer, token.KindAccess, time.Hour),
+			user:        suspendedUser,
+			wantUser:    models.User{},
+			wantErr:     authservice.ErrUserSuspended,
+		},
+		{
+			name:        "valid access token :POS",
+			accessToken: signedToken(t, signer, token.KindAccess, time.Hour),
+			user:        testUser,
+			wantUser:    testUser,
+			wantErr:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserRepo := authfakes.FakeUserRepository{}
+			fakeUserRepo.UserByIDReturns(tt.user, tt.userErr)
+			service := authservice.NewService(&authfakes.FakeAuthRepository{}, &fakeUserRepo, signer)
+
+			gotUser, gotErr := service.Authenticate(context.Background(), tt.accessToken)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantUser, gotUser, "expect user to match")
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package automod
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/automod"
+	"github.com/glowfi/voxpopuli/backend/internal/render"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	automodrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/automod"
+	"github.com/google/uuid"
+)
+
+var ErrAutomodNotModerator = errors.New("only moderators of a voxsphere can configure its automod")
+
+// emptyConfig is the config of voxspheres that never configured automod.
+var emptyConfig = json.RawMessage(`{"rules":[]}`)
+
+type AutomodService interface {
+	AutomodConfig(ctx context.Context, voxsphereID, moderatorID uuid.UUID) (models.AutomodConfig, error)
+	UpdateAutomodConfig(ctx context.Context, voxsphereID, moderatorID uuid.UUID, config json.RawMessage) (models.AutomodConfig, error)
+	JudgePost(ctx context.Context, post models.Post, links []string) (*models.AutomodVerdict, error)
+	JudgeComment(ctx context.Context, voxsphereID uuid.UUID, comment models.Comment) (*models.AutomodVerdict, error)
+}
+
+//counterfeiter:generate . AutomodRepository
+type AutomodRepository interface {
+	AutomodConfig(ctx context.Context, voxsphereID uuid.UUID) (models.AutomodConfig, error)
+	SetAutomodConfig(ctx context.Context, config models.AutomodConfig) (models.AutomodConfig, error)
+	AuthorStanding(ctx context.Context, userID uuid.UUID) (models.AuthorStanding, error)
+}
+
+//counterfeiter:generate . ModeratorRepository
+type ModeratorRepository interface {
+	IsVoxsphereModerator(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	repo          AutomodRepository
+	moderatorRepo ModeratorRepository
+}
+
+func NewService(repo AutomodRepository, moderatorRepo ModeratorRepository) *Service {
+	return &Service{
+		repo:          repo,
+		moderatorRepo: moderatorRepo,
+	}
+}
+
+// AutomodConfig returns the automod config of the voxsphere of voxsphereID to
+// the user of moderatorID, who has to moderate it.
+func (s *Service) AutomodConfig(ctx context.Context, voxsphereID, moderatorID uuid.UUID) (models.AutomodConfig, error) {
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.AutomodConfig{}, err
+	}
+
+	config, err := s.repo.AutomodConfig(ctx, voxsphereID)
+	if errors.Is(err, automodrepo.ErrAutomodConfigNotFound) {
+		return models.AutomodConfig{VoxsphereID: voxsphereID, Config: emptyConfig}, nil
+	}
+	return config, err
+}
+
+// UpdateAutomodConfig replaces the automod config of the voxsphere of
+// voxsphereID on behalf of the user of moderatorID, who has to moderate it.
+// The config has to compile, so that screening never runs into a broken one.
+func (s *Service) UpdateAutomodConfig(ctx context.Context, voxsphereID, moderatorID uuid.UUID, config json.RawMessage) (models.AutomodConfig, error) {
+	if _, err := automod.Parse(config); err != nil {
+		return models.AutomodConfig{}, err
+	}
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.AutomodConfig{}, err
+	}
+
+	return s.repo.SetAutomodConfig(ctx, models.AutomodConfig{
+		VoxsphereID: voxsphereID,
+		Config:      config,
+		UpdatedBy:   moderatorID,
+	})
+}
+
+// JudgePost runs the post through the automod rules of its voxsphere and
+// returns the verdict on it, or nil when no rule matched. links are the links
+// the post was submitted with. The verdict is carried out by the post
+// repository along with the write of the post.
+func (s *Service) JudgePost(ctx context.Context, post models.Post, links []string) (*models.AutomodVerdict, error) {
+	subject := automod.Subject{
+		Target: automod.TargetPost,
+		Title:  post.Title,
+		Body:   post.Text,
+		Links:  links,
+	}
+	return s.judge(ctx, post.VoxsphereID, post.AuthorID, subject, models.AutomodVerdict{
+		VoxsphereID: post.VoxsphereID,
+		TargetType:  models.ReportTargetPost,
+		TargetID:    post.ID,
+	}, func(body string) models.Comment {
+		return models.Comment{PostID: post.ID, Body: body}
+	})
+}
+
+// JudgeComment runs the comment through the automod rules of the voxsphere of
+// voxsphereID and returns the verdict on it, or nil when no rule matched.
+func (s *Service) JudgeComment(ctx context.Context, voxsphereID uuid.UUID, comment models.Comment) (*models.AutomodVerdict, error) {
+	subject := automod.Subject{
+		Target: automod.TargetComment,
+		Body:   comment.Body,
+	}
+	return s.judge(ctx, voxsphereID, comment.AuthorID, subject, models.AutomodVerdict{
+		VoxsphereID: voxsphereID,
+		TargetType:  models.ReportTargetComment,
+		TargetID:    comment.ID,
+	}, func(body string) models.Comment {
+		return models.Comment{PostID: comment.PostID, ParentCommentID: comment.ID, Body: body}
+	})
+}
+
+// judge evaluates subject against the config of the voxsphere of
+// voxsphereID and fills in verdict with the outcome. Voxspheres without a
+// config judge nothing. reply builds the reply automod makes with body.
+func (s *Service) judge(ctx context.Context, voxsphereID, authorID uuid.UUID, subject automod.Subject, verdict models.AutomodVerdict, reply func(body string) models.Comment) (*models.AutomodVerdict, error) {
+	config, err := s.repo.AutomodConfig(ctx, voxsphereID)
+	if err != nil {
+		if errors.Is(err, automodrepo.ErrAutomodConfigNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	engine, err := automod.Parse(config.Config)
+	if err != nil {
+		return nil, fmt.Errorf("automod config of voxsphere %s: %w", voxsphereID, err)
+	}
+
+	standing, err := s.repo.AuthorStanding(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+	subject.AuthorCreatedAt = standing.CreatedAt
+	subject.AuthorKarma = standing.Karma
+
+	outcome := engine.Evaluate(subject, time.Now())
+	if !outcome.Matched() {
+		return nil, nil
+	}
+
+	verdict.Remove = outcome.Remove
+	verdict.Filter = outcome.Filter
+	verdict.Details = "automod: " + strings.Join(outcome.Rules, ", ")
+	verdict.FlairID = outcome.FlairID
+	for _, body := range outcome.Replies {
+		comment := reply(body)
+		comment.ID = uuid.New()
+		comment.AuthorID = models.AutoModeratorID
+		comment.BodyHtml = render.Markdown(body)
+		verdict.Replies = append(verdict.Replies, comment)
+	}
+	return &verdict, nil
+}
+
+// requireModerator fails with ErrAutomodNotModerator unless the user of userID
+// moderates the voxsphere of voxsphereID.
+func (s *Service) requireModerator(ctx context.Context, voxsphereID, userID uuid.UUID) error {
+	isModerator, err := s.moderatorRepo.IsVoxsphereModerator(ctx, voxsphereID, userID)
+	if err != nil {
+		return err
+	}
+	if !isModerator {
+		return ErrAutomodNotModerator
+	}
+	return nil
+}
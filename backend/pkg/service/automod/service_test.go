@@ -0,0 +1,252 @@
+package automod_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/automod"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	automodrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/automod"
+	automodservice "github.com/glowfi/voxpopuli/backend/pkg/service/automod"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/automod/automodfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	voxsphereID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	moderatorID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	authorID    = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	flairID     = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+)
+
+const config = `
+{
+  "rules": [
+    {"name": "spam", "body": "(?i)buy now", "action": "remove"},
+    {"name": "new accounts", "account_age_below_days": 7, "action": "filter"},
+    {"name": "question", "title": "\\?$", "action": "flair", "flair_id": "00000000-0000-0000-0000-000000000001"},
+    {"name": "shorteners", "domains": ["bit.ly"], "action": "reply", "reply": "no **shorteners**"}
+  ]
+}
+`
+
+func TestService_AutomodConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		isModerator bool
+		configErr   error
+		wantConfig  models.AutomodConfig
+		wantErr     error
+	}{
+		{
+			name:        "not a moderator :NEG",
+			isModerator: false,
+			wantErr:     automodservice.ErrAutomodNotModerator,
+		},
+		{
+			name:        "never configured :POS",
+			isModerator: true,
+			configErr:   automodrepo.ErrAutomodConfigNotFound,
+			wantConfig:  models.AutomodConfig{VoxsphereID: voxsphereID, Config: json.RawMessage(`{"rules":[]}`)},
+		},
+		{
+			name:        "configured :POS",
+			isModerator: true,
+			wantConfig:  models.AutomodConfig{VoxsphereID: voxsphereID, Config: json.RawMessage(config)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAutomodRepo := automodfakes.FakeAutomodRepository{}
+			fakeAutomodRepo.AutomodConfigReturns(models.AutomodConfig{VoxsphereID: voxsphereID, Config: json.RawMessage(config)}, tt.configErr)
+			fakeModeratorRepo := automodfakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := automodservice.NewService(&fakeAutomodRepo, &fakeModeratorRepo)
+
+			gotConfig, gotErr := service.AutomodConfig(context.Background(), voxsphereID, moderatorID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantConfig, gotConfig, "expect config to match")
+		})
+	}
+}
+
+func TestService_UpdateAutomodConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		isModerator bool
+		wantErr     error
+		wantSetCall bool
+	}{
+		{
+			name:        "invalid config :NEG",
+			config:      `{"rules": [{"name": "spam", "body": "(", "action": "remove"}]}`,
+			isModerator: true,
+			wantErr:     automod.ErrInvalidConfig,
+		},
+		{
+			name:        "not a moderator :NEG",
+			config:      config,
+			isModerator: false,
+			wantErr:     automodservice.ErrAutomodNotModerator,
+		},
+		{
+			name:        "moderator :POS",
+			config:      config,
+			isModerator: true,
+			wantSetCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAutomodRepo := automodfakes.FakeAutomodRepository{}
+			fakeModeratorRepo := automodfakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := automodservice.NewService(&fakeAutomodRepo, &fakeModeratorRepo)
+
+			_, gotErr := service.UpdateAutomodConfig(context.Background(), voxsphereID, moderatorID, json.RawMessage(tt.config))
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if !tt.wantSetCall {
+				assert.Equal(t, 0, fakeAutomodRepo.SetAutomodConfigCallCount(), "expect config to stay as is")
+				return
+			}
+			_, gotConfig := fakeAutomodRepo.SetAutomodConfigArgsForCall(0)
+			assert.Equal(t, voxsphereID, gotConfig.VoxsphereID, "expect voxsphere to match")
+			assert.Equal(t, moderatorID, gotConfig.UpdatedBy, "expect moderator to update the config")
+		})
+	}
+}
+
+func TestService_JudgePost(t *testing.T) {
+	post := models.Post{
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		AuthorID:    authorID,
+		VoxsphereID: voxsphereID,
+		Title:       "title",
+		Text:        "text",
+	}
+	oldAccount := models.AuthorStanding{CreatedAt: time.Now().AddDate(-1, 0, 0), Karma: 100}
+	newAccount := models.AuthorStanding{CreatedAt: time.Now().Add(-time.Hour)}
+
+	tests := []struct {
+		name        string
+		title       string
+		text        string
+		links       []string
+		standing    models.AuthorStanding
+		configErr   error
+		wantVerdict *models.AutomodVerdict
+	}{
+		{
+			name:        "no config :POS",
+			standing:    newAccount,
+			configErr:   automodrepo.ErrAutomodConfigNotFound,
+			wantVerdict: nil,
+		},
+		{
+			name:        "no rule matches :POS",
+			title:       "title",
+			text:        "text",
+			standing:    oldAccount,
+			wantVerdict: nil,
+		},
+		{
+			name:     "spam :POS",
+			title:    "title",
+			text:     "buy now",
+			standing: oldAccount,
+			wantVerdict: &models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    post.ID,
+				Remove:      true,
+				Details:     "automod: spam",
+			},
+		},
+		{
+			name:     "new account asking :POS",
+			title:    "what?",
+			text:     "text",
+			standing: newAccount,
+			wantVerdict: &models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    post.ID,
+				Filter:      true,
+				Details:     "automod: new accounts, question",
+				FlairID:     flairID,
+			},
+		},
+		{
+			name:     "shortened link :POS",
+			title:    "title",
+			links:    []string{"https://bit.ly/foo"},
+			standing: oldAccount,
+			wantVerdict: &models.AutomodVerdict{
+				VoxsphereID: voxsphereID,
+				TargetType:  models.ReportTargetPost,
+				TargetID:    post.ID,
+				Details:     "automod: shorteners",
+				Replies: []models.Comment{
+					{
+						AuthorID: models.AutoModeratorID,
+						PostID:   post.ID,
+						Body:     "no **shorteners**",
+						BodyHtml: "<p>no <strong>shorteners</strong></p>",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAutomodRepo := automodfakes.FakeAutomodRepository{}
+			fakeAutomodRepo.AutomodConfigReturns(models.AutomodConfig{VoxsphereID: voxsphereID, Config: json.RawMessage(config)}, tt.configErr)
+			fakeAutomodRepo.AuthorStandingReturns(tt.standing, nil)
+			service := automodservice.NewService(&fakeAutomodRepo, &automodfakes.FakeModeratorRepository{})
+
+			post := post
+			post.Title = tt.title
+			post.Text = tt.text
+			gotVerdict, gotErr := service.JudgePost(context.Background(), post, tt.links)
+			assert.NoError(t, gotErr, "expect no error")
+
+			if gotVerdict != nil {
+				for i := range gotVerdict.Replies {
+					assert.NotEqual(t, uuid.Nil, gotVerdict.Replies[i].ID, "expect reply to get an id")
+					gotVerdict.Replies[i].ID = uuid.Nil
+				}
+			}
+			assert.Equal(t, tt.wantVerdict, gotVerdict, "expect verdict to match")
+		})
+	}
+}
+
+func TestService_JudgeComment(t *testing.T) {
+	comment := models.Comment{
+		ID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		AuthorID: authorID,
+		PostID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Body:     "BUY NOW",
+	}
+
+	fakeAutomodRepo := automodfakes.FakeAutomodRepository{}
+	fakeAutomodRepo.AutomodConfigReturns(models.AutomodConfig{VoxsphereID: voxsphereID, Config: json.RawMessage(config)}, nil)
+	fakeAutomodRepo.AuthorStandingReturns(models.AuthorStanding{CreatedAt: time.Now().AddDate(-1, 0, 0)}, nil)
+	service := automodservice.NewService(&fakeAutomodRepo, &automodfakes.FakeModeratorRepository{})
+
+	gotVerdict, gotErr := service.JudgeComment(context.Background(), voxsphereID, comment)
+	assert.NoError(t, gotErr, "expect no error")
+
+	assert.Equal(t, &models.AutomodVerdict{
+		VoxsphereID: voxsphereID,
+		TargetType:  models.ReportTargetComment,
+		TargetID:    comment.ID,
+		Remove:      true,
+		Details:     "automod: spam",
+	}, gotVerdict, "expect comment to be removed")
+}
@@ -0,0 +1,77 @@
+package award
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+const maxIdempotencyKeyLength = 64
+
+var ErrAwardInvalidIdempotencyKey = errors.New("idempotency key must be 1 to 64 characters")
+
+type AwardService interface {
+	Awards(ctx context.Context) ([]models.Award, error)
+	GrantPostAward(ctx context.Context, postID, giverID uuid.UUID, idempotencyKey string, submission models.AwardGrantSubmission) (models.AwardGrant, error)
+	GrantCommentAward(ctx context.Context, commentID, giverID uuid.UUID, idempotencyKey string, submission models.AwardGrantSubmission) (models.AwardGrant, error)
+	CoinWallet(ctx context.Context, userID uuid.UUID, limit int) (models.CoinWallet, error)
+}
+
+//counterfeiter:generate . AwardRepository
+type AwardRepository interface {
+	Awards(ctx context.Context) ([]models.Award, error)
+	GrantAward(ctx context.Context, grant models.AwardGrant) (models.AwardGrant, error)
+	CoinWallet(ctx context.Context, userID uuid.UUID, limit int) (models.CoinWallet, error)
+}
+
+type Service struct {
+	repo AwardRepository
+}
+
+func NewService(repo AwardRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// Awards returns the awards users can give, along with their prices.
+func (s *Service) Awards(ctx context.Context) ([]models.Award, error) {
+	return s.repo.Awards(ctx)
+}
+
+// GrantPostAward gives the submitted award to the post of postID on behalf of
+// the user of giverID. A retry with the same idempotencyKey returns the
+// grant made the first time instead of charging the giver again.
+func (s *Service) GrantPostAward(ctx context.Context, postID, giverID uuid.UUID, idempotencyKey string, submission models.AwardGrantSubmission) (models.AwardGrant, error) {
+	return s.grantAward(ctx, models.AwardGrant{
+		AwardID:        submission.AwardID,
+		GiverID:        giverID,
+		PostID:         postID,
+		IdempotencyKey: idempotencyKey,
+	})
+}
+
+// GrantCommentAward gives the submitted award to the comment of commentID on
+// behalf of the user of giverID, with retries handled as in GrantPostAward.
+func (s *Service) GrantCommentAward(ctx context.Context, commentID, giverID uuid.UUID, idempotencyKey string, submission models.AwardGrantSubmission) (models.AwardGrant, error) {
+	return s.grantAward(ctx, models.AwardGrant{
+		AwardID:        submission.AwardID,
+		GiverID:        giverID,
+		CommentID:      commentID,
+		IdempotencyKey: idempotencyKey,
+	})
+}
+
+func (s *Service) grantAward(ctx context.Context, grant models.AwardGrant) (models.AwardGrant, error) {
+	if len(grant.IdempotencyKey) == 0 || len(grant.IdempotencyKey) > maxIdempotencyKeyLength {
+		return models.AwardGrant{}, ErrAwardInvalidIdempotencyKey
+	}
+	grant.ID = uuid.New()
+	return s.repo.GrantAward(ctx, grant)
+}
+
+// CoinWallet returns the coin balance of the user of userID along with their
+// latest limit transactions.
+func (s *Service) CoinWallet(ctx context.Context, userID uuid.UUID, limit int) (models.CoinWallet, error) {
+	return s.repo.CoinWallet(ctx, userID, limit)
+}
@@ -0,0 +1,93 @@
+package award_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	awardrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/award"
+	awardservice "github.com/glowfi/voxpopuli/backend/pkg/service/award"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/award/awardfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	awardID   = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	giverID   = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	postID    = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	commentID = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+)
+
+func TestService_GrantPostAward(t *testing.T) {
+	tests := []struct {
+		name           string
+		idempotencyKey string
+		repoErr        error
+		wantErr        error
+		wantGrantCall  bool
+	}{
+		{
+			name:           "empty idempotency key :NEG",
+			idempotencyKey: "",
+			wantErr:        awardservice.ErrAwardInvalidIdempotencyKey,
+		},
+		{
+			name:           "too long idempotency key :NEG",
+			idempotencyKey: strings.Repeat("k", 65),
+			wantErr:        awardservice.ErrAwardInvalidIdempotencyKey,
+		},
+		{
+			name:           "insufficient coins :NEG",
+			idempotencyKey: "key1",
+			repoErr:        awardrepo.ErrAwardGrantInsufficientCoins,
+			wantErr:        awardrepo.ErrAwardGrantInsufficientCoins,
+			wantGrantCall:  true,
+		},
+		{
+			name:           "grant :POS",
+			idempotencyKey: "key1",
+			wantGrantCall:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeRepo := awardfakes.FakeAwardRepository{}
+			fakeRepo.GrantAwardStub = func(_ context.Context, grant models.AwardGrant) (models.AwardGrant, error) {
+				return grant, tt.repoErr
+			}
+			service := awardservice.NewService(&fakeRepo)
+
+			_, gotErr := service.GrantPostAward(context.Background(), postID, giverID, tt.idempotencyKey, models.AwardGrantSubmission{AwardID: awardID})
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if !tt.wantGrantCall {
+				assert.Equal(t, 0, fakeRepo.GrantAwardCallCount(), "expect no grant")
+				return
+			}
+			_, gotGrant := fakeRepo.GrantAwardArgsForCall(0)
+			assert.NotEqual(t, uuid.Nil, gotGrant.ID, "expect grant to get an id")
+			gotGrant.ID = uuid.Nil
+			assert.Equal(t, models.AwardGrant{
+				AwardID:        awardID,
+				GiverID:        giverID,
+				PostID:         postID,
+				IdempotencyKey: tt.idempotencyKey,
+			}, gotGrant, "expect grant to match")
+		})
+	}
+}
+
+func TestService_GrantCommentAward(t *testing.T) {
+	fakeRepo := awardfakes.FakeAwardRepository{}
+	service := awardservice.NewService(&fakeRepo)
+
+	_, gotErr := service.GrantCommentAward(context.Background(), commentID, giverID, "key1", models.AwardGrantSubmission{AwardID: awardID})
+	assert.NoError(t, gotErr, "expect no error")
+	assert.Equal(t, 1, fakeRepo.GrantAwardCallCount(), "expect a grant")
+
+	_, gotGrant := fakeRepo.GrantAwardArgsForCall(0)
+	assert.Equal(t, commentID, gotGrant.CommentID, "expect comment to get the award")
+	assert.Equal(t, uuid.Nil, gotGrant.PostID, "expect no post to get the award")
+}
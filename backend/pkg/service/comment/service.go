@@ -0,0 +1,314 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/glowfi/voxpopuli/backend/internal/render"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	commentsrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/comments"
+	moderationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/moderation"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultDepth = 8
+	MaxDepth     = 16
+	DefaultLimit = 200
+	MaxLimit     = 500
+)
+
+const maxBodyLength = 10000
+
+var (
+	ErrCommentInvalidBody     = fmt.Errorf("body must be 1 to %d characters long", maxBodyLength)
+	ErrCommentParentNotInPost = errors.New("parent comment is not a comment of the post")
+	ErrCommentNotAuthor       = errors.New("only the author of a comment can change it")
+	ErrCommentPostLocked      = errors.New("the post is locked")
+	ErrCommentBanned          = errors.New("banned users cannot comment in a voxsphere")
+)
+
+type CommentService interface {
+	CommentTree(ctx context.Context, postID, parentID uuid.UUID, depth, limit int) (models.CommentTree, error)
+	CommentsByAuthorName(ctx context.Context, name string, skip, limit int) ([]models.UserComment, error)
+	CreateComment(ctx context.Context, postID, authorID uuid.UUID, submission models.CommentSubmission) (models.Comment, error)
+	EditComment(ctx context.Context, ID, userID uuid.UUID, body string) (models.Comment, error)
+	DeleteComment(ctx context.Context, ID, userID uuid.UUID) error
+}
+
+//counterfeiter:generate . CommentRepository
+type CommentRepository interface {
+	CommentByID(ctx context.Context, ID uuid.UUID) (models.Comment, error)
+	CommentTree(ctx context.Context, postID, parentID uuid.UUID, depth, limit int) ([]models.CommentNode, error)
+	CommentsByAuthorName(ctx context.Context, name string, skip, limit int) ([]models.UserComment, error)
+	AddComment(ctx context.Context, comment models.Comment, verdict *models.AutomodVerdict) (models.Comment, error)
+	EditComment(ctx context.Context, ID uuid.UUID, body, bodyHtml string, verdict *models.AutomodVerdict) (models.Comment, error)
+	SoftDeleteComment(ctx context.Context, ID uuid.UUID) error
+}
+
+//counterfeiter:generate . RestrictionRepository
+type RestrictionRepository interface {
+	PostRestrictions(ctx context.Context, postID, userID uuid.UUID) (models.PostRestrictions, error)
+}
+
+//counterfeiter:generate . Screener
+type Screener interface {
+	JudgeComment(ctx context.Context, voxsphereID uuid.UUID, comment models.Comment) (*models.AutomodVerdict, error)
+}
+
+//counterfeiter:generate . CustomEmojiRepository
+type CustomEmojiRepository interface {
+	VoxsphereCustomEmojis(ctx context.Context, voxsphereID uuid.UUID) ([]models.CustomEmoji, error)
+}
+
+type Service struct {
+	repo            CommentRepository
+	restrictionRepo RestrictionRepository
+	screener        Screener
+	emojiRepo       CustomEmojiRepository
+}
+
+func NewService(repo CommentRepository, restrictionRepo RestrictionRepository, screener Screener, emojiRepo CustomEmojiRepository) *Service {
+	return &Service{
+		repo:            repo,
+		restrictionRepo: restrictionRepo,
+		screener:        screener,
+		emojiRepo:       emojiRepo,
+	}
+}
+
+func (s *Service) CommentsByAuthorName(ctx context.Context, name string, skip, limit int) ([]models.UserComment, error) {
+	return s.repo.CommentsByAuthorName(ctx, name, skip, limit)
+}
+
+// CommentTree returns the replies of parentID nested up to depth levels, or the
+// top level comments of the post when parentID is uuid.Nil. Every level holds at
+// most limit replies; replies cut off by either bound are collapsed into a
+// "load more" stub on their parent. Both bounds are applied by the repository
+// as it walks the tree, so that comments past them are never loaded.
+func (s *Service) CommentTree(ctx context.Context, postID, parentID uuid.UUID, depth, limit int) (models.CommentTree, error) {
+	depth = clamp(depth, DefaultDepth, MaxDepth)
+	limit = clamp(limit, DefaultLimit, MaxLimit)
+
+	nodes, err := s.repo.CommentTree(ctx, postID, parentID, depth, limit)
+	if err != nil {
+		return models.CommentTree{}, err
+	}
+
+	// the top level of the walk hangs from its root, whatever the parent
+	// comment of its comments is
+	children := make(map[uuid.UUID][]models.CommentNode)
+	for _, node := range nodes {
+		parent := node.ParentCommentID
+		if node.Depth == 0 {
+			parent = parentID
+		}
+		children[parent] = append(children[parent], node)
+	}
+
+	b := treeBuilder{children: children}
+	replies, more := b.replies(parentID, 0)
+
+	return models.CommentTree{
+		PostID:   postID,
+		Comments: replies,
+		More:     more,
+	}, nil
+}
+
+// CreateComment adds the comment submitted by the user of authorID to the post
+// of postID, as a reply when the submission has a parent comment. Locked posts
+// take no comments, and users banned from the voxsphere of the post cannot
+// comment on it. The new comment is judged by the automod rules of the
+// voxsphere before it is stored, along with the verdict on it.
+func (s *Service) CreateComment(ctx context.Context, postID, authorID uuid.UUID, submission models.CommentSubmission) (models.Comment, error) {
+	body, err := validBody(submission.Body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	restrictions, err := s.restrictionRepo.PostRestrictions(ctx, postID, authorID)
+	if err != nil {
+		if errors.Is(err, moderationrepo.ErrModPostNotFound) {
+			return models.Comment{}, commentsrepo.ErrCommentPostNotFound
+		}
+		return models.Comment{}, err
+	}
+	if restrictions.Banned {
+		return models.Comment{}, ErrCommentBanned
+	}
+	if restrictions.Locked {
+		return models.Comment{}, ErrCommentPostLocked
+	}
+
+	if submission.ParentCommentID != uuid.Nil {
+		parent, err := s.repo.CommentByID(ctx, submission.ParentCommentID)
+		if err != nil && !errors.Is(err, commentsrepo.ErrCommentNotFound) {
+			return models.Comment{}, err
+		}
+		if err != nil || parent.PostID != postID {
+			return models.Comment{}, ErrCommentParentNotInPost
+		}
+	}
+
+	bodyHtml, err := s.renderBody(ctx, restrictions.VoxsphereID, body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comment := models.Comment{
+		ID:              uuid.New(),
+		AuthorID:        authorID,
+		ParentCommentID: submission.ParentCommentID,
+		PostID:          postID,
+		Body:            body,
+		BodyHtml:        bodyHtml,
+	}
+	verdict, err := s.screener.JudgeComment(ctx, restrictions.VoxsphereID, comment)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err = s.repo.AddComment(ctx, comment, verdict)
+	if err != nil {
+		// the parent comment, when there is one, was found in the post, so
+		// a missing parent record is the post itself
+		if errors.Is(err, commentsrepo.ErrCommentParentTableRecordNotFound) {
+			return models.Comment{}, commentsrepo.ErrCommentPostNotFound
+		}
+		return models.Comment{}, err
+	}
+	return comment, nil
+}
+
+// EditComment replaces the body of the comment of ID on behalf of the user of
+// userID, who has to be its author. The new body is judged by the automod
+// rules of the voxsphere like that of a new comment.
+func (s *Service) EditComment(ctx context.Context, ID, userID uuid.UUID, body string) (models.Comment, error) {
+	body, err := validBody(body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err := s.authoredComment(ctx, ID, userID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	restrictions, err := s.restrictionRepo.PostRestrictions(ctx, comment.PostID, userID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+	bodyHtml, err := s.renderBody(ctx, restrictions.VoxsphereID, body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comment.Body, comment.BodyHtml = body, bodyHtml
+	verdict, err := s.screener.JudgeComment(ctx, restrictions.VoxsphereID, comment)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	return s.repo.EditComment(ctx, ID, body, bodyHtml, verdict)
+}
+
+// DeleteComment soft deletes the comment of ID on behalf of the user of
+// userID, who has to be its author. The replies of the comment are kept.
+func (s *Service) DeleteComment(ctx context.Context, ID, userID uuid.UUID) error {
+	if _, err := s.authoredComment(ctx, ID, userID); err != nil {
+		return err
+	}
+
+	return s.repo.SoftDeleteComment(ctx, ID)
+}
+
+// authoredComment returns the comment of ID when the user of userID is its
+// author.
+func (s *Service) authoredComment(ctx context.Context, ID, userID uuid.UUID) (models.Comment, error) {
+	comment, err := s.repo.CommentByID(ctx, ID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+	if comment.AuthorID != userID {
+		return models.Comment{}, ErrCommentNotAuthor
+	}
+	return comment, nil
+}
+
+// renderBody renders the markdown body of a comment in the voxsphere of
+// voxsphereID, showing the custom emojis of the voxsphere.
+func (s *Service) renderBody(ctx context.Context, voxsphereID uuid.UUID, body string) (string, error) {
+	emojis, err := s.emojiRepo.VoxsphereCustomEmojis(ctx, voxsphereID)
+	if err != nil {
+		return "", err
+	}
+	return render.CustomEmojis(render.Markdown(body), emojis), nil
+}
+
+func validBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if len(body) == 0 || utf8.RuneCountInString(body) > maxBodyLength {
+		return "", ErrCommentInvalidBody
+	}
+	return body, nil
+}
+
+type treeBuilder struct {
+	children map[uuid.UUID][]models.CommentNode
+}
+
+// replies builds the threads of the shown replies to parentID, collapsing the
+// unshown ones into a stub counting them and their descendants.
+func (b treeBuilder) replies(parentID uuid.UUID, depth int) ([]models.CommentThread, *models.CommentMore) {
+	threads := []models.CommentThread{}
+	var more *models.CommentMore
+	for _, node := range b.children[parentID] {
+		if !node.Shown {
+			if more == nil {
+				more = &models.CommentMore{
+					ParentCommentID: parentID,
+					Depth:           int32(depth),
+					Children:        []uuid.UUID{},
+				}
+			}
+			more.Children = append(more.Children, node.ID)
+			more.Count += 1 + node.Descendants
+			continue
+		}
+
+		replies, repliesMore := b.replies(node.ID, depth+1)
+		threads = append(threads, models.CommentThread{
+			ID:              node.ID,
+			Author:          node.Author,
+			AuthorID:        node.AuthorID,
+			AuthorFlair:     node.AuthorFlair,
+			ParentCommentID: node.ParentCommentID,
+			PostID:          node.PostID,
+			Body:            node.Body,
+			BodyHtml:        node.BodyHtml,
+			Ups:             node.Ups,
+			Score:           node.Score,
+			Depth:           int32(depth),
+			Replies:         replies,
+			More:            repliesMore,
+			CreatedAt:       node.CreatedAt,
+			CreatedAtUnix:   node.CreatedAtUnix,
+			UpdatedAt:       node.UpdatedAt,
+		})
+	}
+	return threads, more
+}
+
+func clamp(value, fallback, max int) int {
+	if value <= 0 {
+		return fallback
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
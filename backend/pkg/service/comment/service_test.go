@@ -0,0 +1,710 @@
+package comment_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	commentsrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/comments"
+	moderationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/moderation"
+	commentservice "github.com/glowfi/voxpopuli/backend/pkg/service/comment"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/comment/commentfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func commentOf(ID, parentID uuid.UUID, author string) models.CommentAuthor {
+	return models.CommentAuthor{
+		Comment: models.Comment{
+			ID:              ID,
+			AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ParentCommentID: parentID,
+			PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Body:            "body of " + ID.String(),
+			BodyHtml:        "<p>body of " + ID.String() + "</p>",
+			Ups:             1,
+			Score:           1,
+			CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+			CreatedAtUnix:   1725091100,
+			UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+		},
+		Author: author,
+	}
+}
+
+func threadOf(comment models.CommentAuthor, depth int32, replies []models.CommentThread, more *models.CommentMore) models.CommentThread {
+	return models.CommentThread{
+		ID:              comment.ID,
+		Author:          comment.Author,
+		AuthorID:        comment.AuthorID,
+		AuthorFlair:     comment.AuthorFlair,
+		ParentCommentID: comment.ParentCommentID,
+		PostID:          comment.PostID,
+		Body:            comment.Body,
+		BodyHtml:        comment.BodyHtml,
+		Ups:             comment.Ups,
+		Score:           comment.Score,
+		Depth:           depth,
+		Replies:         replies,
+		More:            more,
+		CreatedAt:       comment.CreatedAt,
+		CreatedAtUnix:   comment.CreatedAtUnix,
+		UpdatedAt:       comment.UpdatedAt,
+	}
+}
+
+func nodeOf(comment models.CommentAuthor, depth int32) models.CommentNode {
+	return models.CommentNode{
+		CommentAuthor: comment,
+		Depth:         depth,
+		Shown:         true,
+	}
+}
+
+func hiddenNodeOf(ID, parentID uuid.UUID, depth, descendants int32) models.CommentNode {
+	return models.CommentNode{
+		CommentAuthor: models.CommentAuthor{
+			Comment: models.Comment{
+				ID:              ID,
+				ParentCommentID: parentID,
+			},
+		},
+		Depth:       depth,
+		Descendants: descendants,
+	}
+}
+
+func TestService_CommentTree(t *testing.T) {
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	id1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	id2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	id3 := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	id4 := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	id5 := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+	id6 := uuid.MustParse("00000000-0000-0000-0000-000000000006")
+	id9 := uuid.MustParse("00000000-0000-0000-0000-000000000009")
+
+	// 1
+	// ├── 2
+	// │   └── 3
+	// └── 5
+	// 4
+	// 6 (parent 9 is missing)
+	c1 := commentOf(id1, uuid.Nil, "John Doe")
+	c2 := commentOf(id2, id1, "Jane Doe")
+	c3 := commentOf(id3, id2, "Jake Doe")
+	c4 := commentOf(id4, uuid.Nil, "Jane Doe")
+	c5 := commentOf(id5, id1, "John Doe")
+	c6 := commentOf(id6, id9, "Jake Doe")
+	c1.AuthorFlair = &models.UserFlairRendered{
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Voxsphere:   "v/foo",
+		FullText:    "regular",
+		Richtext:    []models.FlairRichtext{{Type: models.FlairRichtextTypeText, Text: "regular"}},
+	}
+
+	type args struct {
+		parentID uuid.UUID
+		depth    int
+		limit    int
+	}
+	type mockReturns struct {
+		nodes     []models.CommentNode
+		nodeError error
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		mockReturns mockReturns
+		wantDepth   int
+		wantLimit   int
+		wantTree    models.CommentTree
+		wantErr     error
+	}{
+		{
+			name: "post not found :NEG",
+			mockReturns: mockReturns{
+				nodes:     []models.CommentNode{},
+				nodeError: commentsrepo.ErrCommentPostNotFound,
+			},
+			wantDepth: commentservice.DefaultDepth,
+			wantLimit: commentservice.DefaultLimit,
+			wantTree:  models.CommentTree{},
+			wantErr:   commentsrepo.ErrCommentPostNotFound,
+		},
+		{
+			name: "parent comment not in post :NEG",
+			args: args{
+				parentID: id9,
+			},
+			mockReturns: mockReturns{
+				nodes:     []models.CommentNode{},
+				nodeError: commentsrepo.ErrCommentNotFound,
+			},
+			wantDepth: commentservice.DefaultDepth,
+			wantLimit: commentservice.DefaultLimit,
+			wantTree:  models.CommentTree{},
+			wantErr:   commentsrepo.ErrCommentNotFound,
+		},
+		{
+			name: "post without comments :POS",
+			mockReturns: mockReturns{
+				nodes: []models.CommentNode{},
+			},
+			wantDepth: commentservice.DefaultDepth,
+			wantLimit: commentservice.DefaultLimit,
+			wantTree: models.CommentTree{
+				PostID:   postID,
+				Comments: []models.CommentThread{},
+			},
+		},
+		{
+			name: "full tree with default bounds :POS",
+			mockReturns: mockReturns{
+				nodes: []models.CommentNode{
+					nodeOf(c1, 0),
+					nodeOf(c4, 0),
+					nodeOf(c6, 0),
+					nodeOf(c2, 1),
+					nodeOf(c5, 1),
+					nodeOf(c3, 2),
+				},
+			},
+			wantDepth: commentservice.DefaultDepth,
+			wantLimit: commentservice.DefaultLimit,
+			wantTree: models.CommentTree{
+				PostID: postID,
+				Comments: []models.CommentThread{
+					threadOf(c1, 0, []models.CommentThread{
+						threadOf(c2, 1, []models.CommentThread{
+							threadOf(c3, 2, []models.CommentThread{}, nil),
+						}, nil),
+						threadOf(c5, 1, []models.CommentThread{}, nil),
+					}, nil),
+					threadOf(c4, 0, []models.CommentThread{}, nil),
+					threadOf(c6, 0, []models.CommentThread{}, nil),
+				},
+			},
+		},
+		{
+			name: "tree truncated by depth :POS",
+			args: args{
+				depth: 1,
+			},
+			mockReturns: mockReturns{
+				nodes: []models.CommentNode{
+					nodeOf(c1, 0),
+					nodeOf(c4, 0),
+					nodeOf(c6, 0),
+					hiddenNodeOf(id2, id1, 1, 1),
+					hiddenNodeOf(id5, id1, 1, 0),
+				},
+			},
+			wantDepth: 1,
+			wantLimit: commentservice.DefaultLimit,
+			wantTree: models.CommentTree{
+				PostID: postID,
+				Comments: []models.CommentThread{
+					threadOf(c1, 0, []models.CommentThread{}, &models.CommentMore{
+						ParentCommentID: id1,
+						Depth:           1,
+						Count:           3,
+						Children:        []uuid.UUID{id2, id5},
+					}),
+					threadOf(c4, 0, []models.CommentThread{}, nil),
+					threadOf(c6, 0, []models.CommentThread{}, nil),
+				},
+			},
+		},
+		{
+			name: "tree truncated by limit :POS",
+			args: args{
+				limit: 1,
+			},
+			mockReturns: mockReturns{
+				nodes: []models.CommentNode{
+					nodeOf(c1, 0),
+					hiddenNodeOf(id4, uuid.Nil, 0, 0),
+					hiddenNodeOf(id6, id9, 0, 0),
+					nodeOf(c2, 1),
+					hiddenNodeOf(id5, id1, 1, 0),
+					nodeOf(c3, 2),
+				},
+			},
+			wantDepth: commentservice.DefaultDepth,
+			wantLimit: 1,
+			wantTree: models.CommentTree{
+				PostID: postID,
+				Comments: []models.CommentThread{
+					threadOf(c1, 0, []models.CommentThread{
+						threadOf(c2, 1, []models.CommentThread{
+							threadOf(c3, 2, []models.CommentThread{}, nil),
+						}, nil),
+					}, &models.CommentMore{
+						ParentCommentID: id1,
+						Depth:           1,
+						Count:           1,
+						Children:        []uuid.UUID{id5},
+					}),
+				},
+				More: &models.CommentMore{
+					ParentCommentID: uuid.Nil,
+					Depth:           0,
+					Count:           2,
+					Children:        []uuid.UUID{id4, id6},
+				},
+			},
+		},
+		{
+			name: "bounds above the maximum :POS",
+			args: args{
+				depth: commentservice.MaxDepth + 1,
+				limit: commentservice.MaxLimit + 1,
+			},
+			mockReturns: mockReturns{
+				nodes: []models.CommentNode{},
+			},
+			wantDepth: commentservice.MaxDepth,
+			wantLimit: commentservice.MaxLimit,
+			wantTree: models.CommentTree{
+				PostID:   postID,
+				Comments: []models.CommentThread{},
+			},
+		},
+		{
+			name: "subtree of a parent comment :POS",
+			args: args{
+				parentID: id2,
+			},
+			mockReturns: mockReturns{
+				nodes: []models.CommentNode{
+					nodeOf(c3, 0),
+				},
+			},
+			wantDepth: commentservice.DefaultDepth,
+			wantLimit: commentservice.DefaultLimit,
+			wantTree: models.CommentTree{
+				PostID: postID,
+				Comments: []models.CommentThread{
+					threadOf(c3, 0, []models.CommentThread{}, nil),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentRepo := commentfakes.FakeCommentRepository{}
+			fakeCommentRepo.CommentTreeReturns(tt.mockReturns.nodes, tt.mockReturns.nodeError)
+
+			commentService := commentservice.NewService(&fakeCommentRepo, &commentfakes.FakeRestrictionRepository{}, &commentfakes.FakeScreener{}, &commentfakes.FakeCustomEmojiRepository{})
+
+			gotTree, gotErr := commentService.CommentTree(context.Background(), postID, tt.args.parentID, tt.args.depth, tt.args.limit)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantTree, gotTree, "expect comment tree to match")
+
+			_, gotPostID, gotParentID, gotDepth, gotLimit := fakeCommentRepo.CommentTreeArgsForCall(0)
+			assert.Equal(t, postID, gotPostID, "expect post id to match")
+			assert.Equal(t, tt.args.parentID, gotParentID, "expect parent id to match")
+			assert.Equal(t, tt.wantDepth, gotDepth, "expect depth to match")
+			assert.Equal(t, tt.wantLimit, gotLimit, "expect limit to match")
+		})
+	}
+}
+
+func TestService_CommentsByAuthorName(t *testing.T) {
+	comments := []models.UserComment{
+		{
+			CommentAuthor: commentOf(uuid.MustParse("00000000-0000-0000-0000-000000000001"), uuid.Nil, "John Doe"),
+			PostTitle:     "Example Post Title 1",
+			VoxsphereID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Voxsphere:     "v/foo",
+		},
+	}
+
+	type args struct {
+		name  string
+		skip  int
+		limit int
+	}
+	type mockReturns struct {
+		comments     []models.UserComment
+		commentError error
+	}
+
+	tests := []struct {
+		name         string
+		args         args
+		mockReturns  mockReturns
+		wantComments []models.UserComment
+		wantErr      error
+	}{
+		{
+			name: "author not found :NEG",
+			args: args{
+				name:  "Nobody",
+				skip:  0,
+				limit: 10,
+			},
+			mockReturns: mockReturns{
+				comments:     []models.UserComment{},
+				commentError: commentsrepo.ErrCommentAuthorNotFound,
+			},
+			wantComments: []models.UserComment{},
+			wantErr:      commentsrepo.ErrCommentAuthorNotFound,
+		},
+		{
+			name: "comments of author :POS",
+			args: args{
+				name:  "John Doe",
+				skip:  0,
+				limit: 10,
+			},
+			mockReturns: mockReturns{
+				comments: comments,
+			},
+			wantComments: comments,
+			wantErr:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentRepo := commentfakes.FakeCommentRepository{}
+			fakeCommentRepo.CommentsByAuthorNameReturns(tt.mockReturns.comments, tt.mockReturns.commentError)
+
+			commentService := commentservice.NewService(&fakeCommentRepo, &commentfakes.FakeRestrictionRepository{}, &commentfakes.FakeScreener{}, &commentfakes.FakeCustomEmojiRepository{})
+
+			gotComments, gotErr := commentService.CommentsByAuthorName(context.Background(), tt.args.name, tt.args.skip, tt.args.limit)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantComments, gotComments, "expect comments to match")
+
+			_, gotName, gotSkip, gotLimit := fakeCommentRepo.CommentsByAuthorNameArgsForCall(0)
+			assert.Equal(t, tt.args.name, gotName, "expect author name to match")
+			assert.Equal(t, tt.args.skip, gotSkip, "expect skip to match")
+			assert.Equal(t, tt.args.limit, gotLimit, "expect limit to match")
+		})
+	}
+}
+
+func TestService_CreateComment(t *testing.T) {
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	authorID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	voxsphereID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	errScreen := errors.New("screening failed")
+	parent := models.Comment{
+		ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		PostID: postID,
+	}
+
+	tests := []struct {
+		name         string
+		submission   models.CommentSubmission
+		restrictions models.PostRestrictions
+		restrictErr  error
+		parent       models.Comment
+		parentError  error
+		addError     error
+		verdict      *models.AutomodVerdict
+		screenErr    error
+		wantComment  models.Comment
+		wantErr      error
+		wantAddCalls int
+	}{
+		{
+			name:       "empty body :NEG",
+			submission: models.CommentSubmission{Body: "   "},
+			wantErr:    commentservice.ErrCommentInvalidBody,
+		},
+		{
+			name:       "body too long :NEG",
+			submission: models.CommentSubmission{Body: strings.Repeat("a", 10001)},
+			wantErr:    commentservice.ErrCommentInvalidBody,
+		},
+		{
+			name:        "post not found :NEG",
+			submission:  models.CommentSubmission{Body: "comment"},
+			restrictErr: moderationrepo.ErrModPostNotFound,
+			wantErr:     commentsrepo.ErrCommentPostNotFound,
+		},
+		{
+			name:         "locked post :NEG",
+			submission:   models.CommentSubmission{Body: "comment"},
+			restrictions: models.PostRestrictions{Locked: true},
+			wantErr:      commentservice.ErrCommentPostLocked,
+		},
+		{
+			name:         "banned author :NEG",
+			submission:   models.CommentSubmission{Body: "comment"},
+			restrictions: models.PostRestrictions{Banned: true},
+			wantErr:      commentservice.ErrCommentBanned,
+		},
+		{
+			name:        "parent not found :NEG",
+			submission:  models.CommentSubmission{ParentCommentID: parent.ID, Body: "reply"},
+			parentError: commentsrepo.ErrCommentNotFound,
+			wantErr:     commentservice.ErrCommentParentNotInPost,
+		},
+		{
+			name:       "parent of another post :NEG",
+			submission: models.CommentSubmission{ParentCommentID: parent.ID, Body: "reply"},
+			parent: models.Comment{
+				ID:     parent.ID,
+				PostID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			wantErr: commentservice.ErrCommentParentNotInPost,
+		},
+		{
+			name:         "post deleted meanwhile :NEG",
+			submission:   models.CommentSubmission{Body: "comment"},
+			addError:     commentsrepo.ErrCommentParentTableRecordNotFound,
+			wantErr:      commentsrepo.ErrCommentPostNotFound,
+			wantAddCalls: 1,
+		},
+		{
+			name:         "screening fails :NEG",
+			submission:   models.CommentSubmission{Body: "comment"},
+			screenErr:    errScreen,
+			wantErr:      errScreen,
+			wantAddCalls: 0,
+		},
+		{
+			name:       "comment added with verdict :POS",
+			submission: models.CommentSubmission{Body: "spam"},
+			verdict:    &models.AutomodVerdict{Remove: true, Details: "spam"},
+			wantComment: models.Comment{
+				AuthorID: authorID,
+				PostID:   postID,
+				Body:     "spam",
+				BodyHtml: "<p>spam</p>",
+			},
+			wantErr:      nil,
+			wantAddCalls: 1,
+		},
+		{
+			name:       "top level comment :POS",
+			submission: models.CommentSubmission{Body: " comment **bold** <script>alert(1)</script>"},
+			wantComment: models.Comment{
+				AuthorID: authorID,
+				PostID:   postID,
+				Body:     "comment **bold** <script>alert(1)</script>",
+				BodyHtml: "<p>comment <strong>bold</strong> alert(1)</p>",
+			},
+			wantErr:      nil,
+			wantAddCalls: 1,
+		},
+		{
+			name:       "comment with custom emojis :POS",
+			submission: models.CommentSubmission{Body: ":party: `:party:`"},
+			wantComment: models.Comment{
+				AuthorID: authorID,
+				PostID:   postID,
+				Body:     ":party: `:party:`",
+				BodyHtml: `<p><img class="custom-emoji" src="https://example.com/party.png" alt=":party:" title=":party:"> <code>:party:</code></p>`,
+			},
+			wantErr:      nil,
+			wantAddCalls: 1,
+		},
+		{
+			name:       "reply :POS",
+			submission: models.CommentSubmission{ParentCommentID: parent.ID, Body: "reply"},
+			parent:     parent,
+			wantComment: models.Comment{
+				AuthorID:        authorID,
+				ParentCommentID: parent.ID,
+				PostID:          postID,
+				Body:            "reply",
+				BodyHtml:        "<p>reply</p>",
+			},
+			wantErr:      nil,
+			wantAddCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentRepo := commentfakes.FakeCommentRepository{}
+			fakeCommentRepo.CommentByIDReturns(tt.parent, tt.parentError)
+			fakeCommentRepo.AddCommentStub = func(_ context.Context, comment models.Comment, _ *models.AutomodVerdict) (models.Comment, error) {
+				if tt.addError != nil {
+					return models.Comment{}, tt.addError
+				}
+				return comment, nil
+			}
+			fakeRestrictionRepo := commentfakes.FakeRestrictionRepository{}
+			tt.restrictions.VoxsphereID = voxsphereID
+			fakeRestrictionRepo.PostRestrictionsReturns(tt.restrictions, tt.restrictErr)
+			fakeScreener := commentfakes.FakeScreener{}
+			fakeScreener.JudgeCommentReturns(tt.verdict, tt.screenErr)
+			fakeEmojiRepo := commentfakes.FakeCustomEmojiRepository{}
+			fakeEmojiRepo.VoxsphereCustomEmojisReturns([]models.CustomEmoji{
+				{VoxsphereID: voxsphereID, Title: ":party:", Url: "https://example.com/party.png"},
+			}, nil)
+			commentService := commentservice.NewService(&fakeCommentRepo, &fakeRestrictionRepo, &fakeScreener, &fakeEmojiRepo)
+
+			gotComment, gotErr := commentService.CreateComment(context.Background(), postID, authorID, tt.submission)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantAddCalls, fakeCommentRepo.AddCommentCallCount(), "expect add comment call count to match")
+
+			if tt.wantErr == nil {
+				_, gotVoxsphereID, gotJudgedComment := fakeScreener.JudgeCommentArgsForCall(0)
+				assert.Equal(t, voxsphereID, gotVoxsphereID, "expect the comment to be judged in the voxsphere of the post")
+				assert.Equal(t, gotComment, gotJudgedComment, "expect the new comment to be judged")
+
+				_, _, gotVerdict := fakeCommentRepo.AddCommentArgsForCall(0)
+				assert.Equal(t, tt.verdict, gotVerdict, "expect the verdict to be stored with the comment")
+
+				assert.NotEqual(t, uuid.Nil, gotComment.ID, "expect comment to get an id")
+				gotComment.ID = uuid.Nil
+			}
+			assert.Equal(t, tt.wantComment, gotComment, "expect comment to match")
+		})
+	}
+}
+
+func TestService_EditComment(t *testing.T) {
+	errScreen := errors.New("screening failed")
+	comment := models.Comment{
+		ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		AuthorID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Body:     "body",
+		BodyHtml: "<p>body</p>",
+	}
+
+	tests := []struct {
+		name         string
+		userID       uuid.UUID
+		body         string
+		commentError error
+		verdict      *models.AutomodVerdict
+		screenErr    error
+		wantBody     string
+		wantBodyHtml string
+		wantErr      error
+	}{
+		{
+			name:    "empty body :NEG",
+			userID:  comment.AuthorID,
+			body:    "",
+			wantErr: commentservice.ErrCommentInvalidBody,
+		},
+		{
+			name:         "comment not found :NEG",
+			userID:       comment.AuthorID,
+			body:         "new body",
+			commentError: commentsrepo.ErrCommentNotFound,
+			wantErr:      commentsrepo.ErrCommentNotFound,
+		},
+		{
+			name:    "not the author :NEG",
+			userID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			body:    "new body",
+			wantErr: commentservice.ErrCommentNotAuthor,
+		},
+		{
+			name:         "edit body :POS",
+			userID:       comment.AuthorID,
+			body:         "new body",
+			wantBody:     "new body",
+			wantBodyHtml: "<p>new body</p>",
+			wantErr:      nil,
+		},
+		{
+			name:         "edit judged :POS",
+			userID:       comment.AuthorID,
+			body:         "spam",
+			verdict:      &models.AutomodVerdict{Remove: true, Details: "spam"},
+			wantBody:     "spam",
+			wantBodyHtml: "<p>spam</p>",
+			wantErr:      nil,
+		},
+		{
+			name:      "screening fails :NEG",
+			userID:    comment.AuthorID,
+			body:      "new body",
+			screenErr: errScreen,
+			wantErr:   errScreen,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentRepo := commentfakes.FakeCommentRepository{}
+			fakeCommentRepo.CommentByIDReturns(comment, tt.commentError)
+			fakeCommentRepo.EditCommentStub = func(_ context.Context, ID uuid.UUID, body, bodyHtml string, _ *models.AutomodVerdict) (models.Comment, error) {
+				edited := comment
+				edited.Body = body
+				edited.BodyHtml = bodyHtml
+				return edited, nil
+			}
+			fakeScreener := commentfakes.FakeScreener{}
+			fakeScreener.JudgeCommentReturns(tt.verdict, tt.screenErr)
+			commentService := commentservice.NewService(&fakeCommentRepo, &commentfakes.FakeRestrictionRepository{}, &fakeScreener, &commentfakes.FakeCustomEmojiRepository{})
+
+			gotComment, gotErr := commentService.EditComment(context.Background(), comment.ID, tt.userID, tt.body)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, 0, fakeCommentRepo.EditCommentCallCount(), "expect comment not to be edited")
+				return
+			}
+			assert.Equal(t, tt.wantBody, gotComment.Body, "expect body to match")
+			assert.Equal(t, tt.wantBodyHtml, gotComment.BodyHtml, "expect body html to match")
+
+			_, _, gotJudgedComment := fakeScreener.JudgeCommentArgsForCall(0)
+			assert.Equal(t, tt.wantBody, gotJudgedComment.Body, "expect the edited body to be judged")
+			_, _, _, _, gotVerdict := fakeCommentRepo.EditCommentArgsForCall(0)
+			assert.Equal(t, tt.verdict, gotVerdict, "expect the verdict to be stored with the edit")
+		})
+	}
+}
+
+func TestService_DeleteComment(t *testing.T) {
+	comment := models.Comment{
+		ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		AuthorID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	}
+
+	tests := []struct {
+		name         string
+		userID       uuid.UUID
+		commentError error
+		wantErr      error
+	}{
+		{
+			name:         "comment not found :NEG",
+			userID:       comment.AuthorID,
+			commentError: commentsrepo.ErrCommentNotFound,
+			wantErr:      commentsrepo.ErrCommentNotFound,
+		},
+		{
+			name:    "not the author :NEG",
+			userID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantErr: commentservice.ErrCommentNotAuthor,
+		},
+		{
+			name:    "delete comment :POS",
+			userID:  comment.AuthorID,
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentRepo := commentfakes.FakeCommentRepository{}
+			fakeCommentRepo.CommentByIDReturns(comment, tt.commentError)
+			commentService := commentservice.NewService(&fakeCommentRepo, &commentfakes.FakeRestrictionRepository{}, &commentfakes.FakeScreener{}, &commentfakes.FakeCustomEmojiRepository{})
+
+			gotErr := commentService.DeleteComment(context.Background(), comment.ID, tt.userID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			wantDeleteCalls := 0
+			if tt.wantErr == nil {
+				wantDeleteCalls = 1
+			}
+			assert.Equal(t, wantDeleteCalls, fakeCommentRepo.SoftDeleteCommentCallCount(), "expect soft delete call count to match")
+		})
+	}
+}
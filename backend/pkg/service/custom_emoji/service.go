@@ -0,0 +1,168 @@
+package customemoji
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	customemojirepo "github.com/glowfi/voxpopuli/backend/pkg/repo/custom_emoji"
+	"github.com/google/uuid"
+)
+
+const maxUrlLength = 2048
+
+var (
+	ErrCustomEmojiNotModerator = errors.New("only moderators of a voxsphere can change its custom emojis")
+	ErrCustomEmojiInvalidTitle = errors.New("title must be 1 to 64 letters, digits, dashes, pluses or underscores")
+	ErrCustomEmojiInvalidUrl   = errors.New("url must be an http or https url")
+)
+
+// titlePattern matches the shortcodes render.CustomEmojis replaces.
+var titlePattern = regexp.MustCompile(`^[\w+-]{1,64}$`)
+
+type CustomEmojiService interface {
+	VoxsphereCustomEmojis(ctx context.Context, voxsphereID uuid.UUID) ([]models.CustomEmoji, error)
+	AddCustomEmoji(ctx context.Context, voxsphereID, moderatorID uuid.UUID, submission models.CustomEmojiSubmission) (models.CustomEmoji, error)
+	RenameCustomEmoji(ctx context.Context, voxsphereID, ID, moderatorID uuid.UUID, title string) (models.CustomEmoji, error)
+	DeleteCustomEmoji(ctx context.Context, voxsphereID, ID, moderatorID uuid.UUID) error
+}
+
+//counterfeiter:generate . CustomEmojiRepository
+type CustomEmojiRepository interface {
+	VoxsphereCustomEmojis(ctx context.Context, voxsphereID uuid.UUID) ([]models.CustomEmoji, error)
+	CustomEmojiByID(ctx context.Context, ID uuid.UUID) (models.CustomEmoji, error)
+	AddCustomEmojis(ctx context.Context, customEmojis ...models.CustomEmoji) ([]models.CustomEmoji, error)
+	UpdateCustomEmoji(ctx context.Context, customEmoji models.CustomEmoji) (models.CustomEmoji, error)
+	DeleteCustomEmoji(ctx context.Context, ID uuid.UUID) error
+}
+
+//counterfeiter:generate . ModeratorRepository
+type ModeratorRepository interface {
+	IsVoxsphereModerator(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	repo          CustomEmojiRepository
+	moderatorRepo ModeratorRepository
+}
+
+func NewService(repo CustomEmojiRepository, moderatorRepo ModeratorRepository) *Service {
+	return &Service{
+		repo:          repo,
+		moderatorRepo: moderatorRepo,
+	}
+}
+
+// VoxsphereCustomEmojis returns the custom emojis of the voxsphere of
+// voxsphereID.
+func (s *Service) VoxsphereCustomEmojis(ctx context.Context, voxsphereID uuid.UUID) ([]models.CustomEmoji, error) {
+	return s.repo.VoxsphereCustomEmojis(ctx, voxsphereID)
+}
+
+// AddCustomEmoji adds the submitted emoji to the voxsphere of voxsphereID on
+// behalf of the moderator of moderatorID.
+func (s *Service) AddCustomEmoji(ctx context.Context, voxsphereID, moderatorID uuid.UUID, submission models.CustomEmojiSubmission) (models.CustomEmoji, error) {
+	title, err := shortcode(submission.Title)
+	if err != nil {
+		return models.CustomEmoji{}, err
+	}
+	link := strings.TrimSpace(submission.Url)
+	if !isValidUrl(link) {
+		return models.CustomEmoji{}, ErrCustomEmojiInvalidUrl
+	}
+	if err := s.checkModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.CustomEmoji{}, err
+	}
+
+	customEmojis, err := s.repo.AddCustomEmojis(ctx, models.CustomEmoji{
+		ID:          uuid.New(),
+		VoxsphereID: voxsphereID,
+		Url:         link,
+		Title:       title,
+	})
+	if err != nil {
+		return models.CustomEmoji{}, err
+	}
+	return customEmojis[0], nil
+}
+
+// RenameCustomEmoji changes the title of the custom emoji of ID in the
+// voxsphere of voxsphereID on behalf of the moderator of moderatorID. Posts
+// and comments already rendered keep showing the emoji.
+func (s *Service) RenameCustomEmoji(ctx context.Context, voxsphereID, ID, moderatorID uuid.UUID, title string) (models.CustomEmoji, error) {
+	title, err := shortcode(title)
+	if err != nil {
+		return models.CustomEmoji{}, err
+	}
+
+	customEmoji, err := s.voxsphereCustomEmoji(ctx, voxsphereID, ID, moderatorID)
+	if err != nil {
+		return models.CustomEmoji{}, err
+	}
+
+	customEmoji.Title = title
+	return s.repo.UpdateCustomEmoji(ctx, customEmoji)
+}
+
+// DeleteCustomEmoji deletes the custom emoji of ID from the voxsphere of
+// voxsphereID on behalf of the moderator of moderatorID. Flairs showing the
+// emoji lose it.
+func (s *Service) DeleteCustomEmoji(ctx context.Context, voxsphereID, ID, moderatorID uuid.UUID) error {
+	if _, err := s.voxsphereCustomEmoji(ctx, voxsphereID, ID, moderatorID); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteCustomEmoji(ctx, ID)
+}
+
+// voxsphereCustomEmoji returns the custom emoji of ID when it belongs to the
+// voxsphere of voxsphereID and the user of moderatorID moderates it.
+func (s *Service) voxsphereCustomEmoji(ctx context.Context, voxsphereID, ID, moderatorID uuid.UUID) (models.CustomEmoji, error) {
+	if err := s.checkModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.CustomEmoji{}, err
+	}
+
+	customEmoji, err := s.repo.CustomEmojiByID(ctx, ID)
+	if err != nil {
+		return models.CustomEmoji{}, err
+	}
+	if customEmoji.VoxsphereID != voxsphereID {
+		return models.CustomEmoji{}, customemojirepo.ErrCustomEmojiNotFound
+	}
+	return customEmoji, nil
+}
+
+func (s *Service) checkModerator(ctx context.Context, voxsphereID, userID uuid.UUID) error {
+	isModerator, err := s.moderatorRepo.IsVoxsphereModerator(ctx, voxsphereID, userID)
+	if err != nil {
+		return err
+	}
+	if !isModerator {
+		return ErrCustomEmojiNotModerator
+	}
+	return nil
+}
+
+// shortcode returns title as the shortcode custom emojis are stored with,
+// between colons.
+func shortcode(title string) (string, error) {
+	title = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(title), ":"), ":")
+	if !titlePattern.MatchString(title) {
+		return "", ErrCustomEmojiInvalidTitle
+	}
+	return ":" + title + ":", nil
+}
+
+func isValidUrl(link string) bool {
+	if len(link) == 0 || len(link) > maxUrlLength {
+		return false
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && len(u.Host) != 0
+}
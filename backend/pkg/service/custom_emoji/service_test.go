@@ -0,0 +1,200 @@
+package customemoji_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	customemojirepo "github.com/glowfi/voxpopuli/backend/pkg/repo/custom_emoji"
+	customemojiservice "github.com/glowfi/voxpopuli/backend/pkg/service/custom_emoji"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/custom_emoji/custom_emojifakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	voxsphereID      = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherVoxsphereID = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	emojiID          = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	moderatorID      = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+)
+
+func TestService_AddCustomEmoji(t *testing.T) {
+	tests := []struct {
+		name        string
+		submission  models.CustomEmojiSubmission
+		isModerator bool
+		wantTitle   string
+		wantErr     error
+	}{
+		{
+			name:        "invalid title :NEG",
+			submission:  models.CustomEmojiSubmission{Title: "party time", Url: "https://example.com/party.png"},
+			isModerator: true,
+			wantErr:     customemojiservice.ErrCustomEmojiInvalidTitle,
+		},
+		{
+			name:        "invalid url :NEG",
+			submission:  models.CustomEmojiSubmission{Title: "party", Url: "javascript:alert(1)"},
+			isModerator: true,
+			wantErr:     customemojiservice.ErrCustomEmojiInvalidUrl,
+		},
+		{
+			name:        "not a moderator :NEG",
+			submission:  models.CustomEmojiSubmission{Title: "party", Url: "https://example.com/party.png"},
+			isModerator: false,
+			wantErr:     customemojiservice.ErrCustomEmojiNotModerator,
+		},
+		{
+			name:        "bare title :POS",
+			submission:  models.CustomEmojiSubmission{Title: "party", Url: "https://example.com/party.png"},
+			isModerator: true,
+			wantTitle:   ":party:",
+		},
+		{
+			name:        "shortcode title :POS",
+			submission:  models.CustomEmojiSubmission{Title: ":cat_jam:", Url: "https://example.com/cat.png"},
+			isModerator: true,
+			wantTitle:   ":cat_jam:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCustomEmojiRepo := custom_emojifakes.FakeCustomEmojiRepository{}
+			fakeCustomEmojiRepo.AddCustomEmojisStub = func(_ context.Context, customEmojis ...models.CustomEmoji) ([]models.CustomEmoji, error) {
+				return customEmojis, nil
+			}
+			fakeModeratorRepo := custom_emojifakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := customemojiservice.NewService(&fakeCustomEmojiRepo, &fakeModeratorRepo)
+
+			gotEmoji, gotErr := service.AddCustomEmoji(context.Background(), voxsphereID, moderatorID, tt.submission)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, 0, fakeCustomEmojiRepo.AddCustomEmojisCallCount(), "expect no emoji to be added")
+				return
+			}
+			assert.NotEqual(t, uuid.Nil, gotEmoji.ID, "expect emoji to get an id")
+			assert.Equal(t, voxsphereID, gotEmoji.VoxsphereID, "expect voxsphere to match")
+			assert.Equal(t, tt.wantTitle, gotEmoji.Title, "expect title to match")
+			assert.Equal(t, tt.submission.Url, gotEmoji.Url, "expect url to match")
+		})
+	}
+}
+
+func TestService_RenameCustomEmoji(t *testing.T) {
+	tests := []struct {
+		name           string
+		title          string
+		emojiVoxsphere uuid.UUID
+		isModerator    bool
+		wantErr        error
+		wantUpdateCall bool
+	}{
+		{
+			name:           "invalid title :NEG",
+			title:          "::",
+			emojiVoxsphere: voxsphereID,
+			isModerator:    true,
+			wantErr:        customemojiservice.ErrCustomEmojiInvalidTitle,
+		},
+		{
+			name:           "not a moderator :NEG",
+			title:          "dance",
+			emojiVoxsphere: voxsphereID,
+			isModerator:    false,
+			wantErr:        customemojiservice.ErrCustomEmojiNotModerator,
+		},
+		{
+			name:           "emoji of another voxsphere :NEG",
+			title:          "dance",
+			emojiVoxsphere: otherVoxsphereID,
+			isModerator:    true,
+			wantErr:        customemojirepo.ErrCustomEmojiNotFound,
+		},
+		{
+			name:           "rename :POS",
+			title:          "dance",
+			emojiVoxsphere: voxsphereID,
+			isModerator:    true,
+			wantUpdateCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCustomEmojiRepo := custom_emojifakes.FakeCustomEmojiRepository{}
+			fakeCustomEmojiRepo.CustomEmojiByIDReturns(models.CustomEmoji{
+				ID:          emojiID,
+				VoxsphereID: tt.emojiVoxsphere,
+				Url:         "https://example.com/party.png",
+				Title:       ":party:",
+			}, nil)
+			fakeModeratorRepo := custom_emojifakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := customemojiservice.NewService(&fakeCustomEmojiRepo, &fakeModeratorRepo)
+
+			_, gotErr := service.RenameCustomEmoji(context.Background(), voxsphereID, emojiID, moderatorID, tt.title)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if !tt.wantUpdateCall {
+				assert.Equal(t, 0, fakeCustomEmojiRepo.UpdateCustomEmojiCallCount(), "expect emoji to stay as is")
+				return
+			}
+			_, gotEmoji := fakeCustomEmojiRepo.UpdateCustomEmojiArgsForCall(0)
+			assert.Equal(t, models.CustomEmoji{
+				ID:          emojiID,
+				VoxsphereID: voxsphereID,
+				Url:         "https://example.com/party.png",
+				Title:       ":dance:",
+			}, gotEmoji, "expect only the title to change")
+		})
+	}
+}
+
+func TestService_DeleteCustomEmoji(t *testing.T) {
+	tests := []struct {
+		name           string
+		emojiVoxsphere uuid.UUID
+		isModerator    bool
+		wantErr        error
+		wantDeleteCall bool
+	}{
+		{
+			name:           "not a moderator :NEG",
+			emojiVoxsphere: voxsphereID,
+			isModerator:    false,
+			wantErr:        customemojiservice.ErrCustomEmojiNotModerator,
+		},
+		{
+			name:           "emoji of another voxsphere :NEG",
+			emojiVoxsphere: otherVoxsphereID,
+			isModerator:    true,
+			wantErr:        customemojirepo.ErrCustomEmojiNotFound,
+		},
+		{
+			name:           "delete :POS",
+			emojiVoxsphere: voxsphereID,
+			isModerator:    true,
+			wantDeleteCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCustomEmojiRepo := custom_emojifakes.FakeCustomEmojiRepository{}
+			fakeCustomEmojiRepo.CustomEmojiByIDReturns(models.CustomEmoji{ID: emojiID, VoxsphereID: tt.emojiVoxsphere}, nil)
+			fakeModeratorRepo := custom_emojifakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := customemojiservice.NewService(&fakeCustomEmojiRepo, &fakeModeratorRepo)
+
+			gotErr := service.DeleteCustomEmoji(context.Background(), voxsphereID, emojiID, moderatorID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			wantDeleteCalls := 0
+			if tt.wantDeleteCall {
+				wantDeleteCalls = 1
+			}
+			assert.Equal(t, wantDeleteCalls, fakeCustomEmojiRepo.DeleteCustomEmojiCallCount(), "expect delete call count to match")
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+const maxBanReasonLength = 1000
+
+var (
+	ErrModNotModerator      = errors.New("only moderators of a voxsphere can moderate it")
+	ErrModInvalidBanReason  = fmt.Errorf("ban reason must be at most %d characters long", maxBanReasonLength)
+	ErrModInvalidBanExpiry  = errors.New("ban expiry must be in the future")
+	ErrModCannotBanYourself = errors.New("moderators cannot ban themselves")
+)
+
+type ModerationService interface {
+	ModeratePost(ctx context.Context, postID, moderatorID uuid.UUID, action models.ModAction) (models.ModLog, error)
+	ModerateComment(ctx context.Context, commentID, moderatorID uuid.UUID, action models.ModAction) (models.ModLog, error)
+	BanUser(ctx context.Context, voxsphereID, userID, moderatorID uuid.UUID, submission models.BanSubmission) (models.VoxsphereBan, error)
+	UnbanUser(ctx context.Context, voxsphereID, userID, moderatorID uuid.UUID) error
+	ModLogs(ctx context.Context, voxsphereID, moderatorID uuid.UUID, skip, limit int) ([]models.ModLog, error)
+}
+
+//counterfeiter:generate . ModerationRepository
+type ModerationRepository interface {
+	IsVoxsphereModerator(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+	PostVoxsphereID(ctx context.Context, postID uuid.UUID) (uuid.UUID, error)
+	CommentVoxsphereID(ctx context.Context, commentID uuid.UUID) (uuid.UUID, error)
+	ModeratePost(ctx context.Context, modLog models.ModLog) (models.ModLog, error)
+	ModerateComment(ctx context.Context, modLog models.ModLog) (models.ModLog, error)
+	BanUser(ctx context.Context, ban models.VoxsphereBan, modLog models.ModLog) (models.VoxsphereBan, error)
+	UnbanUser(ctx context.Context, modLog models.ModLog) error
+	ModLogsByVoxsphereID(ctx context.Context, voxsphereID uuid.UUID, skip, limit int) ([]models.ModLog, error)
+}
+
+type Service struct {
+	repo ModerationRepository
+}
+
+func NewService(repo ModerationRepository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// ModeratePost takes action on the post of postID on behalf of the user of
+// moderatorID, who has to moderate the voxsphere of the post.
+func (s *Service) ModeratePost(ctx context.Context, postID, moderatorID uuid.UUID, action models.ModAction) (models.ModLog, error) {
+	voxsphereID, err := s.repo.PostVoxsphereID(ctx, postID)
+	if err != nil {
+		return models.ModLog{}, err
+	}
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.ModLog{}, err
+	}
+
+	return s.repo.ModeratePost(ctx, newModLog(voxsphereID, moderatorID, action, postID, ""))
+}
+
+// ModerateComment takes action on the comment of commentID on behalf of the
+// user of moderatorID, who has to moderate the voxsphere of the comment.
+func (s *Service) ModerateComment(ctx context.Context, commentID, moderatorID uuid.UUID, action models.ModAction) (models.ModLog, error) {
+	voxsphereID, err := s.repo.CommentVoxsphereID(ctx, commentID)
+	if err != nil {
+		return models.ModLog{}, err
+	}
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.ModLog{}, err
+	}
+
+	return s.repo.ModerateComment(ctx, newModLog(voxsphereID, moderatorID, action, commentID, ""))
+}
+
+// BanUser bans the user of userID from the voxsphere of voxsphereID on behalf
+// of the user of moderatorID, who has to moderate it. The reason of the ban is
+// kept in the mod log.
+func (s *Service) BanUser(ctx context.Context, voxsphereID, userID, moderatorID uuid.UUID, submission models.BanSubmission) (models.VoxsphereBan, error) {
+	if utf8.RuneCountInString(submission.Reason) > maxBanReasonLength {
+		return models.VoxsphereBan{}, ErrModInvalidBanReason
+	}
+	if submission.ExpiresAt != nil && !submission.ExpiresAt.After(time.Now()) {
+		return models.VoxsphereBan{}, ErrModInvalidBanExpiry
+	}
+	if userID == moderatorID {
+		return models.VoxsphereBan{}, ErrModCannotBanYourself
+	}
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.VoxsphereBan{}, err
+	}
+
+	ban := models.VoxsphereBan{
+		VoxsphereID: voxsphereID,
+		UserID:      userID,
+		Reason:      submission.Reason,
+		ExpiresAt:   submission.ExpiresAt,
+	}
+	return s.repo.BanUser(ctx, ban, newModLog(voxsphereID, moderatorID, models.ModActionBanUser, userID, submission.Reason))
+}
+
+// UnbanUser lifts the ban of the user of userID from the voxsphere of
+// voxsphereID on behalf of the user of moderatorID, who has to moderate it.
+func (s *Service) UnbanUser(ctx context.Context, voxsphereID, userID, moderatorID uuid.UUID) error {
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return err
+	}
+
+	return s.repo.UnbanUser(ctx, newModLog(voxsphereID, moderatorID, models.ModActionUnbanUser, userID, ""))
+}
+
+// ModLogs returns the mod log of the voxsphere of voxsphereID, newest first,
+// to the user of moderatorID, who has to moderate it.
+func (s *Service) ModLogs(ctx context.Context, voxsphereID, moderatorID uuid.UUID, skip, limit int) ([]models.ModLog, error) {
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return []models.ModLog{}, err
+	}
+
+	return s.repo.ModLogsByVoxsphereID(ctx, voxsphereID, skip, limit)
+}
+
+// requireModerator fails with ErrModNotModerator unless the user of userID
+// moderates the voxsphere of voxsphereID.
+func (s *Service) requireModerator(ctx context.Context, voxsphereID, userID uuid.UUID) error {
+	isModerator, err := s.repo.IsVoxsphereModerator(ctx, voxsphereID, userID)
+	if err != nil {
+		return err
+	}
+	if !isModerator {
+		return ErrModNotModerator
+	}
+	return nil
+}
+
+func newModLog(voxsphereID, moderatorID uuid.UUID, action models.ModAction, targetID uuid.UUID, details string) models.ModLog {
+	return models.ModLog{
+		ID:          uuid.New(),
+		VoxsphereID: voxsphereID,
+		ModeratorID: moderatorID,
+		Action:      action,
+		TargetID:    targetID,
+		Details:     details,
+	}
+}
@@ -0,0 +1,267 @@
+package moderation_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	moderationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/moderation"
+	moderationservice "github.com/glowfi/voxpopuli/backend/pkg/service/moderation"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/moderation/moderationfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	voxsphereID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	moderatorID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	userID      = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+)
+
+func TestService_ModeratePost(t *testing.T) {
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name              string
+		voxsphereError    error
+		isModerator       bool
+		wantErr           error
+		wantModerateCalls int
+	}{
+		{
+			name:           "post not found :NEG",
+			voxsphereError: moderationrepo.ErrModPostNotFound,
+			wantErr:        moderationrepo.ErrModPostNotFound,
+		},
+		{
+			name:        "not a moderator :NEG",
+			isModerator: false,
+			wantErr:     moderationservice.ErrModNotModerator,
+		},
+		{
+			name:              "moderator :POS",
+			isModerator:       true,
+			wantErr:           nil,
+			wantModerateCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationRepo := moderationfakes.FakeModerationRepository{}
+			fakeModerationRepo.PostVoxsphereIDReturns(voxsphereID, tt.voxsphereError)
+			fakeModerationRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			fakeModerationRepo.ModeratePostStub = func(_ context.Context, modLog models.ModLog) (models.ModLog, error) {
+				return modLog, nil
+			}
+			service := moderationservice.NewService(&fakeModerationRepo)
+
+			gotModLog, gotErr := service.ModeratePost(context.Background(), postID, moderatorID, models.ModActionLockPost)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantModerateCalls, fakeModerationRepo.ModeratePostCallCount(), "expect moderate call count to match")
+
+			if tt.wantErr == nil {
+				assert.NotEqual(t, uuid.Nil, gotModLog.ID, "expect mod log to get an id")
+				assert.Equal(t, voxsphereID, gotModLog.VoxsphereID, "expect voxsphere of the post")
+				assert.Equal(t, moderatorID, gotModLog.ModeratorID, "expect moderator to match")
+				assert.Equal(t, models.ModActionLockPost, gotModLog.Action, "expect action to match")
+				assert.Equal(t, postID, gotModLog.TargetID, "expect post to be the target")
+			}
+		})
+	}
+}
+
+func TestService_ModerateComment(t *testing.T) {
+	commentID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name              string
+		voxsphereError    error
+		isModerator       bool
+		wantErr           error
+		wantModerateCalls int
+	}{
+		{
+			name:           "comment not found :NEG",
+			voxsphereError: moderationrepo.ErrModCommentNotFound,
+			wantErr:        moderationrepo.ErrModCommentNotFound,
+		},
+		{
+			name:        "not a moderator :NEG",
+			isModerator: false,
+			wantErr:     moderationservice.ErrModNotModerator,
+		},
+		{
+			name:              "moderator :POS",
+			isModerator:       true,
+			wantErr:           nil,
+			wantModerateCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationRepo := moderationfakes.FakeModerationRepository{}
+			fakeModerationRepo.CommentVoxsphereIDReturns(voxsphereID, tt.voxsphereError)
+			fakeModerationRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := moderationservice.NewService(&fakeModerationRepo)
+
+			_, gotErr := service.ModerateComment(context.Background(), commentID, moderatorID, models.ModActionRemoveComment)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantModerateCalls, fakeModerationRepo.ModerateCommentCallCount(), "expect moderate call count to match")
+		})
+	}
+}
+
+func TestService_BanUser(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name         string
+		userID       uuid.UUID
+		submission   models.BanSubmission
+		isModerator  bool
+		wantErr      error
+		wantBanCalls int
+	}{
+		{
+			name:        "reason too long :NEG",
+			userID:      userID,
+			submission:  models.BanSubmission{Reason: strings.Repeat("a", 1001)},
+			isModerator: true,
+			wantErr:     moderationservice.ErrModInvalidBanReason,
+		},
+		{
+			name:        "expiry in the past :NEG",
+			userID:      userID,
+			submission:  models.BanSubmission{ExpiresAt: &past},
+			isModerator: true,
+			wantErr:     moderationservice.ErrModInvalidBanExpiry,
+		},
+		{
+			name:        "ban yourself :NEG",
+			userID:      moderatorID,
+			submission:  models.BanSubmission{Reason: "spam"},
+			isModerator: true,
+			wantErr:     moderationservice.ErrModCannotBanYourself,
+		},
+		{
+			name:        "not a moderator :NEG",
+			userID:      userID,
+			submission:  models.BanSubmission{Reason: "spam"},
+			isModerator: false,
+			wantErr:     moderationservice.ErrModNotModerator,
+		},
+		{
+			name:         "temporary ban :POS",
+			userID:       userID,
+			submission:   models.BanSubmission{Reason: "spam", ExpiresAt: &future},
+			isModerator:  true,
+			wantErr:      nil,
+			wantBanCalls: 1,
+		},
+		{
+			name:         "permanent ban :POS",
+			userID:       userID,
+			submission:   models.BanSubmission{Reason: "spam"},
+			isModerator:  true,
+			wantErr:      nil,
+			wantBanCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationRepo := moderationfakes.FakeModerationRepository{}
+			fakeModerationRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := moderationservice.NewService(&fakeModerationRepo)
+
+			_, gotErr := service.BanUser(context.Background(), voxsphereID, tt.userID, moderatorID, tt.submission)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantBanCalls, fakeModerationRepo.BanUserCallCount(), "expect ban call count to match")
+
+			if tt.wantBanCalls != 0 {
+				_, gotBan, gotModLog := fakeModerationRepo.BanUserArgsForCall(0)
+				assert.Equal(t, models.VoxsphereBan{
+					VoxsphereID: voxsphereID,
+					UserID:      tt.userID,
+					Reason:      tt.submission.Reason,
+					ExpiresAt:   tt.submission.ExpiresAt,
+				}, gotBan, "expect ban to match")
+				assert.Equal(t, models.ModActionBanUser, gotModLog.Action, "expect ban to be logged")
+				assert.Equal(t, tt.submission.Reason, gotModLog.Details, "expect reason to be logged")
+			}
+		})
+	}
+}
+
+func TestService_UnbanUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		isModerator    bool
+		wantErr        error
+		wantUnbanCalls int
+	}{
+		{
+			name:        "not a moderator :NEG",
+			isModerator: false,
+			wantErr:     moderationservice.ErrModNotModerator,
+		},
+		{
+			name:           "moderator :POS",
+			isModerator:    true,
+			wantErr:        nil,
+			wantUnbanCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationRepo := moderationfakes.FakeModerationRepository{}
+			fakeModerationRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := moderationservice.NewService(&fakeModerationRepo)
+
+			gotErr := service.UnbanUser(context.Background(), voxsphereID, userID, moderatorID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantUnbanCalls, fakeModerationRepo.UnbanUserCallCount(), "expect unban call count to match")
+
+			if tt.wantUnbanCalls != 0 {
+				_, gotModLog := fakeModerationRepo.UnbanUserArgsForCall(0)
+				assert.Equal(t, voxsphereID, gotModLog.VoxsphereID, "expect voxsphere to match")
+				assert.Equal(t, userID, gotModLog.TargetID, "expect user to be the target")
+				assert.Equal(t, models.ModActionUnbanUser, gotModLog.Action, "expect unban to be logged")
+			}
+		})
+	}
+}
+
+func TestService_ModLogs(t *testing.T) {
+	tests := []struct {
+		name         string
+		isModerator  bool
+		wantErr      error
+		wantLogCalls int
+	}{
+		{
+			name:        "not a moderator :NEG",
+			isModerator: false,
+			wantErr:     moderationservice.ErrModNotModerator,
+		},
+		{
+			name:         "moderator :POS",
+			isModerator:  true,
+			wantErr:      nil,
+			wantLogCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationRepo := moderationfakes.FakeModerationRepository{}
+			fakeModerationRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := moderationservice.NewService(&fakeModerationRepo)
+
+			_, gotErr := service.ModLogs(context.Background(), voxsphereID, moderatorID, 0, 10)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantLogCalls, fakeModerationRepo.ModLogsByVoxsphereIDCallCount(), "expect mod log call count to match")
+		})
+	}
+}
@@ -7,15 +7,110 @@ import (
 
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
 	"github.com/glowfi/voxpopuli/backend/pkg/service/post"
+	"github.com/google/uuid"
 )
 
 type FakePostRepository struct {
-	PostsPaginatedStub        func(context.Context, int, int) ([]models.PostPaginated, error)
+	AddPostWithMediaStub        func(context.Context, models.Post, models.PostMedia, *models.AutomodVerdict, ...models.Link) (models.Post, error)
+	addPostWithMediaMutex       sync.RWMutex
+	addPostWithMediaArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.Post
+		arg3 models.PostMedia
+		arg4 *models.AutomodVerdict
+		arg5 []models.Link
+	}
+	addPostWithMediaReturns struct {
+		result1 models.Post
+		result2 error
+	}
+	addPostWithMediaReturnsOnCall map[int]struct {
+		result1 models.Post
+		result2 error
+	}
+	DeletePostStub        func(context.Context, uuid.UUID) error
+	deletePostMutex       sync.RWMutex
+	deletePostArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	deletePostReturns struct {
+		result1 error
+	}
+	deletePostReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EditPostStub        func(context.Context, models.Post, *models.AutomodVerdict) (models.Post, error)
+	editPostMutex       sync.RWMutex
+	editPostArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.Post
+		arg3 *models.AutomodVerdict
+	}
+	editPostReturns struct {
+		result1 models.Post
+		result2 error
+	}
+	editPostReturnsOnCall map[int]struct {
+		result1 models.Post
+		result2 error
+	}
+	PostByIDStub        func(context.Context, uuid.UUID) (models.Post, error)
+	postByIDMutex       sync.RWMutex
+	postByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	postByIDReturns struct {
+		result1 models.Post
+		result2 error
+	}
+	postByIDReturnsOnCall map[int]struct {
+		result1 models.Post
+		result2 error
+	}
+	PostDetailByIDStub        func(context.Context, uuid.UUID, uuid.UUID) (models.PostDetail, error)
+	postDetailByIDMutex       sync.RWMutex
+	postDetailByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	postDetailByIDReturns struct {
+		result1 models.PostDetail
+		result2 error
+	}
+	postDetailByIDReturnsOnCall map[int]struct {
+		result1 models.PostDetail
+		result2 error
+	}
+	PostsAfterStub        func(context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, *models.PostCursor, int) (models.PostFeed, error)
+	postsAfterMutex       sync.RWMutex
+	postsAfterArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.PostSort
+		arg3 models.PostSortWindow
+		arg4 models.PostFilter
+		arg5 *models.PostCursor
+		arg6 int
+	}
+	postsAfterReturns struct {
+		result1 models.PostFeed
+		result2 error
+	}
+	postsAfterReturnsOnCall map[int]struct {
+		result1 models.PostFeed
+		result2 error
+	}
+	PostsPaginatedStub        func(context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, int, int) ([]models.PostPaginated, error)
 	postsPaginatedMutex       sync.RWMutex
 	postsPaginatedArgsForCall []struct {
 		arg1 context.Context
-		arg2 int
-		arg3 int
+		arg2 models.PostSort
+		arg3 models.PostSortWindow
+		arg4 models.PostFilter
+		arg5 int
+		arg6 int
 	}
 	postsPaginatedReturns struct {
 		result1 []models.PostPaginated
@@ -29,20 +124,419 @@ type FakePostRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakePostRepository) PostsPaginated(arg1 context.Context, arg2 int, arg3 int) ([]models.PostPaginated, error) {
+func (fake *FakePostRepository) AddPostWithMedia(arg1 context.Context, arg2 models.Post, arg3 models.PostMedia, arg4 *models.AutomodVerdict, arg5 ...models.Link) (models.Post, error) {
+	fake.addPostWithMediaMutex.Lock()
+	ret, specificReturn := fake.addPostWithMediaReturnsOnCall[len(fake.addPostWithMediaArgsForCall)]
+	fake.addPostWithMediaArgsForCall = append(fake.addPostWithMediaArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.Post
+		arg3 models.PostMedia
+		arg4 *models.AutomodVerdict
+		arg5 []models.Link
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.AddPostWithMediaStub
+	fakeReturns := fake.addPostWithMediaReturns
+	fake.recordInvocation("AddPostWithMedia", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.addPostWithMediaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostRepository) AddPostWithMediaCallCount() int {
+	fake.addPostWithMediaMutex.RLock()
+	defer fake.addPostWithMediaMutex.RUnlock()
+	return len(fake.addPostWithMediaArgsForCall)
+}
+
+func (fake *FakePostRepository) AddPostWithMediaCalls(stub func(context.Context, models.Post, models.PostMedia, *models.AutomodVerdict, ...models.Link) (models.Post, error)) {
+	fake.addPostWithMediaMutex.Lock()
+	defer fake.addPostWithMediaMutex.Unlock()
+	fake.AddPostWithMediaStub = stub
+}
+
+func (fake *FakePostRepository) AddPostWithMediaArgsForCall(i int) (context.Context, models.Post, models.PostMedia, *models.AutomodVerdict, []models.Link) {
+	fake.addPostWithMediaMutex.RLock()
+	defer fake.addPostWithMediaMutex.RUnlock()
+	argsForCall := fake.addPostWithMediaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePostRepository) AddPostWithMediaReturns(result1 models.Post, result2 error) {
+	fake.addPostWithMediaMutex.Lock()
+	defer fake.addPostWithMediaMutex.Unlock()
+	fake.AddPostWithMediaStub = nil
+	fake.addPostWithMediaReturns = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) AddPostWithMediaReturnsOnCall(i int, result1 models.Post, result2 error) {
+	fake.addPostWithMediaMutex.Lock()
+	defer fake.addPostWithMediaMutex.Unlock()
+	fake.AddPostWithMediaStub = nil
+	if fake.addPostWithMediaReturnsOnCall == nil {
+		fake.addPostWithMediaReturnsOnCall = make(map[int]struct {
+			result1 models.Post
+			result2 error
+		})
+	}
+	fake.addPostWithMediaReturnsOnCall[i] = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) DeletePost(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deletePostMutex.Lock()
+	ret, specificReturn := fake.deletePostReturnsOnCall[len(fake.deletePostArgsForCall)]
+	fake.deletePostArgsForCall = append(fake.deletePostArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeletePostStub
+	fakeReturns := fake.deletePostReturns
+	fake.recordInvocation("DeletePost", []interface{}{arg1, arg2})
+	fake.deletePostMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePostRepository) DeletePostCallCount() int {
+	fake.deletePostMutex.RLock()
+	defer fake.deletePostMutex.RUnlock()
+	return len(fake.deletePostArgsForCall)
+}
+
+func (fake *FakePostRepository) DeletePostCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deletePostMutex.Lock()
+	defer fake.deletePostMutex.Unlock()
+	fake.DeletePostStub = stub
+}
+
+func (fake *FakePostRepository) DeletePostArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deletePostMutex.RLock()
+	defer fake.deletePostMutex.RUnlock()
+	argsForCall := fake.deletePostArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePostRepository) DeletePostReturns(result1 error) {
+	fake.deletePostMutex.Lock()
+	defer fake.deletePostMutex.Unlock()
+	fake.DeletePostStub = nil
+	fake.deletePostReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePostRepository) DeletePostReturnsOnCall(i int, result1 error) {
+	fake.deletePostMutex.Lock()
+	defer fake.deletePostMutex.Unlock()
+	fake.DeletePostStub = nil
+	if fake.deletePostReturnsOnCall == nil {
+		fake.deletePostReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deletePostReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePostRepository) EditPost(arg1 context.Context, arg2 models.Post, arg3 *models.AutomodVerdict) (models.Post, error) {
+	fake.editPostMutex.Lock()
+	ret, specificReturn := fake.editPostReturnsOnCall[len(fake.editPostArgsForCall)]
+	fake.editPostArgsForCall = append(fake.editPostArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.Post
+		arg3 *models.AutomodVerdict
+	}{arg1, arg2, arg3})
+	stub := fake.EditPostStub
+	fakeReturns := fake.editPostReturns
+	fake.recordInvocation("EditPost", []interface{}{arg1, arg2, arg3})
+	fake.editPostMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostRepository) EditPostCallCount() int {
+	fake.editPostMutex.RLock()
+	defer fake.editPostMutex.RUnlock()
+	return len(fake.editPostArgsForCall)
+}
+
+func (fake *FakePostRepository) EditPostCalls(stub func(context.Context, models.Post, *models.AutomodVerdict) (models.Post, error)) {
+	fake.editPostMutex.Lock()
+	defer fake.editPostMutex.Unlock()
+	fake.EditPostStub = stub
+}
+
+func (fake *FakePostRepository) EditPostArgsForCall(i int) (context.Context, models.Post, *models.AutomodVerdict) {
+	fake.editPostMutex.RLock()
+	defer fake.editPostMutex.RUnlock()
+	argsForCall := fake.editPostArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostRepository) EditPostReturns(result1 models.Post, result2 error) {
+	fake.editPostMutex.Lock()
+	defer fake.editPostMutex.Unlock()
+	fake.EditPostStub = nil
+	fake.editPostReturns = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) EditPostReturnsOnCall(i int, result1 models.Post, result2 error) {
+	fake.editPostMutex.Lock()
+	defer fake.editPostMutex.Unlock()
+	fake.EditPostStub = nil
+	if fake.editPostReturnsOnCall == nil {
+		fake.editPostReturnsOnCall = make(map[int]struct {
+			result1 models.Post
+			result2 error
+		})
+	}
+	fake.editPostReturnsOnCall[i] = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) PostByID(arg1 context.Context, arg2 uuid.UUID) (models.Post, error) {
+	fake.postByIDMutex.Lock()
+	ret, specificReturn := fake.postByIDReturnsOnCall[len(fake.postByIDArgsForCall)]
+	fake.postByIDArgsForCall = append(fake.postByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.PostByIDStub
+	fakeReturns := fake.postByIDReturns
+	fake.recordInvocation("PostByID", []interface{}{arg1, arg2})
+	fake.postByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostRepository) PostByIDCallCount() int {
+	fake.postByIDMutex.RLock()
+	defer fake.postByIDMutex.RUnlock()
+	return len(fake.postByIDArgsForCall)
+}
+
+func (fake *FakePostRepository) PostByIDCalls(stub func(context.Context, uuid.UUID) (models.Post, error)) {
+	fake.postByIDMutex.Lock()
+	defer fake.postByIDMutex.Unlock()
+	fake.PostByIDStub = stub
+}
+
+func (fake *FakePostRepository) PostByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.postByIDMutex.RLock()
+	defer fake.postByIDMutex.RUnlock()
+	argsForCall := fake.postByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePostRepository) PostByIDReturns(result1 models.Post, result2 error) {
+	fake.postByIDMutex.Lock()
+	defer fake.postByIDMutex.Unlock()
+	fake.PostByIDStub = nil
+	fake.postByIDReturns = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) PostByIDReturnsOnCall(i int, result1 models.Post, result2 error) {
+	fake.postByIDMutex.Lock()
+	defer fake.postByIDMutex.Unlock()
+	fake.PostByIDStub = nil
+	if fake.postByIDReturnsOnCall == nil {
+		fake.postByIDReturnsOnCall = make(map[int]struct {
+			result1 models.Post
+			result2 error
+		})
+	}
+	fake.postByIDReturnsOnCall[i] = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) PostDetailByID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (models.PostDetail, error) {
+	fake.postDetailByIDMutex.Lock()
+	ret, specificReturn := fake.postDetailByIDReturnsOnCall[len(fake.postDetailByIDArgsForCall)]
+	fake.postDetailByIDArgsForCall = append(fake.postDetailByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.PostDetailByIDStub
+	fakeReturns := fake.postDetailByIDReturns
+	fake.recordInvocation("PostDetailByID", []interface{}{arg1, arg2, arg3})
+	fake.postDetailByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostRepository) PostDetailByIDCallCount() int {
+	fake.postDetailByIDMutex.RLock()
+	defer fake.postDetailByIDMutex.RUnlock()
+	return len(fake.postDetailByIDArgsForCall)
+}
+
+func (fake *FakePostRepository) PostDetailByIDCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (models.PostDetail, error)) {
+	fake.postDetailByIDMutex.Lock()
+	defer fake.postDetailByIDMutex.Unlock()
+	fake.PostDetailByIDStub = stub
+}
+
+func (fake *FakePostRepository) PostDetailByIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.postDetailByIDMutex.RLock()
+	defer fake.postDetailByIDMutex.RUnlock()
+	argsForCall := fake.postDetailByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostRepository) PostDetailByIDReturns(result1 models.PostDetail, result2 error) {
+	fake.postDetailByIDMutex.Lock()
+	defer fake.postDetailByIDMutex.Unlock()
+	fake.PostDetailByIDStub = nil
+	fake.postDetailByIDReturns = struct {
+		result1 models.PostDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) PostDetailByIDReturnsOnCall(i int, result1 models.PostDetail, result2 error) {
+	fake.postDetailByIDMutex.Lock()
+	defer fake.postDetailByIDMutex.Unlock()
+	fake.PostDetailByIDStub = nil
+	if fake.postDetailByIDReturnsOnCall == nil {
+		fake.postDetailByIDReturnsOnCall = make(map[int]struct {
+			result1 models.PostDetail
+			result2 error
+		})
+	}
+	fake.postDetailByIDReturnsOnCall[i] = struct {
+		result1 models.PostDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) PostsAfter(arg1 context.Context, arg2 models.PostSort, arg3 models.PostSortWindow, arg4 models.PostFilter, arg5 *models.PostCursor, arg6 int) (models.PostFeed, error) {
+	fake.postsAfterMutex.Lock()
+	ret, specificReturn := fake.postsAfterReturnsOnCall[len(fake.postsAfterArgsForCall)]
+	fake.postsAfterArgsForCall = append(fake.postsAfterArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.PostSort
+		arg3 models.PostSortWindow
+		arg4 models.PostFilter
+		arg5 *models.PostCursor
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.PostsAfterStub
+	fakeReturns := fake.postsAfterReturns
+	fake.recordInvocation("PostsAfter", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.postsAfterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostRepository) PostsAfterCallCount() int {
+	fake.postsAfterMutex.RLock()
+	defer fake.postsAfterMutex.RUnlock()
+	return len(fake.postsAfterArgsForCall)
+}
+
+func (fake *FakePostRepository) PostsAfterCalls(stub func(context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, *models.PostCursor, int) (models.PostFeed, error)) {
+	fake.postsAfterMutex.Lock()
+	defer fake.postsAfterMutex.Unlock()
+	fake.PostsAfterStub = stub
+}
+
+func (fake *FakePostRepository) PostsAfterArgsForCall(i int) (context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, *models.PostCursor, int) {
+	fake.postsAfterMutex.RLock()
+	defer fake.postsAfterMutex.RUnlock()
+	argsForCall := fake.postsAfterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakePostRepository) PostsAfterReturns(result1 models.PostFeed, result2 error) {
+	fake.postsAfterMutex.Lock()
+	defer fake.postsAfterMutex.Unlock()
+	fake.PostsAfterStub = nil
+	fake.postsAfterReturns = struct {
+		result1 models.PostFeed
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) PostsAfterReturnsOnCall(i int, result1 models.PostFeed, result2 error) {
+	fake.postsAfterMutex.Lock()
+	defer fake.postsAfterMutex.Unlock()
+	fake.PostsAfterStub = nil
+	if fake.postsAfterReturnsOnCall == nil {
+		fake.postsAfterReturnsOnCall = make(map[int]struct {
+			result1 models.PostFeed
+			result2 error
+		})
+	}
+	fake.postsAfterReturnsOnCall[i] = struct {
+		result1 models.PostFeed
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostRepository) PostsPaginated(arg1 context.Context, arg2 models.PostSort, arg3 models.PostSortWindow, arg4 models.PostFilter, arg5 int, arg6 int) ([]models.PostPaginated, error) {
 	fake.postsPaginatedMutex.Lock()
 	ret, specificReturn := fake.postsPaginatedReturnsOnCall[len(fake.postsPaginatedArgsForCall)]
 	fake.postsPaginatedArgsForCall = append(fake.postsPaginatedArgsForCall, struct {
 		arg1 context.Context
-		arg2 int
-		arg3 int
-	}{arg1, arg2, arg3})
+		arg2 models.PostSort
+		arg3 models.PostSortWindow
+		arg4 models.PostFilter
+		arg5 int
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.PostsPaginatedStub
 	fakeReturns := fake.postsPaginatedReturns
-	fake.recordInvocation("PostsPaginated", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("PostsPaginated", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.postsPaginatedMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -56,17 +550,17 @@ func (fake *FakePostRepository) PostsPaginatedCallCount() int {
 	return len(fake.postsPaginatedArgsForCall)
 }
 
-func (fake *FakePostRepository) PostsPaginatedCalls(stub func(context.Context, int, int) ([]models.PostPaginated, error)) {
+func (fake *FakePostRepository) PostsPaginatedCalls(stub func(context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, int, int) ([]models.PostPaginated, error)) {
 	fake.postsPaginatedMutex.Lock()
 	defer fake.postsPaginatedMutex.Unlock()
 	fake.PostsPaginatedStub = stub
 }
 
-func (fake *FakePostRepository) PostsPaginatedArgsForCall(i int) (context.Context, int, int) {
+func (fake *FakePostRepository) PostsPaginatedArgsForCall(i int) (context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, int, int) {
 	fake.postsPaginatedMutex.RLock()
 	defer fake.postsPaginatedMutex.RUnlock()
 	argsForCall := fake.postsPaginatedArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakePostRepository) PostsPaginatedReturns(result1 []models.PostPaginated, result2 error) {
@@ -98,6 +592,18 @@ func (fake *FakePostRepository) PostsPaginatedReturnsOnCall(i int, result1 []mod
 func (fake *FakePostRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addPostWithMediaMutex.RLock()
+	defer fake.addPostWithMediaMutex.RUnlock()
+	fake.deletePostMutex.RLock()
+	defer fake.deletePostMutex.RUnlock()
+	fake.editPostMutex.RLock()
+	defer fake.editPostMutex.RUnlock()
+	fake.postByIDMutex.RLock()
+	defer fake.postByIDMutex.RUnlock()
+	fake.postDetailByIDMutex.RLock()
+	defer fake.postDetailByIDMutex.RUnlock()
+	fake.postsAfterMutex.RLock()
+	defer fake.postsAfterMutex.RUnlock()
 	fake.postsPaginatedMutex.RLock()
 	defer fake.postsPaginatedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
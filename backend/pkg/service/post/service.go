@@ -2,29 +2,257 @@ package post
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/glowfi/voxpopuli/backend/internal/render"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	maxTitleLength = 300
+	maxTextLength  = 40000
+	maxLinkLength  = 2048
+)
+
+var (
+	ErrPostInvalidTitle = fmt.Errorf("title must be 1 to %d characters long", maxTitleLength)
+	ErrPostInvalidText  = fmt.Errorf("text must be at most %d characters long", maxTextLength)
+	ErrPostInvalidLink  = errors.New("link must be an http or https url")
+	ErrPostNotMember    = errors.New("only members of a voxsphere can post in it")
+	ErrPostBanned       = errors.New("banned users cannot post in a voxsphere")
+	ErrPostNotAuthor    = errors.New("only the author of a post can change it")
 )
 
 type PostService interface {
-	PostsPaginated(ctx context.Context, skip, limit int) ([]models.PostPaginated, error)
+	PostsPaginated(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, skip, limit int) ([]models.PostPaginated, error)
+	PostsAfter(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, after *models.PostCursor, limit int) (models.PostFeed, error)
+	PostDetailByID(ctx context.Context, ID, viewerID uuid.UUID) (models.PostDetail, error)
+	CreatePost(ctx context.Context, authorID uuid.UUID, submission models.PostSubmission) (models.Post, error)
+	EditPost(ctx context.Context, ID, userID uuid.UUID, edit models.PostEdit) (models.Post, error)
+	DeletePost(ctx context.Context, ID, userID uuid.UUID) error
 }
 
 //counterfeiter:generate . PostRepository
 type PostRepository interface {
-	PostsPaginated(ctx context.Context, skip, limit int) ([]models.PostPaginated, error)
+	PostsPaginated(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, skip, limit int) ([]models.PostPaginated, error)
+	PostsAfter(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, after *models.PostCursor, limit int) (models.PostFeed, error)
+	PostDetailByID(ctx context.Context, ID, viewerID uuid.UUID) (models.PostDetail, error)
+	PostByID(ctx context.Context, ID uuid.UUID) (models.Post, error)
+	AddPostWithMedia(ctx context.Context, post models.Post, postMedia models.PostMedia, verdict *models.AutomodVerdict, links ...models.Link) (models.Post, error)
+	EditPost(ctx context.Context, post models.Post, verdict *models.AutomodVerdict) (models.Post, error)
+	DeletePost(ctx context.Context, ID uuid.UUID) error
+}
+
+//counterfeiter:generate . MembershipRepository
+type MembershipRepository interface {
+	IsVoxsphereMember(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+}
+
+//counterfeiter:generate . BanRepository
+type BanRepository interface {
+	IsBannedFromVoxsphere(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+}
+
+//counterfeiter:generate . Screener
+type Screener interface {
+	JudgePost(ctx context.Context, post models.Post, links []string) (*models.AutomodVerdict, error)
+}
+
+//counterfeiter:generate . CustomEmojiRepository
+type CustomEmojiRepository interface {
+	VoxsphereCustomEmojis(ctx context.Context, voxsphereID uuid.UUID) ([]models.CustomEmoji, error)
 }
 
 type Service struct {
-	repo PostRepository
+	repo           PostRepository
+	membershipRepo MembershipRepository
+	banRepo        BanRepository
+	screener       Screener
+	emojiRepo      CustomEmojiRepository
 }
 
-func NewService(repo PostRepository) *Service {
+func NewService(repo PostRepository, membershipRepo MembershipRepository, banRepo BanRepository, screener Screener, emojiRepo CustomEmojiRepository) *Service {
 	return &Service{
-		repo: repo,
+		repo:           repo,
+		membershipRepo: membershipRepo,
+		banRepo:        banRepo,
+		screener:       screener,
+		emojiRepo:      emojiRepo,
+	}
+}
+
+func (s *Service) PostsPaginated(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, skip, limit int) ([]models.PostPaginated, error) {
+	return s.repo.PostsPaginated(ctx, sort, window, filter, skip, limit)
+}
+
+func (s *Service) PostsAfter(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, after *models.PostCursor, limit int) (models.PostFeed, error) {
+	return s.repo.PostsAfter(ctx, sort, window, filter, after, limit)
+}
+
+func (s *Service) PostDetailByID(ctx context.Context, ID, viewerID uuid.UUID) (models.PostDetail, error) {
+	return s.repo.PostDetailByID(ctx, ID, viewerID)
+}
+
+// CreatePost adds the post submitted by the user of authorID to the voxsphere
+// of the submission, which the user has to be a member of and not banned
+// from. The new post is judged by the automod rules of the voxsphere before it
+// is added, and added along with the verdict.
+func (s *Service) CreatePost(ctx context.Context, authorID uuid.UUID, submission models.PostSubmission) (models.Post, error) {
+	title := strings.TrimSpace(submission.Title)
+	if len(title) == 0 || utf8.RuneCountInString(title) > maxTitleLength {
+		return models.Post{}, ErrPostInvalidTitle
+	}
+	if utf8.RuneCountInString(submission.Text) > maxTextLength {
+		return models.Post{}, ErrPostInvalidText
+	}
+	link := strings.TrimSpace(submission.Link)
+	if len(link) != 0 && !isValidLink(link) {
+		return models.Post{}, ErrPostInvalidLink
+	}
+
+	isMember, err := s.membershipRepo.IsVoxsphereMember(ctx, submission.VoxsphereID, authorID)
+	if err != nil {
+		return models.Post{}, err
+	}
+	if !isMember {
+		return models.Post{}, ErrPostNotMember
+	}
+
+	isBanned, err := s.banRepo.IsBannedFromVoxsphere(ctx, submission.VoxsphereID, authorID)
+	if err != nil {
+		return models.Post{}, err
+	}
+	if isBanned {
+		return models.Post{}, ErrPostBanned
+	}
+
+	textHtml, err := s.renderText(ctx, submission.VoxsphereID, submission.Text)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	post := models.Post{
+		ID:          uuid.New(),
+		AuthorID:    authorID,
+		VoxsphereID: submission.VoxsphereID,
+		Title:       title,
+		Text:        submission.Text,
+		TextHtml:    textHtml,
+		Over18:      submission.Over18,
+		Spoiler:     submission.Spoiler,
 	}
+	postMedia := models.PostMedia{
+		ID:        uuid.New(),
+		PostID:    post.ID,
+		MediaType: models.MediaTypeText,
+	}
+	var links []models.Link
+	if len(link) != 0 {
+		postMedia.MediaType = models.MediaTypeLink
+		links = append(links, models.Link{
+			ID:      uuid.New(),
+			MediaID: postMedia.ID,
+			Link:    link,
+		})
+	}
+
+	var judgedLinks []string
+	if len(link) != 0 {
+		judgedLinks = append(judgedLinks, link)
+	}
+	verdict, err := s.screener.JudgePost(ctx, post, judgedLinks)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	return s.repo.AddPostWithMedia(ctx, post, postMedia, verdict, links...)
 }
 
-func (s *Service) PostsPaginated(ctx context.Context, skip, limit int) ([]models.PostPaginated, error) {
-	return s.repo.PostsPaginated(ctx, skip, limit)
+// EditPost applies edit to the post of ID on behalf of the user of userID,
+// who has to be its author. The edited post is judged by the automod rules of
+// the voxsphere like a new one.
+func (s *Service) EditPost(ctx context.Context, ID, userID uuid.UUID, edit models.PostEdit) (models.Post, error) {
+	if edit.Text != nil && utf8.RuneCountInString(*edit.Text) > maxTextLength {
+		return models.Post{}, ErrPostInvalidText
+	}
+
+	post, err := s.authoredPost(ctx, ID, userID)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	if edit.Text != nil {
+		textHtml, err := s.renderText(ctx, post.VoxsphereID, *edit.Text)
+		if err != nil {
+			return models.Post{}, err
+		}
+		post.Text = *edit.Text
+		post.TextHtml = textHtml
+	}
+	if edit.Over18 != nil {
+		post.Over18 = *edit.Over18
+	}
+	if edit.Spoiler != nil {
+		post.Spoiler = *edit.Spoiler
+	}
+
+	verdict, err := s.screener.JudgePost(ctx, post, nil)
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	return s.repo.EditPost(ctx, post, verdict)
+}
+
+// DeletePost deletes the post of ID on behalf of the user of userID, who has
+// to be its author.
+func (s *Service) DeletePost(ctx context.Context, ID, userID uuid.UUID) error {
+	if _, err := s.authoredPost(ctx, ID, userID); err != nil {
+		return err
+	}
+
+	return s.repo.DeletePost(ctx, ID)
+}
+
+// authoredPost returns the post of ID when the user of userID is its author.
+func (s *Service) authoredPost(ctx context.Context, ID, userID uuid.UUID) (models.Post, error) {
+	post, err := s.repo.PostByID(ctx, ID)
+	if err != nil {
+		return models.Post{}, err
+	}
+	if post.AuthorID != userID {
+		return models.Post{}, ErrPostNotAuthor
+	}
+	return post, nil
+}
+
+// renderText renders the markdown text of a post in the voxsphere of
+// voxsphereID, showing the custom emojis of the voxsphere.
+func (s *Service) renderText(ctx context.Context, voxsphereID uuid.UUID, text string) (string, error) {
+	textHtml := render.Markdown(text)
+	if len(textHtml) == 0 {
+		return "", nil
+	}
+
+	emojis, err := s.emojiRepo.VoxsphereCustomEmojis(ctx, voxsphereID)
+	if err != nil {
+		return "", err
+	}
+	return render.CustomEmojis(textHtml, emojis), nil
+}
+
+func isValidLink(link string) bool {
+	if len(link) > maxLinkLength {
+		return false
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && len(u.Host) != 0
 }
@@ -2,10 +2,13 @@ package post_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	postrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post"
 	postservice "github.com/glowfi/voxpopuli/backend/pkg/service/post"
 	"github.com/glowfi/voxpopuli/backend/pkg/service/post/postfakes"
 	"github.com/google/uuid"
@@ -14,8 +17,11 @@ import (
 
 func TestService_PostsPaginated(t *testing.T) {
 	type args struct {
-		skip  int
-		limit int
+		sort   models.PostSort
+		window models.PostSortWindow
+		filter models.PostFilter
+		skip   int
+		limit  int
 	}
 	type mockReturns struct {
 		posts     []models.PostPaginated
@@ -32,8 +38,10 @@ func TestService_PostsPaginated(t *testing.T) {
 		{
 			name: "paginated posts skip 3 limit 2 :POS",
 			args: args{
-				skip:  3,
-				limit: 2,
+				sort:   models.PostSortHot,
+				window: models.PostSortWindowDay,
+				skip:   3,
+				limit:  2,
 			},
 			mockReturns: mockReturns{
 				posts: []models.PostPaginated{
@@ -47,7 +55,7 @@ func TestService_PostsPaginated(t *testing.T) {
 						Text:        "This is an example post text 4.",
 						TextHtml:    "This is an example post text 4 in HTML.",
 						MediaType:   models.MediaTypeVideo,
-						Medias: []any{
+						Medias: models.Medias{
 							models.Video{
 								ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 								MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
@@ -78,7 +86,7 @@ func TestService_PostsPaginated(t *testing.T) {
 						Text:        "This is an example post text 5.",
 						TextHtml:    "This is an example post text 5 in HTML.",
 						MediaType:   models.MediaTypeLink,
-						Medias: []any{
+						Medias: models.Medias{
 							models.Link{
 								ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 								MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000005"),
@@ -111,7 +119,7 @@ func TestService_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 4.",
 					TextHtml:    "This is an example post text 4 in HTML.",
 					MediaType:   models.MediaTypeVideo,
-					Medias: []any{
+					Medias: models.Medias{
 						models.Video{
 							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
@@ -142,7 +150,7 @@ func TestService_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 5.",
 					TextHtml:    "This is an example post text 5 in HTML.",
 					MediaType:   models.MediaTypeLink,
-					Medias: []any{
+					Medias: models.Medias{
 						models.Link{
 							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000005"),
@@ -167,8 +175,11 @@ func TestService_PostsPaginated(t *testing.T) {
 		{
 			name: "paginated posts skip 3 limit 1 :POS",
 			args: args{
-				skip:  3,
-				limit: 1,
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowWeek,
+				filter: models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+				skip:   3,
+				limit:  1,
 			},
 			mockReturns: mockReturns{
 				posts: []models.PostPaginated{
@@ -182,7 +193,7 @@ func TestService_PostsPaginated(t *testing.T) {
 						Text:        "This is an example post text 4.",
 						TextHtml:    "This is an example post text 4 in HTML.",
 						MediaType:   models.MediaTypeVideo,
-						Medias: []any{
+						Medias: models.Medias{
 							models.Video{
 								ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 								MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
@@ -217,7 +228,7 @@ func TestService_PostsPaginated(t *testing.T) {
 					Text:        "This is an example post text 4.",
 					TextHtml:    "This is an example post text 4 in HTML.",
 					MediaType:   models.MediaTypeVideo,
-					Medias: []any{
+					Medias: models.Medias{
 						models.Video{
 							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
@@ -248,8 +259,10 @@ func TestService_PostsPaginated(t *testing.T) {
 				postError: nil,
 			},
 			args: args{
-				skip:  100,
-				limit: 100,
+				sort:   models.PostSortNew,
+				window: models.PostSortWindowDay,
+				skip:   100,
+				limit:  100,
 			},
 			wantPostPaginted: nil,
 			wantErr:          nil,
@@ -261,8 +274,10 @@ func TestService_PostsPaginated(t *testing.T) {
 				postError: nil,
 			},
 			args: args{
-				skip:  100,
-				limit: 100,
+				sort:   models.PostSortControversial,
+				window: models.PostSortWindowAll,
+				skip:   100,
+				limit:  100,
 			},
 			wantPostPaginted: nil,
 			wantErr:          nil,
@@ -272,11 +287,586 @@ func TestService_PostsPaginated(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fakePostRepo := postfakes.FakePostRepository{}
 			fakePostRepo.PostsPaginatedReturns(tt.mockReturns.posts, tt.mockReturns.postError)
-			service := postservice.NewService(&fakePostRepo)
+			service := postservice.NewService(&fakePostRepo, &postfakes.FakeMembershipRepository{}, &postfakes.FakeBanRepository{}, &postfakes.FakeScreener{}, &postfakes.FakeCustomEmojiRepository{})
 
-			gotPosts, gotErr := service.PostsPaginated(context.Background(), tt.args.skip, tt.args.limit)
+			gotPosts, gotErr := service.PostsPaginated(context.Background(), tt.args.sort, tt.args.window, tt.args.filter, tt.args.skip, tt.args.limit)
 			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
 			assert.Equal(t, tt.wantPostPaginted, gotPosts, "expect posts to match")
+
+			_, gotSort, gotWindow, gotFilter, gotSkip, gotLimit := fakePostRepo.PostsPaginatedArgsForCall(0)
+			assert.Equal(t, tt.args.sort, gotSort, "expect sort to match")
+			assert.Equal(t, tt.args.window, gotWindow, "expect window to match")
+			assert.Equal(t, tt.args.filter, gotFilter, "expect filter to match")
+			assert.Equal(t, tt.args.skip, gotSkip, "expect skip to match")
+			assert.Equal(t, tt.args.limit, gotLimit, "expect limit to match")
 		})
 	}
 }
+
+func TestService_PostsAfter(t *testing.T) {
+	type args struct {
+		sort   models.PostSort
+		window models.PostSortWindow
+		filter models.PostFilter
+		after  *models.PostCursor
+		limit  int
+	}
+	type mockReturns struct {
+		feed      models.PostFeed
+		postError error
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		mockReturns mockReturns
+		wantFeed    models.PostFeed
+		wantErr     error
+	}{
+		{
+			name: "first page :POS",
+			args: args{
+				sort:   models.PostSortNew,
+				window: models.PostSortWindowDay,
+				filter: models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+				after:  nil,
+				limit:  1,
+			},
+			mockReturns: mockReturns{
+				feed: models.PostFeed{
+					Posts: []models.PostPaginated{
+						{
+							ID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							Title: "Example Post Title 2",
+						},
+					},
+					NextCursor: ptrof("cursor"),
+				},
+			},
+			wantFeed: models.PostFeed{
+				Posts: []models.PostPaginated{
+					{
+						ID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Title: "Example Post Title 2",
+					},
+				},
+				NextCursor: ptrof("cursor"),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "cursor does not match sort :NEG",
+			args: args{
+				sort:   models.PostSortTop,
+				window: models.PostSortWindowAll,
+				after: &models.PostCursor{
+					Sort:     models.PostSortNew,
+					Window:   models.PostSortWindowAll,
+					Filter:   models.PostFilter{}.Key(),
+					RankedAt: 1725091200,
+					SortKey:  []string{"1725091100"},
+					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				},
+				limit: 1,
+			},
+			mockReturns: mockReturns{
+				feed:      models.PostFeed{},
+				postError: models.ErrInvalidPostCursor,
+			},
+			wantFeed: models.PostFeed{},
+			wantErr:  models.ErrInvalidPostCursor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostRepo := postfakes.FakePostRepository{}
+			fakePostRepo.PostsAfterReturns(tt.mockReturns.feed, tt.mockReturns.postError)
+			service := postservice.NewService(&fakePostRepo, &postfakes.FakeMembershipRepository{}, &postfakes.FakeBanRepository{}, &postfakes.FakeScreener{}, &postfakes.FakeCustomEmojiRepository{})
+
+			gotFeed, gotErr := service.PostsAfter(context.Background(), tt.args.sort, tt.args.window, tt.args.filter, tt.args.after, tt.args.limit)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantFeed, gotFeed, "expect feed to match")
+
+			_, gotSort, gotWindow, gotFilter, gotAfter, gotLimit := fakePostRepo.PostsAfterArgsForCall(0)
+			assert.Equal(t, tt.args.sort, gotSort, "expect sort to match")
+			assert.Equal(t, tt.args.window, gotWindow, "expect window to match")
+			assert.Equal(t, tt.args.filter, gotFilter, "expect filter to match")
+			assert.Equal(t, tt.args.after, gotAfter, "expect after cursor to match")
+			assert.Equal(t, tt.args.limit, gotLimit, "expect limit to match")
+		})
+	}
+}
+
+func TestService_PostDetailByID(t *testing.T) {
+	viewerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	type args struct {
+		ID uuid.UUID
+	}
+	type mockReturns struct {
+		post      models.PostDetail
+		postError error
+	}
+
+	tests := []struct {
+		name           string
+		args           args
+		mockReturns    mockReturns
+		wantPostDetail models.PostDetail
+		wantErr        error
+	}{
+		{
+			name: "post detail :POS",
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			mockReturns: mockReturns{
+				post: models.PostDetail{
+					PostPaginated: models.PostPaginated{
+						ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Author:        "John Doe",
+						AuthorID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Voxsphere:     "v/foo",
+						VoxsphereID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Title:         "Example Post Title 1",
+						Text:          "This is an example post text 1.",
+						TextHtml:      "This is an example post text 1 in HTML.",
+						MediaType:     models.MediaTypeText,
+						Ups:           10,
+						NumAwards:     1,
+						CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix: 1725091100,
+						UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						PostFlairs: []models.PostFlairRendered{
+							{
+								ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								FullText:        "desc1 :ce1:",
+								BackgroundColor: "#FFFFFF",
+								Richtext: []models.FlairRichtext{
+									{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc1 "},
+									{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 1, Text: ":ce1:", Url: ptrof("https://example.com/ce1.png")},
+								},
+							},
+						},
+					},
+					Awards: []models.Award{
+						{
+							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Title:     "award_foo",
+							ImageLink: "https://example.com/award_foo.png",
+						},
+					},
+				},
+				postError: nil,
+			},
+			wantPostDetail: models.PostDetail{
+				PostPaginated: models.PostPaginated{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Author:        "John Doe",
+					AuthorID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Voxsphere:     "v/foo",
+					VoxsphereID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:         "Example Post Title 1",
+					Text:          "This is an example post text 1.",
+					TextHtml:      "This is an example post text 1 in HTML.",
+					MediaType:     models.MediaTypeText,
+					Ups:           10,
+					NumAwards:     1,
+					CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					CreatedAtUnix: 1725091100,
+					UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					PostFlairs: []models.PostFlairRendered{
+						{
+							ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							FullText:        "desc1 :ce1:",
+							BackgroundColor: "#FFFFFF",
+							Richtext: []models.FlairRichtext{
+								{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc1 "},
+								{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 1, Text: ":ce1:", Url: ptrof("https://example.com/ce1.png")},
+							},
+						},
+					},
+				},
+				Awards: []models.Award{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Title:     "award_foo",
+						ImageLink: "https://example.com/award_foo.png",
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "post not found :NEG",
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			mockReturns: mockReturns{
+				post:      models.PostDetail{},
+				postError: postrepo.ErrPostNotFound,
+			},
+			wantPostDetail: models.PostDetail{},
+			wantErr:        postrepo.ErrPostNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostRepo := postfakes.FakePostRepository{}
+			fakePostRepo.PostDetailByIDReturns(tt.mockReturns.post, tt.mockReturns.postError)
+			service := postservice.NewService(&fakePostRepo, &postfakes.FakeMembershipRepository{}, &postfakes.FakeBanRepository{}, &postfakes.FakeScreener{}, &postfakes.FakeCustomEmojiRepository{})
+
+			gotPost, gotErr := service.PostDetailByID(context.Background(), tt.args.ID, viewerID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantPostDetail, gotPost, "expect post to match")
+
+			_, gotID, gotViewerID := fakePostRepo.PostDetailByIDArgsForCall(0)
+			assert.Equal(t, tt.args.ID, gotID, "expect post id to be passed to the repository")
+			assert.Equal(t, viewerID, gotViewerID, "expect viewer id to be passed to the repository")
+		})
+	}
+}
+
+func TestService_CreatePost(t *testing.T) {
+	authorID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	voxsphereID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	errScreen := errors.New("screening failed")
+
+	tests := []struct {
+		name          string
+		submission    models.PostSubmission
+		isMember      bool
+		isBanned      bool
+		verdict       *models.AutomodVerdict
+		screenErr     error
+		wantPost      models.Post
+		wantMediaType models.MediaType
+		wantLinks     []string
+		wantErr       error
+	}{
+		{
+			name:       "blank title :NEG",
+			submission: models.PostSubmission{VoxsphereID: voxsphereID, Title: "   "},
+			isMember:   true,
+			wantErr:    postservice.ErrPostInvalidTitle,
+		},
+		{
+			name:       "title too long :NEG",
+			submission: models.PostSubmission{VoxsphereID: voxsphereID, Title: strings.Repeat("a", 301)},
+			isMember:   true,
+			wantErr:    postservice.ErrPostInvalidTitle,
+		},
+		{
+			name:       "text too long :NEG",
+			submission: models.PostSubmission{VoxsphereID: voxsphereID, Title: "title", Text: strings.Repeat("a", 40001)},
+			isMember:   true,
+			wantErr:    postservice.ErrPostInvalidText,
+		},
+		{
+			name:       "link without http scheme :NEG",
+			submission: models.PostSubmission{VoxsphereID: voxsphereID, Title: "title", Link: "javascript:alert(1)"},
+			isMember:   true,
+			wantErr:    postservice.ErrPostInvalidLink,
+		},
+		{
+			name:       "not a member :NEG",
+			submission: models.PostSubmission{VoxsphereID: voxsphereID, Title: "title"},
+			isMember:   false,
+			wantErr:    postservice.ErrPostNotMember,
+		},
+		{
+			name:       "banned member :NEG",
+			submission: models.PostSubmission{VoxsphereID: voxsphereID, Title: "title"},
+			isMember:   true,
+			isBanned:   true,
+			wantErr:    postservice.ErrPostBanned,
+		},
+		{
+			name: "text post :POS",
+			submission: models.PostSubmission{
+				VoxsphereID: voxsphereID,
+				Title:       " title ",
+				Text:        "a **text** <b>raw</b>",
+				Spoiler:     true,
+			},
+			isMember: true,
+			wantPost: models.Post{
+				AuthorID:    authorID,
+				VoxsphereID: voxsphereID,
+				Title:       "title",
+				Text:        "a **text** <b>raw</b>",
+				TextHtml:    "<p>a <strong>text</strong> raw</p>",
+				Spoiler:     true,
+			},
+			wantMediaType: models.MediaTypeText,
+			wantLinks:     nil,
+			wantErr:       nil,
+		},
+		{
+			name: "text post with custom emojis :POS",
+			submission: models.PostSubmission{
+				VoxsphereID: voxsphereID,
+				Title:       "title",
+				Text:        "time to :party: :dance:",
+			},
+			isMember: true,
+			wantPost: models.Post{
+				AuthorID:    authorID,
+				VoxsphereID: voxsphereID,
+				Title:       "title",
+				Text:        "time to :party: :dance:",
+				TextHtml:    `<p>time to <img class="custom-emoji" src="https://example.com/party.png" alt=":party:" title=":party:"> :dance:</p>`,
+			},
+			wantMediaType: models.MediaTypeText,
+			wantLinks:     nil,
+			wantErr:       nil,
+		},
+		{
+			name: "link post :POS",
+			submission: models.PostSubmission{
+				VoxsphereID: voxsphereID,
+				Title:       "title",
+				Link:        "https://example.com",
+				Over18:      true,
+			},
+			isMember: true,
+			wantPost: models.Post{
+				AuthorID:    authorID,
+				VoxsphereID: voxsphereID,
+				Title:       "title",
+				Over18:      true,
+			},
+			wantMediaType: models.MediaTypeLink,
+			wantLinks:     []string{"https://example.com"},
+			wantErr:       nil,
+		},
+		{
+			name:       "post added with verdict :POS",
+			submission: models.PostSubmission{VoxsphereID: voxsphereID, Title: "title"},
+			isMember:   true,
+			verdict:    &models.AutomodVerdict{VoxsphereID: voxsphereID, TargetType: models.ReportTargetPost, Remove: true},
+			wantPost: models.Post{
+				AuthorID:    authorID,
+				VoxsphereID: voxsphereID,
+				Title:       "title",
+			},
+			wantMediaType: models.MediaTypeText,
+			wantLinks:     nil,
+			wantErr:       nil,
+		},
+		{
+			name:       "screening fails :NEG",
+			submission: models.PostSubmission{VoxsphereID: voxsphereID, Title: "title"},
+			isMember:   true,
+			screenErr:  errScreen,
+			wantErr:    errScreen,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostRepo := postfakes.FakePostRepository{}
+			fakePostRepo.AddPostWithMediaStub = func(_ context.Context, post models.Post, _ models.PostMedia, _ *models.AutomodVerdict, _ ...models.Link) (models.Post, error) {
+				return post, nil
+			}
+			fakeMembershipRepo := postfakes.FakeMembershipRepository{}
+			fakeMembershipRepo.IsVoxsphereMemberReturns(tt.isMember, nil)
+			fakeBanRepo := postfakes.FakeBanRepository{}
+			fakeBanRepo.IsBannedFromVoxsphereReturns(tt.isBanned, nil)
+			fakeScreener := postfakes.FakeScreener{}
+			fakeScreener.JudgePostReturns(tt.verdict, tt.screenErr)
+			fakeEmojiRepo := postfakes.FakeCustomEmojiRepository{}
+			fakeEmojiRepo.VoxsphereCustomEmojisReturns([]models.CustomEmoji{
+				{VoxsphereID: voxsphereID, Title: ":party:", Url: "https://example.com/party.png"},
+			}, nil)
+			service := postservice.NewService(&fakePostRepo, &fakeMembershipRepo, &fakeBanRepo, &fakeScreener, &fakeEmojiRepo)
+
+			gotPost, gotErr := service.CreatePost(context.Background(), authorID, tt.submission)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, models.Post{}, gotPost, "expect no post")
+				assert.Equal(t, 0, fakePostRepo.AddPostWithMediaCallCount(), "expect no post to be added")
+				return
+			}
+
+			tt.wantPost.ID = gotPost.ID
+			_, gotJudgedPost, gotJudgedLinks := fakeScreener.JudgePostArgsForCall(0)
+			assert.Equal(t, gotPost, gotJudgedPost, "expect the new post to be judged")
+			assert.Equal(t, tt.wantLinks, gotJudgedLinks, "expect the links of the post to be judged")
+			assert.Equal(t, tt.wantPost, gotPost, "expect post to match")
+
+			_, _, gotPostMedia, gotVerdict, gotLinks := fakePostRepo.AddPostWithMediaArgsForCall(0)
+			assert.Equal(t, tt.verdict, gotVerdict, "expect the verdict to be added with the post")
+			assert.Equal(t, gotPost.ID, gotPostMedia.PostID, "expect post media to belong to the post")
+			assert.Equal(t, tt.wantMediaType, gotPostMedia.MediaType, "expect media type to match")
+			var gotLinkUrls []string
+			for _, link := range gotLinks {
+				assert.Equal(t, gotPostMedia.ID, link.MediaID, "expect link to belong to the post media")
+				gotLinkUrls = append(gotLinkUrls, link.Link)
+			}
+			assert.Equal(t, tt.wantLinks, gotLinkUrls, "expect links to match")
+		})
+	}
+}
+
+func TestService_EditPost(t *testing.T) {
+	errScreen := errors.New("screening failed")
+	post := models.Post{
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Title:       "title",
+		Text:        "text",
+		TextHtml:    "<p>text</p>",
+	}
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		edit        models.PostEdit
+		postError   error
+		verdict     *models.AutomodVerdict
+		screenErr   error
+		wantUpdated models.Post
+		wantErr     error
+	}{
+		{
+			name:    "text too long :NEG",
+			userID:  post.AuthorID,
+			edit:    models.PostEdit{Text: ptrof(strings.Repeat("a", 40001))},
+			wantErr: postservice.ErrPostInvalidText,
+		},
+		{
+			name:      "post not found :NEG",
+			userID:    post.AuthorID,
+			edit:      models.PostEdit{Spoiler: ptrof(true)},
+			postError: postrepo.ErrPostNotFound,
+			wantErr:   postrepo.ErrPostNotFound,
+		},
+		{
+			name:    "not the author :NEG",
+			userID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			edit:    models.PostEdit{Spoiler: ptrof(true)},
+			wantErr: postservice.ErrPostNotAuthor,
+		},
+		{
+			name:   "edit text and flags :POS",
+			userID: post.AuthorID,
+			edit: models.PostEdit{
+				Text:   ptrof("new text"),
+				Over18: ptrof(true),
+			},
+			wantUpdated: models.Post{
+				ID:          post.ID,
+				AuthorID:    post.AuthorID,
+				VoxsphereID: post.VoxsphereID,
+				Title:       "title",
+				Text:        "new text",
+				TextHtml:    "<p>new text</p>",
+				Over18:      true,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "edit judged :POS",
+			userID:  post.AuthorID,
+			edit:    models.PostEdit{Text: ptrof("buy now")},
+			verdict: &models.AutomodVerdict{VoxsphereID: post.VoxsphereID, TargetType: models.ReportTargetPost, TargetID: post.ID, Remove: true},
+			wantUpdated: models.Post{
+				ID:          post.ID,
+				AuthorID:    post.AuthorID,
+				VoxsphereID: post.VoxsphereID,
+				Title:       "title",
+				Text:        "buy now",
+				TextHtml:    "<p>buy now</p>",
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "screening fails :NEG",
+			userID:    post.AuthorID,
+			edit:      models.PostEdit{Text: ptrof("new text")},
+			screenErr: errScreen,
+			wantErr:   errScreen,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostRepo := postfakes.FakePostRepository{}
+			fakePostRepo.PostByIDReturns(post, tt.postError)
+			fakePostRepo.EditPostStub = func(_ context.Context, post models.Post, _ *models.AutomodVerdict) (models.Post, error) {
+				return post, nil
+			}
+			fakeScreener := postfakes.FakeScreener{}
+			fakeScreener.JudgePostReturns(tt.verdict, tt.screenErr)
+			service := postservice.NewService(&fakePostRepo, &postfakes.FakeMembershipRepository{}, &postfakes.FakeBanRepository{}, &fakeScreener, &postfakes.FakeCustomEmojiRepository{})
+
+			gotPost, gotErr := service.EditPost(context.Background(), post.ID, tt.userID, tt.edit)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantUpdated, gotPost, "expect post to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, 0, fakePostRepo.EditPostCallCount(), "expect post not to be updated")
+				return
+			}
+			_, gotJudgedPost, _ := fakeScreener.JudgePostArgsForCall(0)
+			assert.Equal(t, tt.wantUpdated, gotJudgedPost, "expect the edited post to be judged")
+			_, _, gotVerdict := fakePostRepo.EditPostArgsForCall(0)
+			assert.Equal(t, tt.verdict, gotVerdict, "expect the verdict to be saved with the edit")
+		})
+	}
+}
+
+func TestService_DeletePost(t *testing.T) {
+	post := models.Post{
+		ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		AuthorID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	}
+
+	tests := []struct {
+		name      string
+		userID    uuid.UUID
+		postError error
+		wantErr   error
+	}{
+		{
+			name:      "post not found :NEG",
+			userID:    post.AuthorID,
+			postError: postrepo.ErrPostNotFound,
+			wantErr:   postrepo.ErrPostNotFound,
+		},
+		{
+			name:    "not the author :NEG",
+			userID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			wantErr: postservice.ErrPostNotAuthor,
+		},
+		{
+			name:    "author deletes post :POS",
+			userID:  post.AuthorID,
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostRepo := postfakes.FakePostRepository{}
+			fakePostRepo.PostByIDReturns(post, tt.postError)
+			service := postservice.NewService(&fakePostRepo, &postfakes.FakeMembershipRepository{}, &postfakes.FakeBanRepository{}, &postfakes.FakeScreener{}, &postfakes.FakeCustomEmojiRepository{})
+
+			gotErr := service.DeletePost(context.Background(), post.ID, tt.userID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, 0, fakePostRepo.DeletePostCallCount(), "expect post not to be deleted")
+				return
+			}
+			_, gotID := fakePostRepo.DeletePostArgsForCall(0)
+			assert.Equal(t, post.ID, gotID, "expect post id to be passed to the repository")
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
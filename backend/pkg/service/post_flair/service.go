@@ -0,0 +1,93 @@
+package postflair
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+var ErrPostFlairNotAllowed = errors.New("only the author of a post or a moderator of its voxsphere can change its flair")
+
+type PostFlairService interface {
+	VoxspherePostFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.PostFlairRendered, error)
+	SetPostFlair(ctx context.Context, postID, userID, postFlairID uuid.UUID) error
+	ClearPostFlair(ctx context.Context, postID, userID uuid.UUID) error
+}
+
+//counterfeiter:generate . PostFlairRepository
+type PostFlairRepository interface {
+	VoxspherePostFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.PostFlairRendered, error)
+	SetPostFlair(ctx context.Context, postID, postFlairID uuid.UUID) error
+	ClearPostFlair(ctx context.Context, postID uuid.UUID) error
+}
+
+//counterfeiter:generate . PostRepository
+type PostRepository interface {
+	PostByID(ctx context.Context, ID uuid.UUID) (models.Post, error)
+}
+
+//counterfeiter:generate . ModeratorRepository
+type ModeratorRepository interface {
+	IsVoxsphereModerator(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	repo          PostFlairRepository
+	postRepo      PostRepository
+	moderatorRepo ModeratorRepository
+}
+
+func NewService(repo PostFlairRepository, postRepo PostRepository, moderatorRepo ModeratorRepository) *Service {
+	return &Service{
+		repo:          repo,
+		postRepo:      postRepo,
+		moderatorRepo: moderatorRepo,
+	}
+}
+
+// VoxspherePostFlairs returns the post flairs the posts of the voxsphere of
+// voxsphereID can be flaired with.
+func (s *Service) VoxspherePostFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.PostFlairRendered, error) {
+	return s.repo.VoxspherePostFlairs(ctx, voxsphereID)
+}
+
+// SetPostFlair flairs the post of postID with the post flair of postFlairID
+// on behalf of the user of userID.
+func (s *Service) SetPostFlair(ctx context.Context, postID, userID, postFlairID uuid.UUID) error {
+	if err := s.canFlair(ctx, postID, userID); err != nil {
+		return err
+	}
+	return s.repo.SetPostFlair(ctx, postID, postFlairID)
+}
+
+// ClearPostFlair removes the flair of the post of postID on behalf of the user
+// of userID.
+func (s *Service) ClearPostFlair(ctx context.Context, postID, userID uuid.UUID) error {
+	if err := s.canFlair(ctx, postID, userID); err != nil {
+		return err
+	}
+	return s.repo.ClearPostFlair(ctx, postID)
+}
+
+// canFlair checks that the user of userID is the author of the post of
+// postID or moderates its voxsphere.
+func (s *Service) canFlair(ctx context.Context, postID, userID uuid.UUID) error {
+	post, err := s.postRepo.PostByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if post.AuthorID == userID {
+		return nil
+	}
+
+	isModerator, err := s.moderatorRepo.IsVoxsphereModerator(ctx, post.VoxsphereID, userID)
+	if err != nil {
+		return err
+	}
+	if !isModerator {
+		return ErrPostFlairNotAllowed
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package postflair_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	postrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post"
+	postflairrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post_flair"
+	postflairservice "github.com/glowfi/voxpopuli/backend/pkg/service/post_flair"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/post_flair/post_flairfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	postID      = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	voxsphereID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	flairID     = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	authorID    = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherUserID = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+)
+
+func TestService_VoxspherePostFlairs(t *testing.T) {
+	flairs := []models.PostFlairRendered{
+		{
+			ID:              flairID,
+			VoxsphereID:     voxsphereID,
+			FullText:        "desc1 :ce1:",
+			BackgroundColor: "#FFFFFF",
+			Richtext: []models.FlairRichtext{
+				{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc1 "},
+			},
+		},
+	}
+
+	fakePostFlairRepo := post_flairfakes.FakePostFlairRepository{}
+	fakePostFlairRepo.VoxspherePostFlairsReturns(flairs, nil)
+	service := postflairservice.NewService(&fakePostFlairRepo, &post_flairfakes.FakePostRepository{}, &post_flairfakes.FakeModeratorRepository{})
+
+	gotFlairs, gotErr := service.VoxspherePostFlairs(context.Background(), voxsphereID)
+	assert.NoError(t, gotErr, "expect no error")
+	assert.Equal(t, flairs, gotFlairs, "expect flairs to match")
+
+	_, gotVoxsphereID := fakePostFlairRepo.VoxspherePostFlairsArgsForCall(0)
+	assert.Equal(t, voxsphereID, gotVoxsphereID, "expect voxsphere to match")
+}
+
+func TestService_SetPostFlair(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		postErr     error
+		isModerator bool
+		setErr      error
+		wantErr     error
+		wantSetCall bool
+	}{
+		{
+			name:    "post not found :NEG",
+			userID:  authorID,
+			postErr: postrepo.ErrPostNotFound,
+			wantErr: postrepo.ErrPostNotFound,
+		},
+		{
+			name:        "neither author nor moderator :NEG",
+			userID:      otherUserID,
+			isModerator: false,
+			wantErr:     postflairservice.ErrPostFlairNotAllowed,
+		},
+		{
+			name:        "flair of another voxsphere :NEG",
+			userID:      authorID,
+			setErr:      postflairrepo.ErrPostFlairNotInVoxsphere,
+			wantErr:     postflairrepo.ErrPostFlairNotInVoxsphere,
+			wantSetCall: true,
+		},
+		{
+			name:        "author :POS",
+			userID:      authorID,
+			wantSetCall: true,
+		},
+		{
+			name:        "moderator :POS",
+			userID:      otherUserID,
+			isModerator: true,
+			wantSetCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostFlairRepo := post_flairfakes.FakePostFlairRepository{}
+			fakePostFlairRepo.SetPostFlairReturns(tt.setErr)
+			fakePostRepo := post_flairfakes.FakePostRepository{}
+			fakePostRepo.PostByIDReturns(models.Post{ID: postID, AuthorID: authorID, VoxsphereID: voxsphereID}, tt.postErr)
+			fakeModeratorRepo := post_flairfakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := postflairservice.NewService(&fakePostFlairRepo, &fakePostRepo, &fakeModeratorRepo)
+
+			gotErr := service.SetPostFlair(context.Background(), postID, tt.userID, flairID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if !tt.wantSetCall {
+				assert.Equal(t, 0, fakePostFlairRepo.SetPostFlairCallCount(), "expect flair to stay as is")
+				return
+			}
+			_, gotPostID, gotFlairID := fakePostFlairRepo.SetPostFlairArgsForCall(0)
+			assert.Equal(t, postID, gotPostID, "expect post to match")
+			assert.Equal(t, flairID, gotFlairID, "expect flair to match")
+		})
+	}
+}
+
+func TestService_ClearPostFlair(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        uuid.UUID
+		isModerator   bool
+		wantErr       error
+		wantClearCall bool
+	}{
+		{
+			name:        "neither author nor moderator :NEG",
+			userID:      otherUserID,
+			isModerator: false,
+			wantErr:     postflairservice.ErrPostFlairNotAllowed,
+		},
+		{
+			name:          "author :POS",
+			userID:        authorID,
+			wantClearCall: true,
+		},
+		{
+			name:          "moderator :POS",
+			userID:        otherUserID,
+			isModerator:   true,
+			wantClearCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostFlairRepo := post_flairfakes.FakePostFlairRepository{}
+			fakePostRepo := post_flairfakes.FakePostRepository{}
+			fakePostRepo.PostByIDReturns(models.Post{ID: postID, AuthorID: authorID, VoxsphereID: voxsphereID}, nil)
+			fakeModeratorRepo := post_flairfakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := postflairservice.NewService(&fakePostFlairRepo, &fakePostRepo, &fakeModeratorRepo)
+
+			gotErr := service.ClearPostFlair(context.Background(), postID, tt.userID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			wantClearCalls := 0
+			if tt.wantClearCall {
+				wantClearCalls = 1
+			}
+			assert.Equal(t, wantClearCalls, fakePostFlairRepo.ClearPostFlairCallCount(), "expect clear call count to match")
+		})
+	}
+}
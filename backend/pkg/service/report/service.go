@@ -0,0 +1,103 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+const maxReasonLength = 1000
+
+var (
+	ErrReportInvalid      = fmt.Errorf("report must name a rule or give a reason of at most %d characters", maxReasonLength)
+	ErrReportNotModerator = errors.New("only moderators of a voxsphere can see its reports")
+)
+
+type ReportService interface {
+	ReportPost(ctx context.Context, postID, reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error)
+	ReportComment(ctx context.Context, commentID, reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error)
+	ReportQueue(ctx context.Context, voxsphereID, moderatorID uuid.UUID, skip, limit int) ([]models.ReportQueueItem, error)
+}
+
+//counterfeiter:generate . ReportRepository
+type ReportRepository interface {
+	AddReport(ctx context.Context, report models.Report) (models.Report, error)
+	ReportQueue(ctx context.Context, voxsphereID uuid.UUID, skip, limit int) ([]models.ReportQueueItem, error)
+}
+
+//counterfeiter:generate . ModeratorRepository
+type ModeratorRepository interface {
+	IsVoxsphereModerator(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	repo          ReportRepository
+	moderatorRepo ModeratorRepository
+}
+
+func NewService(repo ReportRepository, moderatorRepo ModeratorRepository) *Service {
+	return &Service{
+		repo:          repo,
+		moderatorRepo: moderatorRepo,
+	}
+}
+
+// ReportPost reports the post of postID to the moderators of its voxsphere on
+// behalf of the user of reporterID.
+func (s *Service) ReportPost(ctx context.Context, postID, reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error) {
+	report, err := newReport(reporterID, submission)
+	if err != nil {
+		return models.Report{}, err
+	}
+	report.PostID = postID
+
+	return s.repo.AddReport(ctx, report)
+}
+
+// ReportComment reports the comment of commentID to the moderators of its
+// voxsphere on behalf of the user of reporterID.
+func (s *Service) ReportComment(ctx context.Context, commentID, reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error) {
+	report, err := newReport(reporterID, submission)
+	if err != nil {
+		return models.Report{}, err
+	}
+	report.CommentID = commentID
+
+	return s.repo.AddReport(ctx, report)
+}
+
+// ReportQueue returns the open reports of the voxsphere of voxsphereID grouped
+// by target to the user of moderatorID, who has to moderate it.
+func (s *Service) ReportQueue(ctx context.Context, voxsphereID, moderatorID uuid.UUID, skip, limit int) ([]models.ReportQueueItem, error) {
+	isModerator, err := s.moderatorRepo.IsVoxsphereModerator(ctx, voxsphereID, moderatorID)
+	if err != nil {
+		return []models.ReportQueueItem{}, err
+	}
+	if !isModerator {
+		return []models.ReportQueueItem{}, ErrReportNotModerator
+	}
+
+	return s.repo.ReportQueue(ctx, voxsphereID, skip, limit)
+}
+
+func newReport(reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error) {
+	reason := strings.TrimSpace(submission.Reason)
+	if submission.RuleID == uuid.Nil && len(reason) == 0 {
+		return models.Report{}, ErrReportInvalid
+	}
+	if utf8.RuneCountInString(reason) > maxReasonLength {
+		return models.Report{}, ErrReportInvalid
+	}
+
+	return models.Report{
+		ID:         uuid.New(),
+		ReporterID: reporterID,
+		RuleID:     submission.RuleID,
+		Reason:     reason,
+	}, nil
+}
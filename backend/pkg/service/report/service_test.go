@@ -0,0 +1,158 @@
+package report_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	reportrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/report"
+	reportservice "github.com/glowfi/voxpopuli/backend/pkg/service/report"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/report/reportfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	voxsphereID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	ruleID      = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	reporterID  = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	moderatorID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+)
+
+func TestService_ReportPost(t *testing.T) {
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name         string
+		submission   models.ReportSubmission
+		addError     error
+		wantReason   string
+		wantErr      error
+		wantAddCalls int
+	}{
+		{
+			name:       "no rule and no reason :NEG",
+			submission: models.ReportSubmission{Reason: "   "},
+			wantErr:    reportservice.ErrReportInvalid,
+		},
+		{
+			name:       "reason too long :NEG",
+			submission: models.ReportSubmission{Reason: strings.Repeat("a", 1001)},
+			wantErr:    reportservice.ErrReportInvalid,
+		},
+		{
+			name:         "already reported :NEG",
+			submission:   models.ReportSubmission{RuleID: ruleID},
+			addError:     reportrepo.ErrReportDuplicate,
+			wantErr:      reportrepo.ErrReportDuplicate,
+			wantAddCalls: 1,
+		},
+		{
+			name:         "report by rule :POS",
+			submission:   models.ReportSubmission{RuleID: ruleID},
+			wantAddCalls: 1,
+		},
+		{
+			name:         "report by reason :POS",
+			submission:   models.ReportSubmission{Reason: "  spam  "},
+			wantReason:   "spam",
+			wantAddCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeReportRepo := reportfakes.FakeReportRepository{}
+			fakeReportRepo.AddReportStub = func(_ context.Context, report models.Report) (models.Report, error) {
+				if tt.addError != nil {
+					return models.Report{}, tt.addError
+				}
+				return report, nil
+			}
+			service := reportservice.NewService(&fakeReportRepo, &reportfakes.FakeModeratorRepository{})
+
+			gotReport, gotErr := service.ReportPost(context.Background(), postID, reporterID, tt.submission)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantAddCalls, fakeReportRepo.AddReportCallCount(), "expect add call count to match")
+
+			if tt.wantErr == nil {
+				assert.NotEqual(t, uuid.Nil, gotReport.ID, "expect report to get an id")
+				assert.Equal(t, postID, gotReport.PostID, "expect post to be reported")
+				assert.Equal(t, uuid.Nil, gotReport.CommentID, "expect no comment to be reported")
+				assert.Equal(t, reporterID, gotReport.ReporterID, "expect reporter to match")
+				assert.Equal(t, tt.submission.RuleID, gotReport.RuleID, "expect rule to match")
+				assert.Equal(t, tt.wantReason, gotReport.Reason, "expect reason to be trimmed")
+			}
+		})
+	}
+}
+
+func TestService_ReportComment(t *testing.T) {
+	commentID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := []struct {
+		name         string
+		submission   models.ReportSubmission
+		wantErr      error
+		wantAddCalls int
+	}{
+		{
+			name:       "no rule and no reason :NEG",
+			submission: models.ReportSubmission{},
+			wantErr:    reportservice.ErrReportInvalid,
+		},
+		{
+			name:         "report by rule and reason :POS",
+			submission:   models.ReportSubmission{RuleID: ruleID, Reason: "spam"},
+			wantAddCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeReportRepo := reportfakes.FakeReportRepository{}
+			service := reportservice.NewService(&fakeReportRepo, &reportfakes.FakeModeratorRepository{})
+
+			_, gotErr := service.ReportComment(context.Background(), commentID, reporterID, tt.submission)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantAddCalls, fakeReportRepo.AddReportCallCount(), "expect add call count to match")
+
+			if tt.wantAddCalls != 0 {
+				_, gotReport := fakeReportRepo.AddReportArgsForCall(0)
+				assert.Equal(t, commentID, gotReport.CommentID, "expect comment to be reported")
+				assert.Equal(t, uuid.Nil, gotReport.PostID, "expect no post to be reported")
+			}
+		})
+	}
+}
+
+func TestService_ReportQueue(t *testing.T) {
+	tests := []struct {
+		name           string
+		isModerator    bool
+		wantErr        error
+		wantQueueCalls int
+	}{
+		{
+			name:        "not a moderator :NEG",
+			isModerator: false,
+			wantErr:     reportservice.ErrReportNotModerator,
+		},
+		{
+			name:           "moderator :POS",
+			isModerator:    true,
+			wantQueueCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeReportRepo := reportfakes.FakeReportRepository{}
+			fakeModeratorRepo := reportfakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := reportservice.NewService(&fakeReportRepo, &fakeModeratorRepo)
+
+			_, gotErr := service.ReportQueue(context.Background(), voxsphereID, moderatorID, 0, 10)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantQueueCalls, fakeReportRepo.ReportQueueCallCount(), "expect queue call count to match")
+		})
+	}
+}
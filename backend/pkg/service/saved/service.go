@@ -0,0 +1,145 @@
+package saved
+
+import (
+	"context"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+type SavedService interface {
+	SavePost(ctx context.Context, userID, postID uuid.UUID) error
+	UnsavePost(ctx context.Context, userID, postID uuid.UUID) error
+	SaveComment(ctx context.Context, userID, commentID uuid.UUID) error
+	UnsaveComment(ctx context.Context, userID, commentID uuid.UUID) error
+	HidePost(ctx context.Context, userID, postID uuid.UUID) error
+	UnhidePost(ctx context.Context, userID, postID uuid.UUID) error
+	Saved(ctx context.Context, userID uuid.UUID, skip, limit int) ([]models.SavedItem, error)
+}
+
+//counterfeiter:generate . SavedRepository
+type SavedRepository interface {
+	SavePost(ctx context.Context, userID, postID uuid.UUID) error
+	UnsavePost(ctx context.Context, userID, postID uuid.UUID) error
+	SaveComment(ctx context.Context, userID, commentID uuid.UUID) error
+	UnsaveComment(ctx context.Context, userID, commentID uuid.UUID) error
+	HidePost(ctx context.Context, userID, postID uuid.UUID) error
+	UnhidePost(ctx context.Context, userID, postID uuid.UUID) error
+	SavedRefs(ctx context.Context, userID uuid.UUID, skip, limit int) ([]models.SavedRef, error)
+}
+
+//counterfeiter:generate . PostRepository
+type PostRepository interface {
+	PostsByIDs(ctx context.Context, IDs []uuid.UUID, viewerID uuid.UUID) ([]models.PostPaginated, error)
+}
+
+//counterfeiter:generate . CommentRepository
+type CommentRepository interface {
+	CommentsByIDs(ctx context.Context, IDs []uuid.UUID) ([]models.UserComment, error)
+}
+
+type Service struct {
+	repo        SavedRepository
+	postRepo    PostRepository
+	commentRepo CommentRepository
+}
+
+func NewService(repo SavedRepository, postRepo PostRepository, commentRepo CommentRepository) *Service {
+	return &Service{
+		repo:        repo,
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+// SavePost bookmarks the post of postID for the user of userID.
+func (s *Service) SavePost(ctx context.Context, userID, postID uuid.UUID) error {
+	return s.repo.SavePost(ctx, userID, postID)
+}
+
+// UnsavePost removes the bookmark of the user of userID on the post of postID.
+func (s *Service) UnsavePost(ctx context.Context, userID, postID uuid.UUID) error {
+	return s.repo.UnsavePost(ctx, userID, postID)
+}
+
+// SaveComment bookmarks the comment of commentID for the user of userID.
+func (s *Service) SaveComment(ctx context.Context, userID, commentID uuid.UUID) error {
+	return s.repo.SaveComment(ctx, userID, commentID)
+}
+
+// UnsaveComment removes the bookmark of the user of userID on the comment of
+// commentID.
+func (s *Service) UnsaveComment(ctx context.Context, userID, commentID uuid.UUID) error {
+	return s.repo.UnsaveComment(ctx, userID, commentID)
+}
+
+// HidePost hides the post of postID from the feeds of the user of userID.
+func (s *Service) HidePost(ctx context.Context, userID, postID uuid.UUID) error {
+	return s.repo.HidePost(ctx, userID, postID)
+}
+
+// UnhidePost shows the post of postID in the feeds of the user of userID again.
+func (s *Service) UnhidePost(ctx context.Context, userID, postID uuid.UUID) error {
+	return s.repo.UnhidePost(ctx, userID, postID)
+}
+
+// Saved returns a page of the posts and comments saved by the user of userID,
+// most recently saved first. Saved items which were since removed or deleted
+// are left out of the page.
+func (s *Service) Saved(ctx context.Context, userID uuid.UUID, skip, limit int) ([]models.SavedItem, error) {
+	refs, err := s.repo.SavedRefs(ctx, userID, skip, limit)
+	if err != nil {
+		return []models.SavedItem{}, err
+	}
+
+	var postIDs, commentIDs []uuid.UUID
+	for _, ref := range refs {
+		switch ref.Type {
+		case models.SavedItemTypePost:
+			postIDs = append(postIDs, ref.ID)
+		case models.SavedItemTypeComment:
+			commentIDs = append(commentIDs, ref.ID)
+		}
+	}
+
+	posts, err := s.postRepo.PostsByIDs(ctx, postIDs, userID)
+	if err != nil {
+		return []models.SavedItem{}, err
+	}
+	postsByID := make(map[uuid.UUID]models.PostPaginated, len(posts))
+	for _, post := range posts {
+		postsByID[post.ID] = post
+	}
+
+	comments, err := s.commentRepo.CommentsByIDs(ctx, commentIDs)
+	if err != nil {
+		return []models.SavedItem{}, err
+	}
+	commentsByID := make(map[uuid.UUID]models.UserComment, len(comments))
+	for _, comment := range comments {
+		commentsByID[comment.ID] = comment
+	}
+
+	items := make([]models.SavedItem, 0, len(refs))
+	for _, ref := range refs {
+		item := models.SavedItem{Type: ref.Type, SavedAt: ref.SavedAt}
+		switch ref.Type {
+		case models.SavedItemTypePost:
+			post, ok := postsByID[ref.ID]
+			if !ok {
+				continue
+			}
+			item.Post = &post
+		case models.SavedItemTypeComment:
+			comment, ok := commentsByID[ref.ID]
+			if !ok {
+				continue
+			}
+			item.Comment = &comment
+		default:
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
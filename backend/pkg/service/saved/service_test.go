@@ -0,0 +1,127 @@
+package saved_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	savedservice "github.com/glowfi/voxpopuli/backend/pkg/service/saved"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/saved/savedfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	userID     = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	postID1    = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	postID2    = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	commentID1 = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	savedAt    = time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC)
+)
+
+func TestService_Saved(t *testing.T) {
+	errRepo := errors.New("repo error")
+
+	tests := []struct {
+		name           string
+		refs           []models.SavedRef
+		refsErr        error
+		posts          []models.PostPaginated
+		comments       []models.UserComment
+		wantItems      []models.SavedItem
+		wantErr        error
+		wantPostIDs    []uuid.UUID
+		wantCommentIDs []uuid.UUID
+	}{
+		{
+			name:      "saved refs error :NEG",
+			refsErr:   errRepo,
+			wantItems: []models.SavedItem{},
+			wantErr:   errRepo,
+		},
+		{
+			name:      "nothing saved :NEG",
+			refs:      []models.SavedRef{},
+			wantItems: []models.SavedItem{},
+		},
+		{
+			name: "saved items in saved order :POS",
+			refs: []models.SavedRef{
+				{Type: models.SavedItemTypePost, ID: postID2, SavedAt: savedAt.Add(2 * time.Second)},
+				{Type: models.SavedItemTypeComment, ID: commentID1, SavedAt: savedAt.Add(time.Second)},
+				{Type: models.SavedItemTypePost, ID: postID1, SavedAt: savedAt},
+			},
+			posts: []models.PostPaginated{
+				{ID: postID1, Title: "post 1", Saved: true},
+				{ID: postID2, Title: "post 2", Saved: true},
+			},
+			comments: []models.UserComment{
+				{CommentAuthor: models.CommentAuthor{Comment: models.Comment{ID: commentID1}}},
+			},
+			wantItems: []models.SavedItem{
+				{
+					Type:    models.SavedItemTypePost,
+					SavedAt: savedAt.Add(2 * time.Second),
+					Post:    &models.PostPaginated{ID: postID2, Title: "post 2", Saved: true},
+				},
+				{
+					Type:    models.SavedItemTypeComment,
+					SavedAt: savedAt.Add(time.Second),
+					Comment: &models.UserComment{CommentAuthor: models.CommentAuthor{Comment: models.Comment{ID: commentID1}}},
+				},
+				{
+					Type:    models.SavedItemTypePost,
+					SavedAt: savedAt,
+					Post:    &models.PostPaginated{ID: postID1, Title: "post 1", Saved: true},
+				},
+			},
+			wantPostIDs:    []uuid.UUID{postID2, postID1},
+			wantCommentIDs: []uuid.UUID{commentID1},
+		},
+		{
+			name: "removed saved post left out :POS",
+			refs: []models.SavedRef{
+				{Type: models.SavedItemTypePost, ID: postID2, SavedAt: savedAt.Add(time.Second)},
+				{Type: models.SavedItemTypePost, ID: postID1, SavedAt: savedAt},
+			},
+			posts: []models.PostPaginated{
+				{ID: postID1, Title: "post 1", Saved: true},
+			},
+			wantItems: []models.SavedItem{
+				{
+					Type:    models.SavedItemTypePost,
+					SavedAt: savedAt,
+					Post:    &models.PostPaginated{ID: postID1, Title: "post 1", Saved: true},
+				},
+			},
+			wantPostIDs: []uuid.UUID{postID2, postID1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeRepo := savedfakes.FakeSavedRepository{}
+			fakeRepo.SavedRefsReturns(tt.refs, tt.refsErr)
+			fakePostRepo := savedfakes.FakePostRepository{}
+			fakePostRepo.PostsByIDsReturns(tt.posts, nil)
+			fakeCommentRepo := savedfakes.FakeCommentRepository{}
+			fakeCommentRepo.CommentsByIDsReturns(tt.comments, nil)
+			service := savedservice.NewService(&fakeRepo, &fakePostRepo, &fakeCommentRepo)
+
+			gotItems, gotErr := service.Saved(context.Background(), userID, 0, 10)
+
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantItems, gotItems, "expect saved items to match")
+			if tt.wantPostIDs != nil {
+				_, gotPostIDs, gotViewerID := fakePostRepo.PostsByIDsArgsForCall(0)
+				assert.Equal(t, tt.wantPostIDs, gotPostIDs, "expect post ids to match")
+				assert.Equal(t, userID, gotViewerID, "expect viewer id to match")
+			}
+			if tt.wantCommentIDs != nil {
+				_, gotCommentIDs := fakeCommentRepo.CommentsByIDsArgsForCall(0)
+				assert.Equal(t, tt.wantCommentIDs, gotCommentIDs, "expect comment ids to match")
+			}
+		})
+	}
+}
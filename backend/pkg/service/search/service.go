@@ -0,0 +1,37 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, query string, searchType models.SearchType, skip, limit int) ([]models.SearchResult, error)
+}
+
+//counterfeiter:generate . SearchRepository
+type SearchRepository interface {
+	Search(ctx context.Context, query string, searchType models.SearchType, skip, limit int) ([]models.SearchResult, error)
+}
+
+type Service struct {
+	repo SearchRepository
+}
+
+func NewService(repo SearchRepository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// Search returns the matches of query among the entities of searchType. A
+// blank query matches nothing.
+func (s *Service) Search(ctx context.Context, query string, searchType models.SearchType, skip, limit int) ([]models.SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return []models.SearchResult{}, nil
+	}
+	return s.repo.Search(ctx, query, searchType, skip, limit)
+}
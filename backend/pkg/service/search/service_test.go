@@ -0,0 +1,120 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	searchservice "github.com/glowfi/voxpopuli/backend/pkg/service/search"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/search/searchfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Search(t *testing.T) {
+	results := []models.SearchResult{
+		{
+			Type:      models.SearchTypePost,
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Title:     "Learning Go generics",
+			Snippet:   "<mark>Generics</mark> make containers reusable.",
+			Rank:      0.6,
+			CreatedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+		},
+	}
+
+	type args struct {
+		query      string
+		searchType models.SearchType
+		skip       int
+		limit      int
+	}
+	type mockReturns struct {
+		results     []models.SearchResult
+		searchError error
+	}
+
+	tests := []struct {
+		name          string
+		args          args
+		mockReturns   mockReturns
+		wantResults   []models.SearchResult
+		wantErr       error
+		wantRepoCalls int
+		wantQuery     string
+	}{
+		{
+			name: "blank query :POS",
+			args: args{
+				query:      "   ",
+				searchType: models.SearchTypePost,
+				skip:       0,
+				limit:      10,
+			},
+			wantResults:   []models.SearchResult{},
+			wantErr:       nil,
+			wantRepoCalls: 0,
+		},
+		{
+			name: "repo error :NEG",
+			args: args{
+				query:      "generics",
+				searchType: models.SearchTypePost,
+				skip:       0,
+				limit:      10,
+			},
+			mockReturns: mockReturns{
+				results:     []models.SearchResult{},
+				searchError: errors.New("db error"),
+			},
+			wantResults:   []models.SearchResult{},
+			wantErr:       errors.New("db error"),
+			wantRepoCalls: 1,
+			wantQuery:     "generics",
+		},
+		{
+			name: "trimmed query :POS",
+			args: args{
+				query:      "  generics ",
+				searchType: models.SearchTypePost,
+				skip:       0,
+				limit:      10,
+			},
+			mockReturns: mockReturns{
+				results: results,
+			},
+			wantResults:   results,
+			wantErr:       nil,
+			wantRepoCalls: 1,
+			wantQuery:     "generics",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSearchRepo := searchfakes.FakeSearchRepository{}
+			fakeSearchRepo.SearchReturns(tt.mockReturns.results, tt.mockReturns.searchError)
+
+			service := searchservice.NewService(&fakeSearchRepo)
+
+			gotResults, gotErr := service.Search(context.Background(), tt.args.query, tt.args.searchType, tt.args.skip, tt.args.limit)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, gotErr, tt.wantErr.Error(), "expect error to match")
+			} else {
+				assert.NoError(t, gotErr, "expect no error")
+			}
+			assert.Equal(t, tt.wantResults, gotResults, "expect search results to match")
+			assert.Equal(t, tt.wantRepoCalls, fakeSearchRepo.SearchCallCount(), "expect repo calls to match")
+
+			if tt.wantRepoCalls == 1 {
+				_, gotQuery, gotType, gotSkip, gotLimit := fakeSearchRepo.SearchArgsForCall(0)
+				assert.Equal(t, tt.wantQuery, gotQuery, "expect query to match")
+				assert.Equal(t, tt.args.searchType, gotType, "expect search type to match")
+				assert.Equal(t, tt.args.skip, gotSkip, "expect skip to match")
+				assert.Equal(t, tt.args.limit, gotLimit, "expect limit to match")
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package trophy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/trophy"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	relationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/relation"
+	"github.com/google/uuid"
+)
+
+// evaluationBatchSize is how many users EvaluateUsers evaluates at a time.
+const evaluationBatchSize = 500
+
+type TrophyService interface {
+	EvaluateUsers(ctx context.Context) (int, error)
+}
+
+//counterfeiter:generate . TrophyRepository
+type TrophyRepository interface {
+	TrophyCriteria(ctx context.Context) ([]models.TrophyCriteria, error)
+	UsersAchievementsAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]models.UserAchievements, error)
+}
+
+//counterfeiter:generate . UserTrophyRepository
+type UserTrophyRepository interface {
+	LinkUserTrophies(ctx context.Context, userTrophies ...models.UserTrophy) ([]models.UserTrophy, error)
+}
+
+type Service struct {
+	repo           TrophyRepository
+	userTrophyRepo UserTrophyRepository
+}
+
+func NewService(repo TrophyRepository, userTrophyRepo UserTrophyRepository) *Service {
+	return &Service{
+		repo:           repo,
+		userTrophyRepo: userTrophyRepo,
+	}
+}
+
+// rule is a trophy along with the criteria it is granted by.
+type rule struct {
+	trophyID uuid.UUID
+	criteria trophy.Criteria
+}
+
+// EvaluateUsers grants every user the trophies they earned and do not hold
+// yet, returning how many trophies were granted. It is meant to be run on a
+// schedule.
+func (s *Service) EvaluateUsers(ctx context.Context) (int, error) {
+	rules, err := s.rules(ctx)
+	if err != nil || len(rules) == 0 {
+		return 0, err
+	}
+
+	granted := 0
+	afterID := uuid.Nil
+	for {
+		batch, err := s.repo.UsersAchievementsAfter(ctx, afterID, evaluationBatchSize)
+		if err != nil {
+			return granted, err
+		}
+		if len(batch) == 0 {
+			return granted, nil
+		}
+
+		now := time.Now()
+		earned := make([]models.UserTrophy, 0)
+		for _, achievements := range batch {
+			earned = append(earned, earnedTrophies(rules, achievements, now)...)
+		}
+		userTrophies, err := s.grant(ctx, earned)
+		granted += len(userTrophies)
+		if err != nil {
+			return granted, err
+		}
+
+		if len(batch) < evaluationBatchSize {
+			return granted, nil
+		}
+		afterID = batch[len(batch)-1].UserID
+	}
+}
+
+// rules returns the trophies that have criteria along with their criteria.
+func (s *Service) rules(ctx context.Context) ([]rule, error) {
+	trophyCriteria, err := s.repo.TrophyCriteria(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]rule, 0, len(trophyCriteria))
+	for _, tc := range trophyCriteria {
+		criteria, err := trophy.Parse(tc.Criteria)
+		if err != nil {
+			return nil, fmt.Errorf("trophy %s: %w", tc.TrophyID, err)
+		}
+		rules = append(rules, rule{trophyID: tc.TrophyID, criteria: criteria})
+	}
+	return rules, nil
+}
+
+// earnedTrophies returns the trophies of rules the user of achievements
+// earned as of now, leaving out the ones they already hold.
+func earnedTrophies(rules []rule, achievements models.UserAchievements, now time.Time) []models.UserTrophy {
+	held := make(map[uuid.UUID]bool, len(achievements.TrophyIDs))
+	for _, trophyID := range achievements.TrophyIDs {
+		held[trophyID] = true
+	}
+
+	subject := trophy.Achievements{
+		CreatedAt:      achievements.CreatedAt,
+		Karma:          achievements.Karma,
+		NumPosts:       achievements.NumPosts,
+		NumTopPosts:    achievements.NumTopPosts,
+		AwardsReceived: achievements.AwardsReceived,
+	}
+
+	earned := make([]models.UserTrophy, 0)
+	for _, r := range rules {
+		if held[r.trophyID] || !r.criteria.Earned(subject, now) {
+			continue
+		}
+		earned = append(earned, models.UserTrophy{UserID: achievements.UserID, TrophyID: r.trophyID})
+	}
+	return earned
+}
+
+// grant links userTrophies. When another evaluation granted some of them in
+// the meantime, the rest are linked one at a time.
+func (s *Service) grant(ctx context.Context, userTrophies []models.UserTrophy) ([]models.UserTrophy, error) {
+	if len(userTrophies) == 0 {
+		return nil, nil
+	}
+
+	granted, err := s.userTrophyRepo.LinkUserTrophies(ctx, userTrophies...)
+	if !errors.Is(err, relationrepo.ErrDuplicateID) {
+		return granted, err
+	}
+
+	granted = make([]models.UserTrophy, 0, len(userTrophies))
+	for _, userTrophy := range userTrophies {
+		linked, err := s.userTrophyRepo.LinkUserTrophies(ctx, userTrophy)
+		if errors.Is(err, relationrepo.ErrDuplicateID) {
+			continue
+		}
+		if err != nil {
+			return granted, err
+		}
+		granted = append(granted, linked...)
+	}
+	return granted, nil
+}
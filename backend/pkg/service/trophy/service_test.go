@@ -0,0 +1,133 @@
+package trophy_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/trophy"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	relationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/relation"
+	trophyservice "github.com/glowfi/voxpopuli/backend/pkg/service/trophy"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/trophy/trophyfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	firstPostTrophyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	veteranTrophyID   = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	johnID            = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	janeID            = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+)
+
+var trophyCriteria = []models.TrophyCriteria{
+	{TrophyID: firstPostTrophyID, Criteria: json.RawMessage(`{"first_post": true}`)},
+	{TrophyID: veteranTrophyID, Criteria: json.RawMessage(`{"account_age_days": 365}`)},
+}
+
+func TestService_EvaluateUsersEarned(t *testing.T) {
+	tests := []struct {
+		name         string
+		achievements models.UserAchievements
+		wantGranted  []models.UserTrophy
+	}{
+		{
+			name: "nothing earned :POS",
+			achievements: models.UserAchievements{
+				UserID:    johnID,
+				CreatedAt: time.Now().Add(-time.Hour),
+			},
+			wantGranted: nil,
+		},
+		{
+			name: "earned trophies :POS",
+			achievements: models.UserAchievements{
+				UserID:    johnID,
+				CreatedAt: time.Now().AddDate(-2, 0, 0),
+				NumPosts:  1,
+			},
+			wantGranted: []models.UserTrophy{
+				{UserID: johnID, TrophyID: firstPostTrophyID},
+				{UserID: johnID, TrophyID: veteranTrophyID},
+			},
+		},
+		{
+			name: "held trophies are left out :POS",
+			achievements: models.UserAchievements{
+				UserID:    johnID,
+				CreatedAt: time.Now().AddDate(-2, 0, 0),
+				NumPosts:  1,
+				TrophyIDs: []uuid.UUID{firstPostTrophyID},
+			},
+			wantGranted: []models.UserTrophy{
+				{UserID: johnID, TrophyID: veteranTrophyID},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeRepo := trophyfakes.FakeTrophyRepository{}
+			fakeRepo.TrophyCriteriaReturns(trophyCriteria, nil)
+			fakeRepo.UsersAchievementsAfterReturns([]models.UserAchievements{tt.achievements}, nil)
+			fakeUserTrophyRepo := trophyfakes.FakeUserTrophyRepository{}
+			fakeUserTrophyRepo.LinkUserTrophiesStub = func(_ context.Context, uts ...models.UserTrophy) ([]models.UserTrophy, error) {
+				return uts, nil
+			}
+			service := trophyservice.NewService(&fakeRepo, &fakeUserTrophyRepo)
+
+			gotGranted, gotErr := service.EvaluateUsers(context.Background())
+			assert.NoError(t, gotErr, "expect no error")
+			assert.Equal(t, len(tt.wantGranted), gotGranted, "expect granted count to match")
+
+			if tt.wantGranted == nil {
+				assert.Equal(t, 0, fakeUserTrophyRepo.LinkUserTrophiesCallCount(), "expect no trophy to be linked")
+				return
+			}
+			_, gotLinked := fakeUserTrophyRepo.LinkUserTrophiesArgsForCall(0)
+			assert.Equal(t, tt.wantGranted, gotLinked, "expect granted trophies to match")
+		})
+	}
+}
+
+func TestService_EvaluateUsers(t *testing.T) {
+	t.Run("invalid stored criteria :NEG", func(t *testing.T) {
+		fakeRepo := trophyfakes.FakeTrophyRepository{}
+		fakeRepo.TrophyCriteriaReturns([]models.TrophyCriteria{
+			{TrophyID: firstPostTrophyID, Criteria: json.RawMessage(`{}`)},
+		}, nil)
+		service := trophyservice.NewService(&fakeRepo, &trophyfakes.FakeUserTrophyRepository{})
+
+		_, gotErr := service.EvaluateUsers(context.Background())
+		assert.ErrorIs(t, gotErr, trophy.ErrInvalidCriteria, "expect error to match")
+		assert.Equal(t, 0, fakeRepo.UsersAchievementsAfterCallCount(), "expect no user to be evaluated")
+	})
+
+	t.Run("trophies granted in the meantime are skipped :POS", func(t *testing.T) {
+		fakeRepo := trophyfakes.FakeTrophyRepository{}
+		fakeRepo.TrophyCriteriaReturns(trophyCriteria[:1], nil)
+		fakeRepo.UsersAchievementsAfterReturns([]models.UserAchievements{
+			{UserID: johnID, NumPosts: 1},
+			{UserID: janeID, NumPosts: 2},
+		}, nil)
+		fakeUserTrophyRepo := trophyfakes.FakeUserTrophyRepository{}
+		fakeUserTrophyRepo.LinkUserTrophiesStub = func(_ context.Context, uts ...models.UserTrophy) ([]models.UserTrophy, error) {
+			for _, ut := range uts {
+				if ut.UserID == johnID {
+					return nil, relationrepo.ErrDuplicateID
+				}
+			}
+			return uts, nil
+		}
+		service := trophyservice.NewService(&fakeRepo, &fakeUserTrophyRepo)
+
+		gotGranted, gotErr := service.EvaluateUsers(context.Background())
+		assert.NoError(t, gotErr, "expect no error")
+		assert.Equal(t, 1, gotGranted, "expect only jane to be granted a trophy")
+		assert.Equal(t, 1, fakeRepo.UsersAchievementsAfterCallCount(), "expect a single batch")
+
+		_, gotAfterID, _ := fakeRepo.UsersAchievementsAfterArgsForCall(0)
+		assert.Equal(t, uuid.Nil, gotAfterID, "expect evaluation to start from the first user")
+	})
+}
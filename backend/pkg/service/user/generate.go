@@ -0,0 +1,3 @@
+package user
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+type UserService interface {
+	UserProfileByName(ctx context.Context, name string) (models.UserProfile, error)
+}
+
+//counterfeiter:generate . UserRepository
+type UserRepository interface {
+	UserByName(ctx context.Context, name string) (models.User, error)
+	TrophiesByUserID(ctx context.Context, ID uuid.UUID) ([]models.Trophy, error)
+	UserFlairsByUserID(ctx context.Context, ID uuid.UUID) ([]models.UserFlairRendered, error)
+	KarmaByUserID(ctx context.Context, ID uuid.UUID) (models.UserKarma, error)
+}
+
+type Service struct {
+	repo UserRepository
+}
+
+func NewService(repo UserRepository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+func (s *Service) UserProfileByName(ctx context.Context, name string) (models.UserProfile, error) {
+	user, err := s.repo.UserByName(ctx, name)
+	if err != nil {
+		return models.UserProfile{}, err
+	}
+
+	trophies, err := s.repo.TrophiesByUserID(ctx, user.ID)
+	if err != nil {
+		return models.UserProfile{}, err
+	}
+
+	flairs, err := s.repo.UserFlairsByUserID(ctx, user.ID)
+	if err != nil {
+		return models.UserProfile{}, err
+	}
+
+	karma, err := s.repo.KarmaByUserID(ctx, user.ID)
+	if err != nil {
+		return models.UserProfile{}, err
+	}
+
+	return models.UserProfile{
+		User:     user,
+		Trophies: trophies,
+		Flairs:   flairs,
+		Karma:    karma,
+	}, nil
+}
@@ -0,0 +1,172 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	userrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user"
+	userservice "github.com/glowfi/voxpopuli/backend/pkg/service/user"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/user/userfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_UserProfileByName(t *testing.T) {
+	user := models.User{
+		ID:                uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Name:              "John Doe",
+		PublicDescription: ptrof("This is a public description"),
+		AvatarImg:         ptrof("https://example.com/avatar1.jpg"),
+		BannerImg:         ptrof("https://example.com/banner1.jpg"),
+		Iconcolor:         ptrof("#FF0000"),
+		Keycolor:          ptrof("#00FF00"),
+		Primarycolor:      ptrof("#0000FF"),
+		Over18:            true,
+		Suspended:         false,
+		CreatedAt:         time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+		CreatedAtUnix:     1725091100,
+		UpdatedAt:         time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+	}
+	trophies := []models.Trophy{
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Title:       "Verified Email",
+			Description: "Verified the email address of the account",
+			ImageLink:   "https://example.com/trophy1.png",
+		},
+	}
+	flairs := []models.UserFlairRendered{
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Voxsphere:       "v/foo",
+			FullText:        "foo fan",
+			BackgroundColor: "#ff0000",
+			Richtext: []models.FlairRichtext{
+				{
+					Type:       models.FlairRichtextTypeText,
+					OrderIndex: 1,
+					Text:       "foo fan",
+				},
+			},
+		},
+	}
+	karma := models.UserKarma{
+		PostKarma:    5,
+		CommentKarma: 2,
+		Total:        7,
+	}
+
+	type args struct {
+		name string
+	}
+	type mockReturns struct {
+		user          models.User
+		userError     error
+		trophies      []models.Trophy
+		trophiesError error
+		flairs        []models.UserFlairRendered
+		flairsError   error
+		karma         models.UserKarma
+		karmaError    error
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		mockReturns mockReturns
+		wantProfile models.UserProfile
+		wantErr     error
+	}{
+		{
+			name: "user not found :NEG",
+			args: args{
+				name: "Nobody",
+			},
+			mockReturns: mockReturns{
+				userError: userrepo.ErrUserNotFound,
+			},
+			wantProfile: models.UserProfile{},
+			wantErr:     userrepo.ErrUserNotFound,
+		},
+		{
+			name: "trophies error :NEG",
+			args: args{
+				name: "John Doe",
+			},
+			mockReturns: mockReturns{
+				user:          user,
+				trophiesError: errors.New("db error"),
+			},
+			wantProfile: models.UserProfile{},
+			wantErr:     errors.New("db error"),
+		},
+		{
+			name: "karma error :NEG",
+			args: args{
+				name: "John Doe",
+			},
+			mockReturns: mockReturns{
+				user:       user,
+				trophies:   trophies,
+				flairs:     flairs,
+				karmaError: errors.New("db error"),
+			},
+			wantProfile: models.UserProfile{},
+			wantErr:     errors.New("db error"),
+		},
+		{
+			name: "user profile :POS",
+			args: args{
+				name: "John Doe",
+			},
+			mockReturns: mockReturns{
+				user:     user,
+				trophies: trophies,
+				flairs:   flairs,
+				karma:    karma,
+			},
+			wantProfile: models.UserProfile{
+				User:     user,
+				Trophies: trophies,
+				Flairs:   flairs,
+				Karma:    karma,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserRepo := userfakes.FakeUserRepository{}
+			fakeUserRepo.UserByNameReturns(tt.mockReturns.user, tt.mockReturns.userError)
+			fakeUserRepo.TrophiesByUserIDReturns(tt.mockReturns.trophies, tt.mockReturns.trophiesError)
+			fakeUserRepo.UserFlairsByUserIDReturns(tt.mockReturns.flairs, tt.mockReturns.flairsError)
+			fakeUserRepo.KarmaByUserIDReturns(tt.mockReturns.karma, tt.mockReturns.karmaError)
+
+			service := userservice.NewService(&fakeUserRepo)
+
+			gotProfile, gotErr := service.UserProfileByName(context.Background(), tt.args.name)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, gotErr, tt.wantErr.Error(), "expect error to match")
+			} else {
+				assert.NoError(t, gotErr, "expect no error")
+			}
+			assert.Equal(t, tt.wantProfile, gotProfile, "expect user profile to match")
+
+			_, gotName := fakeUserRepo.UserByNameArgsForCall(0)
+			assert.Equal(t, tt.args.name, gotName, "expect user name to match")
+			if tt.wantErr == nil {
+				_, gotID := fakeUserRepo.KarmaByUserIDArgsForCall(0)
+				assert.Equal(t, user.ID, gotID, "expect user id to match")
+			}
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,175 @@
+package userflair
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	maxFlairPieces     = 10
+	maxFlairTextLength = 64
+)
+
+var (
+	ErrUserFlairNotModerator  = errors.New("only moderators of a voxsphere can change its user flairs")
+	ErrUserFlairNotMember     = errors.New("only members of a voxsphere can wear its user flairs")
+	ErrUserFlairInvalidPieces = fmt.Errorf("a user flair must have 1 to %d pieces", maxFlairPieces)
+	ErrUserFlairInvalidText   = fmt.Errorf("text pieces must be 1 to %d characters long", maxFlairTextLength)
+	ErrUserFlairInvalidEmoji  = errors.New("emoji pieces must name their emoji")
+	ErrUserFlairInvalidType   = errors.New("pieces must be text, emoji or custom_emoji")
+	ErrUserFlairInvalidColor  = errors.New("background color must be a hex color like #FFFFFF")
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+type UserFlairService interface {
+	VoxsphereUserFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.UserFlairRendered, error)
+	CreateUserFlair(ctx context.Context, voxsphereID, moderatorID uuid.UUID, template models.UserFlairTemplate) (models.UserFlairRendered, error)
+	EditUserFlair(ctx context.Context, voxsphereID, userFlairID, moderatorID uuid.UUID, template models.UserFlairTemplate) (models.UserFlairRendered, error)
+	SetUserFlair(ctx context.Context, userID, voxsphereID, userFlairID uuid.UUID) error
+	ClearUserFlair(ctx context.Context, userID, voxsphereID uuid.UUID) error
+}
+
+//counterfeiter:generate . UserFlairRepository
+type UserFlairRepository interface {
+	VoxsphereUserFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.UserFlairRendered, error)
+	AddUserFlairTemplate(ctx context.Context, userFlair models.UserFlair, richtext []models.FlairRichtextPiece) (models.UserFlairRendered, error)
+	UpdateUserFlairTemplate(ctx context.Context, userFlair models.UserFlair, richtext []models.FlairRichtextPiece) (models.UserFlairRendered, error)
+	SetUserFlair(ctx context.Context, userID, voxsphereID, userFlairID uuid.UUID) error
+	ClearUserFlair(ctx context.Context, userID, voxsphereID uuid.UUID) error
+}
+
+//counterfeiter:generate . ModeratorRepository
+type ModeratorRepository interface {
+	IsVoxsphereModerator(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+}
+
+//counterfeiter:generate . MembershipRepository
+type MembershipRepository interface {
+	IsVoxsphereMember(ctx context.Context, voxsphereID, userID uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	repo           UserFlairRepository
+	moderatorRepo  ModeratorRepository
+	membershipRepo MembershipRepository
+}
+
+func NewService(repo UserFlairRepository, moderatorRepo ModeratorRepository, membershipRepo MembershipRepository) *Service {
+	return &Service{
+		repo:           repo,
+		moderatorRepo:  moderatorRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// VoxsphereUserFlairs returns the user flairs members of the voxsphere of
+// voxsphereID can pick.
+func (s *Service) VoxsphereUserFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.UserFlairRendered, error) {
+	return s.repo.VoxsphereUserFlairs(ctx, voxsphereID)
+}
+
+// CreateUserFlair adds template as a new user flair of the voxsphere of
+// voxsphereID on behalf of the moderator of moderatorID.
+func (s *Service) CreateUserFlair(ctx context.Context, voxsphereID, moderatorID uuid.UUID, template models.UserFlairTemplate) (models.UserFlairRendered, error) {
+	if err := validateTemplate(template); err != nil {
+		return models.UserFlairRendered{}, err
+	}
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.UserFlairRendered{}, err
+	}
+
+	userFlair := models.UserFlair{
+		ID:              uuid.New(),
+		VoxsphereID:     voxsphereID,
+		BackgroundColor: template.BackgroundColor,
+	}
+	return s.repo.AddUserFlairTemplate(ctx, userFlair, template.Richtext)
+}
+
+// EditUserFlair replaces the user flair of userFlairID in the voxsphere of
+// voxsphereID with template on behalf of the moderator of moderatorID.
+// Members wearing the flair keep it.
+func (s *Service) EditUserFlair(ctx context.Context, voxsphereID, userFlairID, moderatorID uuid.UUID, template models.UserFlairTemplate) (models.UserFlairRendered, error) {
+	if err := validateTemplate(template); err != nil {
+		return models.UserFlairRendered{}, err
+	}
+	if err := s.requireModerator(ctx, voxsphereID, moderatorID); err != nil {
+		return models.UserFlairRendered{}, err
+	}
+
+	userFlair := models.UserFlair{
+		ID:              userFlairID,
+		VoxsphereID:     voxsphereID,
+		BackgroundColor: template.BackgroundColor,
+	}
+	return s.repo.UpdateUserFlairTemplate(ctx, userFlair, template.Richtext)
+}
+
+// SetUserFlair makes the user of userID wear the user flair of userFlairID in
+// the voxsphere of voxsphereID, which the user has to be a member of.
+func (s *Service) SetUserFlair(ctx context.Context, userID, voxsphereID, userFlairID uuid.UUID) error {
+	isMember, err := s.membershipRepo.IsVoxsphereMember(ctx, voxsphereID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrUserFlairNotMember
+	}
+
+	return s.repo.SetUserFlair(ctx, userID, voxsphereID, userFlairID)
+}
+
+// ClearUserFlair takes off the flair the user of userID wears in the
+// voxsphere of voxsphereID.
+func (s *Service) ClearUserFlair(ctx context.Context, userID, voxsphereID uuid.UUID) error {
+	return s.repo.ClearUserFlair(ctx, userID, voxsphereID)
+}
+
+// requireModerator fails with ErrUserFlairNotModerator unless the user of
+// userID moderates the voxsphere of voxsphereID.
+func (s *Service) requireModerator(ctx context.Context, voxsphereID, userID uuid.UUID) error {
+	isModerator, err := s.moderatorRepo.IsVoxsphereModerator(ctx, voxsphereID, userID)
+	if err != nil {
+		return err
+	}
+	if !isModerator {
+		return ErrUserFlairNotModerator
+	}
+	return nil
+}
+
+func validateTemplate(template models.UserFlairTemplate) error {
+	if template.BackgroundColor != "" && !colorPattern.MatchString(template.BackgroundColor) {
+		return ErrUserFlairInvalidColor
+	}
+	if len(template.Richtext) == 0 || len(template.Richtext) > maxFlairPieces {
+		return ErrUserFlairInvalidPieces
+	}
+
+	for _, piece := range template.Richtext {
+		switch piece.Type {
+		case models.FlairRichtextTypeText:
+			if len(piece.Text) == 0 || utf8.RuneCountInString(piece.Text) > maxFlairTextLength {
+				return ErrUserFlairInvalidText
+			}
+		case models.FlairRichtextTypeEmoji:
+			if piece.EmojiID == uuid.Nil {
+				return ErrUserFlairInvalidEmoji
+			}
+		case models.FlairRichtextTypeCustomEmoji:
+			if piece.CustomEmojiID == uuid.Nil {
+				return ErrUserFlairInvalidEmoji
+			}
+		default:
+			return ErrUserFlairInvalidType
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package userflair_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	userflairrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user_flair"
+	userflairservice "github.com/glowfi/voxpopuli/backend/pkg/service/user_flair"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/user_flair/user_flairfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	voxsphereID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	flairID     = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	moderatorID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	userID      = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	emojiID     = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+)
+
+var template = models.UserFlairTemplate{
+	BackgroundColor: "#FFFFFF",
+	Richtext: []models.FlairRichtextPiece{
+		{Type: models.FlairRichtextTypeText, Text: "desc1 "},
+		{Type: models.FlairRichtextTypeEmoji, EmojiID: emojiID},
+	},
+}
+
+func TestService_CreateUserFlair(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    models.UserFlairTemplate
+		isModerator bool
+		wantErr     error
+		wantAddCall bool
+	}{
+		{
+			name:        "invalid background color :NEG",
+			template:    models.UserFlairTemplate{BackgroundColor: "white", Richtext: template.Richtext},
+			isModerator: true,
+			wantErr:     userflairservice.ErrUserFlairInvalidColor,
+		},
+		{
+			name:        "no pieces :NEG",
+			template:    models.UserFlairTemplate{},
+			isModerator: true,
+			wantErr:     userflairservice.ErrUserFlairInvalidPieces,
+		},
+		{
+			name: "text piece too long :NEG",
+			template: models.UserFlairTemplate{Richtext: []models.FlairRichtextPiece{
+				{Type: models.FlairRichtextTypeText, Text: strings.Repeat("a", 65)},
+			}},
+			isModerator: true,
+			wantErr:     userflairservice.ErrUserFlairInvalidText,
+		},
+		{
+			name: "custom emoji piece without emoji :NEG",
+			template: models.UserFlairTemplate{Richtext: []models.FlairRichtextPiece{
+				{Type: models.FlairRichtextTypeCustomEmoji, EmojiID: emojiID},
+			}},
+			isModerator: true,
+			wantErr:     userflairservice.ErrUserFlairInvalidEmoji,
+		},
+		{
+			name: "unknown piece type :NEG",
+			template: models.UserFlairTemplate{Richtext: []models.FlairRichtextPiece{
+				{Type: "image", Text: "foo"},
+			}},
+			isModerator: true,
+			wantErr:     userflairservice.ErrUserFlairInvalidType,
+		},
+		{
+			name:        "not a moderator :NEG",
+			template:    template,
+			isModerator: false,
+			wantErr:     userflairservice.ErrUserFlairNotModerator,
+		},
+		{
+			name:        "moderator :POS",
+			template:    template,
+			isModerator: true,
+			wantAddCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserFlairRepo := user_flairfakes.FakeUserFlairRepository{}
+			fakeModeratorRepo := user_flairfakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := userflairservice.NewService(&fakeUserFlairRepo, &fakeModeratorRepo, &user_flairfakes.FakeMembershipRepository{})
+
+			_, gotErr := service.CreateUserFlair(context.Background(), voxsphereID, moderatorID, tt.template)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if !tt.wantAddCall {
+				assert.Equal(t, 0, fakeUserFlairRepo.AddUserFlairTemplateCallCount(), "expect no flair to be added")
+				return
+			}
+			_, gotFlair, gotRichtext := fakeUserFlairRepo.AddUserFlairTemplateArgsForCall(0)
+			assert.NotEqual(t, uuid.Nil, gotFlair.ID, "expect flair to get an id")
+			assert.Equal(t, voxsphereID, gotFlair.VoxsphereID, "expect voxsphere to match")
+			assert.Equal(t, tt.template.BackgroundColor, gotFlair.BackgroundColor, "expect background color to match")
+			assert.Equal(t, tt.template.Richtext, gotRichtext, "expect pieces to match")
+		})
+	}
+}
+
+func TestService_EditUserFlair(t *testing.T) {
+	tests := []struct {
+		name           string
+		isModerator    bool
+		updateErr      error
+		wantErr        error
+		wantUpdateCall bool
+	}{
+		{
+			name:        "not a moderator :NEG",
+			isModerator: false,
+			wantErr:     userflairservice.ErrUserFlairNotModerator,
+		},
+		{
+			name:           "flair of another voxsphere :NEG",
+			isModerator:    true,
+			updateErr:      userflairrepo.ErrUserFlairNotFound,
+			wantErr:        userflairrepo.ErrUserFlairNotFound,
+			wantUpdateCall: true,
+		},
+		{
+			name:           "moderator :POS",
+			isModerator:    true,
+			wantUpdateCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserFlairRepo := user_flairfakes.FakeUserFlairRepository{}
+			fakeUserFlairRepo.UpdateUserFlairTemplateReturns(models.UserFlairRendered{}, tt.updateErr)
+			fakeModeratorRepo := user_flairfakes.FakeModeratorRepository{}
+			fakeModeratorRepo.IsVoxsphereModeratorReturns(tt.isModerator, nil)
+			service := userflairservice.NewService(&fakeUserFlairRepo, &fakeModeratorRepo, &user_flairfakes.FakeMembershipRepository{})
+
+			_, gotErr := service.EditUserFlair(context.Background(), voxsphereID, flairID, moderatorID, template)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+
+			if !tt.wantUpdateCall {
+				assert.Equal(t, 0, fakeUserFlairRepo.UpdateUserFlairTemplateCallCount(), "expect flair to stay as is")
+				return
+			}
+			_, gotFlair, _ := fakeUserFlairRepo.UpdateUserFlairTemplateArgsForCall(0)
+			assert.Equal(t, models.UserFlair{ID: flairID, VoxsphereID: voxsphereID, BackgroundColor: "#FFFFFF"}, gotFlair, "expect flair to match")
+		})
+	}
+}
+
+func TestService_SetUserFlair(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name         string
+		isMember     bool
+		memberErr    error
+		repoErr      error
+		wantErr      error
+		wantSetCalls int
+	}{
+		{
+			name:     "not a member :NEG",
+			isMember: false,
+			wantErr:  userflairservice.ErrUserFlairNotMember,
+		},
+		{
+			name:      "membership lookup fails :NEG",
+			memberErr: errDB,
+			wantErr:   errDB,
+		},
+		{
+			name:         "flair of another voxsphere :NEG",
+			isMember:     true,
+			repoErr:      userflairrepo.ErrUserFlairNotInVoxsphere,
+			wantErr:      userflairrepo.ErrUserFlairNotInVoxsphere,
+			wantSetCalls: 1,
+		},
+		{
+			name:         "set flair :POS",
+			isMember:     true,
+			wantErr:      nil,
+			wantSetCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserFlairRepo := user_flairfakes.FakeUserFlairRepository{}
+			fakeUserFlairRepo.SetUserFlairReturns(tt.repoErr)
+			fakeMembershipRepo := user_flairfakes.FakeMembershipRepository{}
+			fakeMembershipRepo.IsVoxsphereMemberReturns(tt.isMember, tt.memberErr)
+			service := userflairservice.NewService(&fakeUserFlairRepo, &user_flairfakes.FakeModeratorRepository{}, &fakeMembershipRepo)
+
+			gotErr := service.SetUserFlair(context.Background(), userID, voxsphereID, flairID)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantSetCalls, fakeUserFlairRepo.SetUserFlairCallCount(), "expect set flair call count to match")
+
+			_, gotVoxsphereID, gotUserID := fakeMembershipRepo.IsVoxsphereMemberArgsForCall(0)
+			assert.Equal(t, voxsphereID, gotVoxsphereID, "expect membership of the voxsphere to be checked")
+			assert.Equal(t, userID, gotUserID, "expect membership of the user to be checked")
+			if tt.wantSetCalls == 0 {
+				return
+			}
+			_, gotUserID, gotVoxsphereID, gotFlairID := fakeUserFlairRepo.SetUserFlairArgsForCall(0)
+			assert.Equal(t, userID, gotUserID, "expect user to match")
+			assert.Equal(t, voxsphereID, gotVoxsphereID, "expect voxsphere to match")
+			assert.Equal(t, flairID, gotFlairID, "expect flair to match")
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package vote
+
+import (
+	"context"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+type VoteService interface {
+	VotePost(ctx context.Context, postID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error)
+	VoteComment(ctx context.Context, commentID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error)
+}
+
+//counterfeiter:generate . VoteRepository
+type VoteRepository interface {
+	VotePost(ctx context.Context, postID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error)
+	VoteComment(ctx context.Context, commentID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error)
+}
+
+type Service struct {
+	repo VoteRepository
+}
+
+func NewService(repo VoteRepository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+func (s *Service) VotePost(ctx context.Context, postID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error) {
+	return s.repo.VotePost(ctx, postID, userID, direction)
+}
+
+func (s *Service) VoteComment(ctx context.Context, commentID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error) {
+	return s.repo.VoteComment(ctx, commentID, userID, direction)
+}
@@ -0,0 +1,159 @@
+package vote_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	voterepo "github.com/glowfi/voxpopuli/backend/pkg/repo/vote"
+	voteservice "github.com/glowfi/voxpopuli/backend/pkg/service/vote"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/vote/votefakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_VotePost(t *testing.T) {
+	type args struct {
+		postID    uuid.UUID
+		userID    uuid.UUID
+		direction models.VoteDirection
+	}
+	type mockReturns struct {
+		vote      models.Vote
+		voteError error
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		mockReturns mockReturns
+		wantVote    models.Vote
+		wantErr     error
+	}{
+		{
+			name: "upvote :POS",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				direction: models.VoteDirectionUp,
+			},
+			mockReturns: mockReturns{
+				vote: models.Vote{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Direction: models.VoteDirectionUp,
+					Score:     11,
+				},
+				voteError: nil,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionUp,
+				Score:     11,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "post not found :NEG",
+			args: args{
+				postID:    uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				direction: models.VoteDirectionDown,
+			},
+			mockReturns: mockReturns{
+				vote:      models.Vote{},
+				voteError: voterepo.ErrVotePostNotFound,
+			},
+			wantVote: models.Vote{},
+			wantErr:  voterepo.ErrVotePostNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeVoteRepo := votefakes.FakeVoteRepository{}
+			fakeVoteRepo.VotePostReturns(tt.mockReturns.vote, tt.mockReturns.voteError)
+			service := voteservice.NewService(&fakeVoteRepo)
+
+			gotVote, gotErr := service.VotePost(context.Background(), tt.args.postID, tt.args.userID, tt.args.direction)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantVote, gotVote, "expect vote to match")
+
+			_, gotPostID, gotUserID, gotDirection := fakeVoteRepo.VotePostArgsForCall(0)
+			assert.Equal(t, tt.args.postID, gotPostID, "expect post id to match")
+			assert.Equal(t, tt.args.userID, gotUserID, "expect user id to match")
+			assert.Equal(t, tt.args.direction, gotDirection, "expect direction to match")
+		})
+	}
+}
+
+func TestService_VoteComment(t *testing.T) {
+	type args struct {
+		commentID uuid.UUID
+		userID    uuid.UUID
+		direction models.VoteDirection
+	}
+	type mockReturns struct {
+		vote      models.Vote
+		voteError error
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		mockReturns mockReturns
+		wantVote    models.Vote
+		wantErr     error
+	}{
+		{
+			name: "clear vote :POS",
+			args: args{
+				commentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				direction: models.VoteDirectionClear,
+			},
+			mockReturns: mockReturns{
+				vote: models.Vote{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Direction: models.VoteDirectionClear,
+					Score:     2,
+				},
+				voteError: nil,
+			},
+			wantVote: models.Vote{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Direction: models.VoteDirectionClear,
+				Score:     2,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "user not found :NEG",
+			args: args{
+				commentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				userID:    uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				direction: models.VoteDirectionUp,
+			},
+			mockReturns: mockReturns{
+				vote:      models.Vote{},
+				voteError: voterepo.ErrVoteUserNotFound,
+			},
+			wantVote: models.Vote{},
+			wantErr:  voterepo.ErrVoteUserNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeVoteRepo := votefakes.FakeVoteRepository{}
+			fakeVoteRepo.VoteCommentReturns(tt.mockReturns.vote, tt.mockReturns.voteError)
+			service := voteservice.NewService(&fakeVoteRepo)
+
+			gotVote, gotErr := service.VoteComment(context.Background(), tt.args.commentID, tt.args.userID, tt.args.direction)
+			assert.ErrorIs(t, gotErr, tt.wantErr, "expect error to match")
+			assert.Equal(t, tt.wantVote, gotVote, "expect vote to match")
+
+			_, gotCommentID, gotUserID, gotDirection := fakeVoteRepo.VoteCommentArgsForCall(0)
+			assert.Equal(t, tt.args.commentID, gotCommentID, "expect comment id to match")
+			assert.Equal(t, tt.args.userID, gotUserID, "expect user id to match")
+			assert.Equal(t, tt.args.direction, gotDirection, "expect direction to match")
+		})
+	}
+}
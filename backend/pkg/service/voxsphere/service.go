@@ -0,0 +1,84 @@
+package voxsphere
+
+import (
+	"context"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/google/uuid"
+)
+
+type VoxsphereService interface {
+	VoxsphereAboutByID(ctx context.Context, ID uuid.UUID) (models.VoxsphereAbout, error)
+	VoxspheresByMemberID(ctx context.Context, userID uuid.UUID) ([]models.Voxsphere, error)
+	JoinVoxsphere(ctx context.Context, ID, userID uuid.UUID) error
+	LeaveVoxsphere(ctx context.Context, ID, userID uuid.UUID) error
+}
+
+//counterfeiter:generate . VoxsphereRepository
+type VoxsphereRepository interface {
+	VoxsphereByID(ctx context.Context, ID uuid.UUID) (models.Voxsphere, error)
+	ModeratorsByVoxsphereID(ctx context.Context, ID uuid.UUID) ([]models.User, error)
+	MemberCountByVoxsphereID(ctx context.Context, ID uuid.UUID) (int64, error)
+	VoxspheresByMemberID(ctx context.Context, userID uuid.UUID) ([]models.Voxsphere, error)
+	JoinVoxsphere(ctx context.Context, voxsphereID, userID uuid.UUID) error
+	LeaveVoxsphere(ctx context.Context, voxsphereID, userID uuid.UUID) error
+}
+
+//counterfeiter:generate . RuleRepository
+type RuleRepository interface {
+	RulesByVoxsphereID(ctx context.Context, voxsphereID uuid.UUID) ([]models.Rule, error)
+}
+
+type Service struct {
+	repo     VoxsphereRepository
+	ruleRepo RuleRepository
+}
+
+func NewService(repo VoxsphereRepository, ruleRepo RuleRepository) *Service {
+	return &Service{
+		repo:     repo,
+		ruleRepo: ruleRepo,
+	}
+}
+
+func (s *Service) VoxsphereAboutByID(ctx context.Context, ID uuid.UUID) (models.VoxsphereAbout, error) {
+	voxsphere, err := s.repo.VoxsphereByID(ctx, ID)
+	if err != nil {
+		return models.VoxsphereAbout{}, err
+	}
+
+	rules, err := s.ruleRepo.RulesByVoxsphereID(ctx, ID)
+	if err != nil {
+		return models.VoxsphereAbout{}, err
+	}
+
+	moderators, err := s.repo.ModeratorsByVoxsphereID(ctx, ID)
+	if err != nil {
+		return models.VoxsphereAbout{}, err
+	}
+
+	memberCount, err := s.repo.MemberCountByVoxsphereID(ctx, ID)
+	if err != nil {
+		return models.VoxsphereAbout{}, err
+	}
+
+	return models.VoxsphereAbout{
+		Voxsphere:   voxsphere,
+		Rules:       rules,
+		Moderators:  moderators,
+		MemberCount: memberCount,
+	}, nil
+}
+
+// VoxspheresByMemberID returns the voxspheres joined by the user of userID.
+func (s *Service) VoxspheresByMemberID(ctx context.Context, userID uuid.UUID) ([]models.Voxsphere, error) {
+	return s.repo.VoxspheresByMemberID(ctx, userID)
+}
+
+func (s *Service) JoinVoxsphere(ctx context.Context, ID, userID uuid.UUID) error {
+	return s.repo.JoinVoxsphere(ctx, ID, userID)
+}
+
+func (s *Service) LeaveVoxsphere(ctx context.Context, ID, userID uuid.UUID) error {
+	return s.repo.LeaveVoxsphere(ctx, ID, userID)
+}
@@ -0,0 +1,159 @@
+package voxsphere_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	voxrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/voxsphere"
+	voxsphereservice "github.com/glowfi/voxpopuli/backend/pkg/service/voxsphere"
+	"github.com/glowfi/voxpopuli/backend/pkg/service/voxsphere/voxspherefakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_VoxsphereAboutByID(t *testing.T) {
+	voxsphere := models.Voxsphere{
+		ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		TopicID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Topic: models.Topic{
+			ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Name: "topic1",
+		},
+		Title:                 "v/foo",
+		PublicDescription:     ptrof("foo PublicDescription"),
+		CommunityIcon:         ptrof("foo icon"),
+		BannerBackgroundImage: ptrof("foo BannerBackgroundImage"),
+		BannerBackgroundColor: ptrof("#000000"),
+		KeyColor:              ptrof("#000000"),
+		PrimaryColor:          ptrof("#000000"),
+		Over18:                true,
+		SpoilersEnabled:       false,
+		CreatedAt:             time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+		CreatedAtUnix:         1725091100,
+		UpdatedAt:             time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+	}
+	rules := []models.Rule{
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ShortName:   "Be civil",
+			Description: "No personal attacks.",
+		},
+	}
+	moderators := []models.User{
+		{
+			ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Name: "John Doe",
+		},
+	}
+
+	type args struct {
+		ID uuid.UUID
+	}
+	type mockReturns struct {
+		voxsphere        models.Voxsphere
+		voxsphereError   error
+		rules            []models.Rule
+		rulesError       error
+		moderators       []models.User
+		moderatorsError  error
+		memberCount      int64
+		memberCountError error
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		mockReturns mockReturns
+		wantAbout   models.VoxsphereAbout
+		wantErr     error
+	}{
+		{
+			name: "voxsphere not found :NEG",
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			},
+			mockReturns: mockReturns{
+				voxsphereError: voxrepo.ErrVoxsphereNotFound,
+			},
+			wantAbout: models.VoxsphereAbout{},
+			wantErr:   voxrepo.ErrVoxsphereNotFound,
+		},
+		{
+			name: "rules error :NEG",
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			mockReturns: mockReturns{
+				voxsphere:  voxsphere,
+				rulesError: errors.New("db error"),
+			},
+			wantAbout: models.VoxsphereAbout{},
+			wantErr:   errors.New("db error"),
+		},
+		{
+			name: "member count error :NEG",
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			mockReturns: mockReturns{
+				voxsphere:        voxsphere,
+				rules:            rules,
+				moderators:       moderators,
+				memberCountError: errors.New("db error"),
+			},
+			wantAbout: models.VoxsphereAbout{},
+			wantErr:   errors.New("db error"),
+		},
+		{
+			name: "voxsphere about :POS",
+			args: args{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+			mockReturns: mockReturns{
+				voxsphere:   voxsphere,
+				rules:       rules,
+				moderators:  moderators,
+				memberCount: 42,
+			},
+			wantAbout: models.VoxsphereAbout{
+				Voxsphere:   voxsphere,
+				Rules:       rules,
+				Moderators:  moderators,
+				MemberCount: 42,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeVoxsphereRepo := voxspherefakes.FakeVoxsphereRepository{}
+			fakeVoxsphereRepo.VoxsphereByIDReturns(tt.mockReturns.voxsphere, tt.mockReturns.voxsphereError)
+			fakeVoxsphereRepo.ModeratorsByVoxsphereIDReturns(tt.mockReturns.moderators, tt.mockReturns.moderatorsError)
+			fakeVoxsphereRepo.MemberCountByVoxsphereIDReturns(tt.mockReturns.memberCount, tt.mockReturns.memberCountError)
+			fakeRuleRepo := voxspherefakes.FakeRuleRepository{}
+			fakeRuleRepo.RulesByVoxsphereIDReturns(tt.mockReturns.rules, tt.mockReturns.rulesError)
+
+			service := voxsphereservice.NewService(&fakeVoxsphereRepo, &fakeRuleRepo)
+
+			gotAbout, gotErr := service.VoxsphereAboutByID(context.Background(), tt.args.ID)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, gotErr, tt.wantErr.Error(), "expect error to match")
+			} else {
+				assert.NoError(t, gotErr, "expect no error")
+			}
+			assert.Equal(t, tt.wantAbout, gotAbout, "expect voxsphere about to match")
+
+			_, gotID := fakeVoxsphereRepo.VoxsphereByIDArgsForCall(0)
+			assert.Equal(t, tt.args.ID, gotID, "expect voxsphere id to match")
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
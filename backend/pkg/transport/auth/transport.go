@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/internal/token"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	authrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/auth"
+	authsvc "github.com/glowfi/voxpopuli/backend/pkg/service/auth"
+)
+
+//counterfeiter:generate . AuthService
+type AuthService interface {
+	Register(ctx context.Context, name, email, password string) (models.Session, error)
+	Login(ctx context.Context, name, password string) (models.Session, error)
+	Refresh(ctx context.Context, refreshToken string) (models.Session, error)
+	Logout(ctx context.Context, refreshToken string) error
+	Authenticate(ctx context.Context, accessToken string) (models.User, error)
+}
+
+type Transport struct {
+	service AuthService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+type registerRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type loginRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func NewTransport(service AuthService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) Register(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	var req registerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid name, email and password")
+		return
+	}
+
+	session, err := t.service.Register(r.Context(), req.Name, req.Email, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, authsvc.ErrInvalidName),
+			errors.Is(err, authsvc.ErrInvalidEmail),
+			errors.Is(err, authsvc.ErrInvalidPassword):
+			writeResponseError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, authrepo.ErrAuthDuplicateName),
+			errors.Is(err, authrepo.ErrAuthDuplicateEmail):
+			writeResponseError(w, http.StatusConflict, err.Error())
+		default:
+			writeResponseError(w, http.StatusInternalServerError, "failed to register")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(session); err != nil {
+		log.Println("json encode error while registering:", err)
+	}
+}
+
+func (t *Transport) Login(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	var req loginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid name and password")
+		return
+	}
+
+	session, err := t.service.Login(r.Context(), req.Name, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, authsvc.ErrInvalidCredentials):
+			writeResponseError(w, http.StatusUnauthorized, err.Error())
+		case errors.Is(err, authsvc.ErrUserSuspended):
+			writeResponseError(w, http.StatusForbidden, err.Error())
+		default:
+			writeResponseError(w, http.StatusInternalServerError, "failed to login")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(session); err != nil {
+		log.Println("json encode error while logging in:", err)
+	}
+}
+
+func (t *Transport) Refresh(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	var req refreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid refresh token")
+		return
+	}
+
+	session, err := t.service.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, token.ErrInvalidToken) {
+			writeResponseError(w, http.StatusUnauthorized, "invalid refresh token")
+			return
+		}
+		writeResponseError(w, http.StatusInternalServerError, "failed to refresh session")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(session); err != nil {
+		log.Println("json encode error while refreshing session:", err)
+	}
+}
+
+func (t *Transport) Logout(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	var req refreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid refresh token")
+		return
+	}
+
+	if err := t.service.Logout(r.Context(), req.RefreshToken); err != nil {
+		if errors.Is(err, token.ErrInvalidToken) {
+			writeResponseError(w, http.StatusUnauthorized, "invalid refresh token")
+			return
+		}
+		writeResponseError(w, http.StatusInternalServerError, "failed to logout")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Me serves the authenticated user.
+func (t *Transport) Me(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(user); err != nil {
+		log.Println("json encode error while fetching user:", err)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
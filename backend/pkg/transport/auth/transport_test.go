@@ -0,0 +1,322 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/internal/token"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	authrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/auth"
+	authsvc "github.com/glowfi/voxpopuli/backend/pkg/service/auth"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/auth/authfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testUser = models.User{
+		ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Name:          "john_doe",
+		CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+		CreatedAtUnix: 1728555010,
+		UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+	}
+	testSession = models.Session{
+		User: testUser,
+		AuthTokens: models.AuthTokens{
+			AccessToken:           "access",
+			AccessTokenExpiresAt:  time.Date(2024, 10, 10, 10, 25, 10, 0, time.UTC),
+			RefreshToken:          "refresh",
+			RefreshTokenExpiresAt: time.Date(2024, 11, 9, 10, 10, 10, 0, time.UTC),
+		},
+	}
+)
+
+const (
+	testUserResponse = `
+        {
+          "id": "00000000-0000-0000-0000-000000000001",
+          "name": "john_doe",
+          "public_description": null,
+          "avatar_img": null,
+          "banner_img": null,
+          "iconcolor": null,
+          "keycolor": null,
+          "primarycolor": null,
+          "over18": false,
+          "suspended": false,
+          "created_at": "2024-10-10T10:10:10Z",
+          "created_at_unix": 1728555010,
+          "updated_at": "2024-10-10T10:10:10Z"
+        }
+        `
+	testSessionResponse = `
+        {
+          "user": ` + testUserResponse + `,
+          "access_token": "access",
+          "access_token_expires_at": "2024-10-10T10:25:10Z",
+          "refresh_token": "refresh",
+          "refresh_token_expires_at": "2024-11-09T10:10:10Z"
+        }
+        `
+)
+
+// serve sends a request of method to url with body through a server backed by
+// fakeAuthService, as user when one is given.
+func serve(t *testing.T, fakeAuthService *authfakes.FakeAuthService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Auth: fakeAuthService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_Register(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "malformed body :NEG",
+			body:           `{"name":`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid name :NEG",
+			body:           `{"name": "john doe", "email": "john@example.com", "password": "password"}`,
+			serviceErr:     authsvc.ErrInvalidName,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "duplicate email :NEG",
+			body:           `{"name": "john_doe", "email": "john@example.com", "password": "password"}`,
+			serviceErr:     authrepo.ErrAuthDuplicateEmail,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "internal server error :NEG",
+			body:           `{"name": "john_doe", "email": "john@example.com", "password": "password"}`,
+			serviceErr:     errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:           "new user :POS",
+			body:           `{"name": "john_doe", "email": "john@example.com", "password": "password"}`,
+			wantStatusCode: http.StatusCreated,
+			wantResponse:   testSessionResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAuthService := authfakes.FakeAuthService{}
+			if tt.serviceErr != nil {
+				fakeAuthService.RegisterReturns(models.Session{}, tt.serviceErr)
+			} else {
+				fakeAuthService.RegisterReturns(testSession, nil)
+			}
+
+			recorder := serve(t, &fakeAuthService, "POST", "/auth/register", tt.body, nil)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusCreated {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+
+				_, gotName, gotEmail, gotPassword := fakeAuthService.RegisterArgsForCall(0)
+				assert.Equal(t, "john_doe", gotName, "expect name to match")
+				assert.Equal(t, "john@example.com", gotEmail, "expect email to match")
+				assert.Equal(t, "password", gotPassword, "expect password to match")
+			}
+		})
+	}
+}
+
+func TestTransport_Login(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "malformed body :NEG",
+			body:           `[]`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid credentials :NEG",
+			body:           `{"name": "john_doe", "password": "passw0rd"}`,
+			serviceErr:     authsvc.ErrInvalidCredentials,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "suspended user :NEG",
+			body:           `{"name": "john_doe", "password": "password"}`,
+			serviceErr:     authsvc.ErrUserSuspended,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "valid credentials :POS",
+			body:           `{"name": "john_doe", "password": "password"}`,
+			wantStatusCode: http.StatusOK,
+			wantResponse:   testSessionResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAuthService := authfakes.FakeAuthService{}
+			if tt.serviceErr != nil {
+				fakeAuthService.LoginReturns(models.Session{}, tt.serviceErr)
+			} else {
+				fakeAuthService.LoginReturns(testSession, nil)
+			}
+
+			recorder := serve(t, &fakeAuthService, "POST", "/auth/login", tt.body, nil)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransport_Refresh(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "invalid refresh token :NEG",
+			body:           `{"refresh_token": "refresh"}`,
+			serviceErr:     token.ErrExpiredToken,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "valid refresh token :POS",
+			body:           `{"refresh_token": "refresh"}`,
+			wantStatusCode: http.StatusOK,
+			wantResponse:   testSessionResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAuthService := authfakes.FakeAuthService{}
+			if tt.serviceErr != nil {
+				fakeAuthService.RefreshReturns(models.Session{}, tt.serviceErr)
+			} else {
+				fakeAuthService.RefreshReturns(testSession, nil)
+			}
+
+			recorder := serve(t, &fakeAuthService, "POST", "/auth/refresh", tt.body, nil)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransport_Logout(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		serviceErr     error
+		wantStatusCode int
+	}{
+		{
+			name:           "invalid refresh token :NEG",
+			body:           `{"refresh_token": "refresh"}`,
+			serviceErr:     token.ErrInvalidToken,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "internal server error :NEG",
+			body:           `{"refresh_token": "refresh"}`,
+			serviceErr:     errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:           "valid refresh token :POS",
+			body:           `{"refresh_token": "refresh"}`,
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAuthService := authfakes.FakeAuthService{}
+			fakeAuthService.LogoutReturns(tt.serviceErr)
+
+			recorder := serve(t, &fakeAuthService, "POST", "/auth/logout", tt.body, nil)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+		})
+	}
+}
+
+func TestTransport_Me(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *models.User
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "authenticated request :POS",
+			user:           &testUser,
+			wantStatusCode: http.StatusOK,
+			wantResponse:   testUserResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAuthService := authfakes.FakeAuthService{}
+
+			recorder := serve(t, &fakeAuthService, "GET", "/me", "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package automod
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/glowfi/voxpopuli/backend/internal/automod"
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	automodrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/automod"
+	automodsvc "github.com/glowfi/voxpopuli/backend/pkg/service/automod"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . AutomodService
+type AutomodService interface {
+	AutomodConfig(ctx context.Context, voxsphereID, moderatorID uuid.UUID) (models.AutomodConfig, error)
+	UpdateAutomodConfig(ctx context.Context, voxsphereID, moderatorID uuid.UUID, config json.RawMessage) (models.AutomodConfig, error)
+}
+
+type Transport struct {
+	service AutomodService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service AutomodService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) AutomodConfig(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	config, err := t.service.AutomodConfig(r.Context(), voxsphereID, user.ID)
+	if err != nil {
+		writeAutomodError(w, err, "failed to fetch automod config")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(config); err != nil {
+		log.Println("json encode error while fetching automod config:", err)
+	}
+}
+
+func (t *Transport) UpdateAutomodConfig(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	var config json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid automod config")
+		return
+	}
+
+	updated, err := t.service.UpdateAutomodConfig(r.Context(), voxsphereID, user.ID, config)
+	if err != nil {
+		writeAutomodError(w, err, "failed to update automod config")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		log.Println("json encode error while updating automod config:", err)
+	}
+}
+
+// writeAutomodError answers a failed automod request, falling back to an
+// internal server error with fallbackMsg.
+func writeAutomodError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, automod.ErrInvalidConfig):
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, automodsvc.ErrAutomodNotModerator):
+		writeResponseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, automodrepo.ErrAutomodVoxsphereNotFound):
+		writeResponseError(w, http.StatusNotFound, err.Error())
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
@@ -0,0 +1,172 @@
+package automod_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/automod"
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	automodsvc "github.com/glowfi/voxpopuli/backend/pkg/service/automod"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/automod/automodfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var moderator = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+// serveAs sends a request of method to url with body through a server backed
+// by fakeAutomodService, as user when one is given.
+func serveAs(t *testing.T, fakeAutomodService *automodfakes.FakeAutomodService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Automod: fakeAutomodService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_AutomodConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/automod",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid voxsphere id :NEG",
+			url:            "/voxspheres/foo/automod",
+			user:           &moderator,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "not a moderator :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/automod",
+			user:           &moderator,
+			serviceErr:     automodsvc.ErrAutomodNotModerator,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "automod config :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/automod",
+			user:           &moderator,
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+            {
+              "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+              "config": {"rules": []},
+              "updated_by": "00000000-0000-0000-0000-000000000001",
+              "updated_at": "2024-10-10T10:10:10Z"
+            }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAutomodService := automodfakes.FakeAutomodService{}
+			fakeAutomodService.AutomodConfigReturns(models.AutomodConfig{
+				VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Config:      json.RawMessage(`{"rules": []}`),
+				UpdatedBy:   moderator.ID,
+				UpdatedAt:   time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+			}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeAutomodService, "GET", tt.url, "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if len(tt.wantResponse) != 0 {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransport_UpdateAutomodConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            string
+		serviceErr      error
+		wantStatusCode  int
+		wantUpdateCalls int
+	}{
+		{
+			name:           "invalid body :NEG",
+			body:           `{"rules": [`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:            "invalid config :NEG",
+			body:            `{"rules": [{"name": "spam", "action": "remove"}]}`,
+			serviceErr:      fmt.Errorf("%w: rule 1: at least one condition is required", automod.ErrInvalidConfig),
+			wantStatusCode:  http.StatusBadRequest,
+			wantUpdateCalls: 1,
+		},
+		{
+			name:            "not a moderator :NEG",
+			body:            `{"rules": []}`,
+			serviceErr:      automodsvc.ErrAutomodNotModerator,
+			wantStatusCode:  http.StatusForbidden,
+			wantUpdateCalls: 1,
+		},
+		{
+			name:            "update config :POS",
+			body:            `{"rules": [{"name": "spam", "body": "spam", "action": "remove"}]}`,
+			wantStatusCode:  http.StatusOK,
+			wantUpdateCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAutomodService := automodfakes.FakeAutomodService{}
+			fakeAutomodService.UpdateAutomodConfigReturns(models.AutomodConfig{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeAutomodService, "PUT", "/voxspheres/00000000-0000-0000-0000-000000000001/automod", tt.body, &moderator)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantUpdateCalls, fakeAutomodService.UpdateAutomodConfigCallCount(), "expect update call count to match")
+
+			if tt.wantUpdateCalls != 0 {
+				_, _, gotModeratorID, gotConfig := fakeAutomodService.UpdateAutomodConfigArgsForCall(0)
+				assert.Equal(t, moderator.ID, gotModeratorID, "expect the authenticated user to update the config")
+				assert.JSONEq(t, tt.body, string(gotConfig), "expect config to be passed as is")
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package award
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	awardrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/award"
+	awardsvc "github.com/glowfi/voxpopuli/backend/pkg/service/award"
+	"github.com/google/uuid"
+)
+
+// idempotencyKeyHeader carries the client token that makes a retried grant
+// apply once.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+//counterfeiter:generate . AwardService
+type AwardService interface {
+	Awards(ctx context.Context) ([]models.Award, error)
+	GrantPostAward(ctx context.Context, postID, giverID uuid.UUID, idempotencyKey string, submission models.AwardGrantSubmission) (models.AwardGrant, error)
+	GrantCommentAward(ctx context.Context, commentID, giverID uuid.UUID, idempotencyKey string, submission models.AwardGrantSubmission) (models.AwardGrant, error)
+	CoinWallet(ctx context.Context, userID uuid.UUID, limit int) (models.CoinWallet, error)
+}
+
+type Transport struct {
+	service AwardService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service AwardService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) Awards(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	awards, err := t.service.Awards(r.Context())
+	if err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch awards")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(awards); err != nil {
+		log.Println("json encode error while fetching awards:", err)
+	}
+}
+
+func (t *Transport) GrantPostAward(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	postID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	var submission models.AwardGrantSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid award")
+		return
+	}
+
+	grant, err := t.service.GrantPostAward(r.Context(), postID, user.ID, r.Header.Get(idempotencyKeyHeader), submission)
+	if err != nil {
+		writeAwardError(w, err, "failed to grant award")
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(grant); err != nil {
+		log.Println("json encode error while granting award:", err)
+	}
+}
+
+func (t *Transport) GrantCommentAward(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	commentID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid comment id")
+		return
+	}
+
+	var submission models.AwardGrantSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid award")
+		return
+	}
+
+	grant, err := t.service.GrantCommentAward(r.Context(), commentID, user.ID, r.Header.Get(idempotencyKeyHeader), submission)
+	if err != nil {
+		writeAwardError(w, err, "failed to grant award")
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(grant); err != nil {
+		log.Println("json encode error while granting award:", err)
+	}
+}
+
+func (t *Transport) CoinWallet(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if len(limitStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid limit")
+		return
+	}
+	limit, err := parseLimitParam(limitStr)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	wallet, err := t.service.CoinWallet(r.Context(), user.ID, limit)
+	if err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch coin wallet")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(wallet); err != nil {
+		log.Println("json encode error while fetching coin wallet:", err)
+	}
+}
+
+// writeAwardError answers a failed award request, falling back to an
+// internal server error with fallbackMsg.
+func writeAwardError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, awardsvc.ErrAwardInvalidIdempotencyKey):
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, awardrepo.ErrAwardGrantInsufficientCoins):
+		writeResponseError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, awardrepo.ErrAwardNotFound),
+		errors.Is(err, awardrepo.ErrAwardGrantTargetNotFound):
+		writeResponseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, awardrepo.ErrAwardGrantKeyReused):
+		writeResponseError(w, http.StatusConflict, err.Error())
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
+
+func parseIntParam(param string, paramName string) (int, error) {
+	value, err := strconv.Atoi(param)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", paramName, err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("invalid %s: value must be non-negative", paramName)
+	}
+	return value, nil
+}
+
+// maxLimit is the largest page size a request may ask for.
+const maxLimit = 100
+
+func parseLimitParam(param string) (int, error) {
+	limit, err := parseIntParam(param, "limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit > maxLimit {
+		return 0, fmt.Errorf("invalid limit: value must be at most %d", maxLimit)
+	}
+	return limit, nil
+}
@@ -0,0 +1,273 @@
+package award_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	awardrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/award"
+	awardsvc "github.com/glowfi/voxpopuli/backend/pkg/service/award"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/award/awardfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var giver = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+// serveAs sends a request of method to url with body and idempotencyKey
+// through a server backed by fakeAwardService, as user when one is given.
+func serveAs(t *testing.T, fakeAwardService *awardfakes.FakeAwardService, method, url, body, idempotencyKey string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Award: fakeAwardService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if len(idempotencyKey) > 0 {
+		request.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_Awards(t *testing.T) {
+	fakeAwardService := awardfakes.FakeAwardService{}
+	fakeAwardService.AwardsReturns([]models.Award{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Title:     "award_foo",
+			ImageLink: "https://example.com/award_foo.png",
+			Price:     100,
+		},
+	}, nil)
+
+	recorder := serveAs(t, &fakeAwardService, "GET", "/awards", "", "", nil)
+
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "expect status code to match")
+	assert.JSONEq(t, `
+    [
+      {
+        "id": "00000000-0000-0000-0000-000000000001",
+        "title": "award_foo",
+        "image_link": "https://example.com/award_foo.png",
+        "price": 100
+      }
+    ]
+    `, recorder.Body.String())
+}
+
+func TestTransport_GrantPostAward(t *testing.T) {
+	grant := models.AwardGrant{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		AwardID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		GiverID:        giver.ID,
+		PostID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Price:          100,
+		IdempotencyKey: "key1",
+		CreatedAt:      time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "not logged in :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/awards",
+			body:           `{"award_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid post id :NEG",
+			url:            "/posts/foo/awards",
+			body:           `{"award_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &giver,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid idempotency key :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/awards",
+			body:           `{"award_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &giver,
+			serviceErr:     awardsvc.ErrAwardInvalidIdempotencyKey,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "insufficient coins :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/awards",
+			body:           `{"award_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &giver,
+			serviceErr:     awardrepo.ErrAwardGrantInsufficientCoins,
+			wantStatusCode: http.StatusPaymentRequired,
+		},
+		{
+			name:           "key reused :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/awards",
+			body:           `{"award_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &giver,
+			serviceErr:     awardrepo.ErrAwardGrantKeyReused,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "post not found :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/awards",
+			body:           `{"award_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &giver,
+			serviceErr:     awardrepo.ErrAwardGrantTargetNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "grant :POS",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/awards",
+			body:           `{"award_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &giver,
+			wantStatusCode: http.StatusCreated,
+			wantResponse: `
+                {
+                  "id": "00000000-0000-0000-0000-000000000001",
+                  "award_id": "00000000-0000-0000-0000-000000000001",
+                  "giver_id": "00000000-0000-0000-0000-000000000001",
+                  "post_id": "00000000-0000-0000-0000-000000000001",
+                  "comment_id": "00000000-0000-0000-0000-000000000000",
+                  "price": 100,
+                  "created_at": "2024-10-10T10:10:10Z"
+                }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAwardService := awardfakes.FakeAwardService{}
+			fakeAwardService.GrantPostAwardReturns(grant, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeAwardService, "POST", tt.url, tt.body, "key1", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantResponse != "" {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+
+				_, gotPostID, gotGiverID, gotKey, gotSubmission := fakeAwardService.GrantPostAwardArgsForCall(0)
+				assert.Equal(t, grant.PostID, gotPostID, "expect post id to match")
+				assert.Equal(t, giver.ID, gotGiverID, "expect giver id to match")
+				assert.Equal(t, "key1", gotKey, "expect idempotency key to be taken from the header")
+				assert.Equal(t, grant.AwardID, gotSubmission.AwardID, "expect award id to match")
+			}
+		})
+	}
+}
+
+func TestTransport_GrantCommentAward(t *testing.T) {
+	fakeAwardService := awardfakes.FakeAwardService{}
+
+	recorder := serveAs(t, &fakeAwardService, "POST", "/comments/00000000-0000-0000-0000-000000000002/awards", `{"award_id": "00000000-0000-0000-0000-000000000001"}`, "key1", &giver)
+
+	assert.Equal(t, http.StatusCreated, recorder.Result().StatusCode, "expect status code to match")
+	_, gotCommentID, _, _, _ := fakeAwardService.GrantCommentAwardArgsForCall(0)
+	assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000002"), gotCommentID, "expect comment id to match")
+}
+
+func TestTransport_CoinWallet(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		user           *models.User
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "not logged in :NEG",
+			url:            "/me/coins?limit=10",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing limit :NEG",
+			url:            "/me/coins",
+			user:           &giver,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "limit above maximum :NEG",
+			url:            "/me/coins?limit=101",
+			user:           &giver,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "wallet :POS",
+			url:            "/me/coins?limit=10",
+			user:           &giver,
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+                {
+                  "balance": 500,
+                  "transactions": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "user_id": "00000000-0000-0000-0000-000000000001",
+                      "amount": 500,
+                      "reason": "welcome",
+                      "award_grant_id": "00000000-0000-0000-0000-000000000000",
+                      "created_at": "2024-10-10T10:10:10Z"
+                    }
+                  ]
+                }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAwardService := awardfakes.FakeAwardService{}
+			fakeAwardService.CoinWalletReturns(models.CoinWallet{
+				Balance: 500,
+				Transactions: []models.CoinTransaction{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						UserID:    giver.ID,
+						Amount:    500,
+						Reason:    models.CoinTransactionReasonWelcome,
+						CreatedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+				},
+			}, nil)
+
+			recorder := serveAs(t, &fakeAwardService, "GET", tt.url, "", "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantResponse != "" {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	commentsrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/comments"
+	commentsvc "github.com/glowfi/voxpopuli/backend/pkg/service/comment"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . CommentService
+type CommentService interface {
+	CommentTree(ctx context.Context, postID, parentID uuid.UUID, depth, limit int) (models.CommentTree, error)
+	CommentsByAuthorName(ctx context.Context, name string, skip, limit int) ([]models.UserComment, error)
+	CreateComment(ctx context.Context, postID, authorID uuid.UUID, submission models.CommentSubmission) (models.Comment, error)
+	EditComment(ctx context.Context, ID, userID uuid.UUID, body string) (models.Comment, error)
+	DeleteComment(ctx context.Context, ID, userID uuid.UUID) error
+}
+
+type Transport struct {
+	service CommentService
+}
+
+type commentEditRequest struct {
+	Body string `json:"body"`
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service CommentService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) CommentTree(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	postID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	parentID := uuid.Nil
+	if parentStr := r.URL.Query().Get("parent"); len(parentStr) != 0 {
+		parentID, err = uuid.Parse(parentStr)
+		if err != nil {
+			writeResponseError(w, http.StatusBadRequest, "add a valid parent comment id")
+			return
+		}
+	}
+
+	var depth int
+	if depthStr := r.URL.Query().Get("depth"); len(depthStr) != 0 {
+		depth, err = parseIntParam(depthStr, "depth")
+		if err != nil {
+			writeResponseError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	var limit int
+	if limitStr := r.URL.Query().Get("limit"); len(limitStr) != 0 {
+		limit, err = parseIntParam(limitStr, "limit")
+		if err != nil {
+			writeResponseError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	tree, err := t.service.CommentTree(r.Context(), postID, parentID, depth, limit)
+	if err != nil {
+		if errors.Is(err, commentsrepo.ErrCommentPostNotFound) {
+			writeResponseError(w, http.StatusNotFound, "post not found")
+			return
+		}
+		if errors.Is(err, commentsrepo.ErrCommentNotFound) {
+			writeResponseError(w, http.StatusNotFound, "parent comment not found")
+			return
+		}
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch comments")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(tree); err != nil {
+		log.Println("json encode error while fetching comments:", err)
+	}
+}
+
+func (t *Transport) UserComments(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	skipStr := r.URL.Query().Get("skip")
+	if len(skipStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid skip")
+		return
+	}
+	skip, err := parseIntParam(skipStr, "skip")
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if len(limitStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid limit")
+		return
+	}
+	limit, err := parseLimitParam(limitStr)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	comments, err := t.service.CommentsByAuthorName(r.Context(), r.PathValue("name"), skip, limit)
+	if err != nil {
+		if errors.Is(err, commentsrepo.ErrCommentAuthorNotFound) {
+			writeResponseError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch comments")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(comments); err != nil {
+		log.Println("json encode error while fetching comments:", err)
+	}
+}
+
+func (t *Transport) CreateComment(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	postID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	var submission models.CommentSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid comment")
+		return
+	}
+
+	comment, err := t.service.CreateComment(r.Context(), postID, user.ID, submission)
+	if err != nil {
+		writeCommentWriteError(w, err, "failed to create comment")
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(comment); err != nil {
+		log.Println("json encode error while creating comment:", err)
+	}
+}
+
+func (t *Transport) EditComment(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid comment id")
+		return
+	}
+
+	var edit commentEditRequest
+	if err := json.NewDecoder(r.Body).Decode(&edit); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid comment edit")
+		return
+	}
+
+	comment, err := t.service.EditComment(r.Context(), ID, user.ID, edit.Body)
+	if err != nil {
+		writeCommentWriteError(w, err, "failed to edit comment")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(comment); err != nil {
+		log.Println("json encode error while editing comment:", err)
+	}
+}
+
+func (t *Transport) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid comment id")
+		return
+	}
+
+	if err := t.service.DeleteComment(r.Context(), ID, user.ID); err != nil {
+		writeCommentWriteError(w, err, "failed to delete comment")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeCommentWriteError answers a failed comment creation, edit or deletion,
+// falling back to an internal server error with fallbackMsg.
+func writeCommentWriteError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, commentsvc.ErrCommentInvalidBody):
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, commentsvc.ErrCommentNotAuthor),
+		errors.Is(err, commentsvc.ErrCommentPostLocked),
+		errors.Is(err, commentsvc.ErrCommentBanned):
+		writeResponseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, commentsrepo.ErrCommentPostNotFound):
+		writeResponseError(w, http.StatusNotFound, "post not found")
+	case errors.Is(err, commentsrepo.ErrCommentNotFound):
+		writeResponseError(w, http.StatusNotFound, "comment not found")
+	case errors.Is(err, commentsvc.ErrCommentParentNotInPost):
+		writeResponseError(w, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, commentsrepo.ErrCommentDuplicateID):
+		writeResponseError(w, http.StatusConflict, "comment already exists")
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
+
+func parseIntParam(param string, paramName string) (int, error) {
+	value, err := strconv.Atoi(param)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", paramName, err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("invalid %s: value must be non-negative", paramName)
+	}
+	return value, nil
+}
+
+// maxLimit is the largest page size a request may ask for.
+const maxLimit = 100
+
+func parseLimitParam(param string) (int, error) {
+	limit, err := parseIntParam(param, "limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit > maxLimit {
+		return 0, fmt.Errorf("invalid limit: value must be at most %d", maxLimit)
+	}
+	return limit, nil
+}
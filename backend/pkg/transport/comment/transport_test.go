@@ -0,0 +1,637 @@
+package comment_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	commentsrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/comments"
+	commentsvc "github.com/glowfi/voxpopuli/backend/pkg/service/comment"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/comment/commentfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport_CommentTree(t *testing.T) {
+	type mockReturns struct {
+		tree         models.CommentTree
+		commentError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantParentID   uuid.UUID
+		wantDepth      int
+		wantLimit      int
+		wantResponse   string
+	}{
+		{
+			name:           "invalid post id :NEG",
+			url:            "/posts/foo/comments",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid parent comment id :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments?parent=foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid depth :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments?depth=-1",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid limit :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments?limit=foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "post not found :NEG",
+			url:  "/posts/00000000-0000-0000-0000-000000000009/comments",
+			mockReturns: mockReturns{
+				commentError: commentsrepo.ErrCommentPostNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "parent comment not found :NEG",
+			url:  "/posts/00000000-0000-0000-0000-000000000001/comments?parent=00000000-0000-0000-0000-000000000009",
+			mockReturns: mockReturns{
+				commentError: commentsrepo.ErrCommentNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "service error :NEG",
+			url:  "/posts/00000000-0000-0000-0000-000000000001/comments",
+			mockReturns: mockReturns{
+				commentError: errors.New("db error"),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "comment tree :POS",
+			url:  "/posts/00000000-0000-0000-0000-000000000001/comments?parent=00000000-0000-0000-0000-000000000001&depth=1&limit=5",
+			mockReturns: mockReturns{
+				tree: models.CommentTree{
+					PostID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Comments: []models.CommentThread{
+						{
+							ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							Author:          "Jane Doe",
+							AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Body:            "This is reply 1 to parent comment 1",
+							BodyHtml:        "<p>This is reply 1 to parent comment 1</p>",
+							Ups:             1,
+							Score:           1,
+							Depth:           0,
+							Replies:         []models.CommentThread{},
+							More: &models.CommentMore{
+								ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+								Depth:           1,
+								Count:           1,
+								Children:        []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000003")},
+							},
+							CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+							CreatedAtUnix: 1725091100,
+							UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						},
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantParentID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			wantDepth:      1,
+			wantLimit:      5,
+			wantResponse: `
+                {
+                  "post_id": "00000000-0000-0000-0000-000000000001",
+                  "comments": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000002",
+                      "author": "Jane Doe",
+                      "author_id": "00000000-0000-0000-0000-000000000002",
+                      "author_flair": null,
+                      "parent_comment_id": "00000000-0000-0000-0000-000000000001",
+                      "post_id": "00000000-0000-0000-0000-000000000001",
+                      "body": "This is reply 1 to parent comment 1",
+                      "body_html": "<p>This is reply 1 to parent comment 1</p>",
+                      "ups": 1,
+                      "score": 1,
+                      "depth": 0,
+                      "replies": [],
+                      "more": {
+                        "parent_comment_id": "00000000-0000-0000-0000-000000000002",
+                        "depth": 1,
+                        "count": 1,
+                        "children": ["00000000-0000-0000-0000-000000000003"]
+                      },
+                      "created_at": "2024-10-10T10:10:10Z",
+                      "created_at_unix": 1725091100,
+                      "updated_at": "2024-10-10T10:10:10Z"
+                    }
+                  ],
+                  "more": null
+                }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentService := commentfakes.FakeCommentService{}
+			fakeCommentService.CommentTreeReturns(tt.mockReturns.tree, tt.mockReturns.commentError)
+
+			server, err := tr.NewServer(tr.Services{
+				Comment: &fakeCommentService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				_, _, gotParentID, gotDepth, gotLimit := fakeCommentService.CommentTreeArgsForCall(0)
+				assert.Equal(t, tt.wantParentID, gotParentID, "expect parent id to match")
+				assert.Equal(t, tt.wantDepth, gotDepth, "expect depth to match")
+				assert.Equal(t, tt.wantLimit, gotLimit, "expect limit to match")
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransport_UserComments(t *testing.T) {
+	type mockReturns struct {
+		comments     []models.UserComment
+		commentError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantName       string
+		wantSkip       int
+		wantLimit      int
+		wantResponse   string
+	}{
+		{
+			name:           "missing skip :NEG",
+			url:            "/users/John%20Doe/comments?limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "missing limit :NEG",
+			url:            "/users/John%20Doe/comments?skip=0",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "limit above maximum :NEG",
+			url:            "/users/John%20Doe/comments?skip=0&limit=101",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid skip :NEG",
+			url:            "/users/John%20Doe/comments?skip=-1&limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "user not found :NEG",
+			url:  "/users/Nobody/comments?skip=0&limit=10",
+			mockReturns: mockReturns{
+				commentError: commentsrepo.ErrCommentAuthorNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "service error :NEG",
+			url:  "/users/John%20Doe/comments?skip=0&limit=10",
+			mockReturns: mockReturns{
+				commentError: errors.New("db error"),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "user comments :POS",
+			url:  "/users/John%20Doe/comments?skip=1&limit=1",
+			mockReturns: mockReturns{
+				comments: []models.UserComment{
+					{
+						CommentAuthor: models.CommentAuthor{
+							Comment: models.Comment{
+								ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								ParentCommentID: uuid.Nil,
+								PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								Body:            "This is a parent comment 1",
+								BodyHtml:        "<p>This is a parent comment 1</p>",
+								Ups:             1,
+								Score:           1,
+								CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+								CreatedAtUnix:   1725091100,
+								UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+							},
+							Author: "John Doe",
+						},
+						PostTitle:   "Example Post Title 1",
+						VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Voxsphere:   "v/foo",
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantName:       "John Doe",
+			wantSkip:       1,
+			wantLimit:      1,
+			wantResponse: `
+                [
+                  {
+                    "id": "00000000-0000-0000-0000-000000000001",
+                    "author_id": "00000000-0000-0000-0000-000000000001",
+                    "author_flair": null,
+                    "parent_comment_id": "00000000-0000-0000-0000-000000000000",
+                    "post_id": "00000000-0000-0000-0000-000000000001",
+                    "body": "This is a parent comment 1",
+                    "body_html": "<p>This is a parent comment 1</p>",
+                    "ups": 1,
+                    "score": 1,
+                    "created_at": "2024-10-10T10:10:10Z",
+                    "created_at_unix": 1725091100,
+                    "updated_at": "2024-10-10T10:10:10Z",
+                    "author": "John Doe",
+                    "post_title": "Example Post Title 1",
+                    "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+                    "voxsphere": "v/foo"
+                  }
+                ]
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentService := commentfakes.FakeCommentService{}
+			fakeCommentService.CommentsByAuthorNameReturns(tt.mockReturns.comments, tt.mockReturns.commentError)
+
+			server, err := tr.NewServer(tr.Services{
+				Comment: &fakeCommentService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				_, gotName, gotSkip, gotLimit := fakeCommentService.CommentsByAuthorNameArgsForCall(0)
+				assert.Equal(t, tt.wantName, gotName, "expect author name to match")
+				assert.Equal(t, tt.wantSkip, gotSkip, "expect skip to match")
+				assert.Equal(t, tt.wantLimit, gotLimit, "expect limit to match")
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+var author = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+var createdComment = models.Comment{
+	ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+	AuthorID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	PostID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Body:            "This is a reply",
+	BodyHtml:        "<p>This is a reply</p>",
+	CreatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+	CreatedAtUnix:   1728555010,
+	UpdatedAt:       time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+}
+
+const createdCommentResponse = `
+{
+  "id": "00000000-0000-0000-0000-000000000002",
+  "author_id": "00000000-0000-0000-0000-000000000001",
+  "parent_comment_id": "00000000-0000-0000-0000-000000000001",
+  "post_id": "00000000-0000-0000-0000-000000000001",
+  "body": "This is a reply",
+  "body_html": "<p>This is a reply</p>",
+  "ups": 0,
+  "score": 0,
+  "created_at": "2024-10-10T10:10:10Z",
+  "created_at_unix": 1728555010,
+  "updated_at": "2024-10-10T10:10:10Z"
+}
+`
+
+func serveAs(t *testing.T, fakeCommentService *commentfakes.FakeCommentService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Comment: fakeCommentService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_CreateComment(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments",
+			body:           `{"body": "This is a reply"}`,
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid post id :NEG",
+			url:            "/posts/foo/comments",
+			body:           `{"body": "This is a reply"}`,
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid json :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments",
+			body:           `{"body":`,
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid parent comment id :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments",
+			body:           `{"parent_comment_id": "foo", "body": "This is a reply"}`,
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid body :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments",
+			body:           `{"body": ""}`,
+			user:           &author,
+			serviceErr:     commentsvc.ErrCommentInvalidBody,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "locked post :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments",
+			body:           `{"body": "This is a reply"}`,
+			user:           &author,
+			serviceErr:     commentsvc.ErrCommentPostLocked,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "post not found :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000009/comments",
+			body:           `{"body": "This is a reply"}`,
+			user:           &author,
+			serviceErr:     commentsrepo.ErrCommentPostNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "parent not in post :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments",
+			body:           `{"parent_comment_id": "00000000-0000-0000-0000-000000000009", "body": "This is a reply"}`,
+			user:           &author,
+			serviceErr:     commentsvc.ErrCommentParentNotInPost,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "internal server error :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments",
+			body:           `{"body": "This is a reply"}`,
+			user:           &author,
+			serviceErr:     errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:           "create reply :POS",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/comments",
+			body:           `{"parent_comment_id": "00000000-0000-0000-0000-000000000001", "body": "This is a reply"}`,
+			user:           &author,
+			wantStatusCode: http.StatusCreated,
+			wantResponse:   createdCommentResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentService := commentfakes.FakeCommentService{}
+			if tt.serviceErr != nil {
+				fakeCommentService.CreateCommentReturns(models.Comment{}, tt.serviceErr)
+			} else {
+				fakeCommentService.CreateCommentReturns(createdComment, nil)
+			}
+
+			recorder := serveAs(t, &fakeCommentService, "POST", tt.url, tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusCreated {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+
+				_, gotPostID, gotAuthorID, gotSubmission := fakeCommentService.CreateCommentArgsForCall(0)
+				assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000001"), gotPostID, "expect post id to match")
+				assert.Equal(t, author.ID, gotAuthorID, "expect comment to be authored by the authenticated user")
+				assert.Equal(t, models.CommentSubmission{
+					ParentCommentID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Body:            "This is a reply",
+				}, gotSubmission, "expect submission to match")
+			}
+		})
+	}
+}
+
+func TestTransport_EditComment(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000002",
+			body:           `{"body": "This is a reply"}`,
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid comment id :NEG",
+			url:            "/comments/foo",
+			body:           `{"body": "This is a reply"}`,
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "not the author :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000002",
+			body:           `{"body": "This is a reply"}`,
+			user:           &author,
+			serviceErr:     commentsvc.ErrCommentNotAuthor,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "comment not found :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000009",
+			body:           `{"body": "This is a reply"}`,
+			user:           &author,
+			serviceErr:     commentsrepo.ErrCommentNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "author edits comment :POS",
+			url:            "/comments/00000000-0000-0000-0000-000000000002",
+			body:           `{"body": "This is a reply"}`,
+			user:           &author,
+			wantStatusCode: http.StatusOK,
+			wantResponse:   createdCommentResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentService := commentfakes.FakeCommentService{}
+			if tt.serviceErr != nil {
+				fakeCommentService.EditCommentReturns(models.Comment{}, tt.serviceErr)
+			} else {
+				fakeCommentService.EditCommentReturns(createdComment, nil)
+			}
+
+			recorder := serveAs(t, &fakeCommentService, "PATCH", tt.url, tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+
+				_, _, gotUserID, gotBody := fakeCommentService.EditCommentArgsForCall(0)
+				assert.Equal(t, author.ID, gotUserID, "expect comment to be edited by the authenticated user")
+				assert.Equal(t, "This is a reply", gotBody, "expect body to match")
+			}
+		})
+	}
+}
+
+func TestTransport_DeleteComment(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000002",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "not the author :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000002",
+			user:           &author,
+			serviceErr:     commentsvc.ErrCommentNotAuthor,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "comment not found :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000009",
+			user:           &author,
+			serviceErr:     commentsrepo.ErrCommentNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "author deletes comment :POS",
+			url:            "/comments/00000000-0000-0000-0000-000000000002",
+			user:           &author,
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCommentService := commentfakes.FakeCommentService{}
+			fakeCommentService.DeleteCommentReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakeCommentService, "DELETE", tt.url, "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+		})
+	}
+}
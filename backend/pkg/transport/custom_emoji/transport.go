@@ -0,0 +1,201 @@
+package customemoji
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	customemojirepo "github.com/glowfi/voxpopuli/backend/pkg/repo/custom_emoji"
+	customemojisvc "github.com/glowfi/voxpopuli/backend/pkg/service/custom_emoji"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . CustomEmojiService
+type CustomEmojiService interface {
+	VoxsphereCustomEmojis(ctx context.Context, voxsphereID uuid.UUID) ([]models.CustomEmoji, error)
+	AddCustomEmoji(ctx context.Context, voxsphereID, moderatorID uuid.UUID, submission models.CustomEmojiSubmission) (models.CustomEmoji, error)
+	RenameCustomEmoji(ctx context.Context, voxsphereID, ID, moderatorID uuid.UUID, title string) (models.CustomEmoji, error)
+	DeleteCustomEmoji(ctx context.Context, voxsphereID, ID, moderatorID uuid.UUID) error
+}
+
+type Transport struct {
+	service CustomEmojiService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service CustomEmojiService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) VoxsphereCustomEmojis(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	customEmojis, err := t.service.VoxsphereCustomEmojis(r.Context(), voxsphereID)
+	if err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch custom emojis")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(customEmojis); err != nil {
+		log.Println("json encode error while fetching custom emojis:", err)
+	}
+}
+
+func (t *Transport) AddCustomEmoji(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	var submission models.CustomEmojiSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid custom emoji")
+		return
+	}
+
+	customEmoji, err := t.service.AddCustomEmoji(r.Context(), voxsphereID, user.ID, submission)
+	if err != nil {
+		writeCustomEmojiError(w, err, "failed to add custom emoji")
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(customEmoji); err != nil {
+		log.Println("json encode error while adding custom emoji:", err)
+	}
+}
+
+func (t *Transport) RenameCustomEmoji(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, emojiID, ok := emojiPathValues(w, r)
+	if !ok {
+		return
+	}
+
+	var rename models.CustomEmojiRename
+	if err := json.NewDecoder(r.Body).Decode(&rename); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid title")
+		return
+	}
+
+	customEmoji, err := t.service.RenameCustomEmoji(r.Context(), voxsphereID, emojiID, user.ID, rename.Title)
+	if err != nil {
+		writeCustomEmojiError(w, err, "failed to rename custom emoji")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(customEmoji); err != nil {
+		log.Println("json encode error while renaming custom emoji:", err)
+	}
+}
+
+func (t *Transport) DeleteCustomEmoji(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, emojiID, ok := emojiPathValues(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.service.DeleteCustomEmoji(r.Context(), voxsphereID, emojiID, user.ID); err != nil {
+		writeCustomEmojiError(w, err, "failed to delete custom emoji")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func emojiPathValues(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return uuid.Nil, uuid.Nil, false
+	}
+	emojiID, err := uuid.Parse(r.PathValue("emoji_id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid custom emoji id")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return voxsphereID, emojiID, true
+}
+
+// writeCustomEmojiError answers a failed custom emoji change, falling back to
+// an internal server error with fallbackMsg.
+func writeCustomEmojiError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, customemojisvc.ErrCustomEmojiInvalidTitle),
+		errors.Is(err, customemojisvc.ErrCustomEmojiInvalidUrl):
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, customemojisvc.ErrCustomEmojiNotModerator):
+		writeResponseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, customemojirepo.ErrCustomEmojiNotFound),
+		errors.Is(err, customemojirepo.ErrCustomEmojiParentTableRecordNotFound):
+		writeResponseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, customemojirepo.ErrCustomEmojiDuplicateTitle):
+		writeResponseError(w, http.StatusConflict, err.Error())
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
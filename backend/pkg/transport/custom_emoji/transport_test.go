@@ -0,0 +1,232 @@
+package customemoji_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	customemojirepo "github.com/glowfi/voxpopuli/backend/pkg/repo/custom_emoji"
+	customemojisvc "github.com/glowfi/voxpopuli/backend/pkg/service/custom_emoji"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/custom_emoji/custom_emojifakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var moderator = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+// serveAs sends a request of method to url with body through a server backed
+// by fakeCustomEmojiService, as user when one is given.
+func serveAs(t *testing.T, fakeCustomEmojiService *custom_emojifakes.FakeCustomEmojiService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		CustomEmoji: fakeCustomEmojiService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_VoxsphereCustomEmojis(t *testing.T) {
+	fakeCustomEmojiService := custom_emojifakes.FakeCustomEmojiService{}
+	fakeCustomEmojiService.VoxsphereCustomEmojisReturns([]models.CustomEmoji{
+		{
+			ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Url:         "https://example.com/party.png",
+			Title:       ":party:",
+		},
+	}, nil)
+
+	recorder := serveAs(t, &fakeCustomEmojiService, "GET", "/voxspheres/00000000-0000-0000-0000-000000000001/emojis", "", nil)
+
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "expect status code to match")
+	assert.JSONEq(t, `
+    [
+      {
+        "id": "00000000-0000-0000-0000-000000000001",
+        "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+        "url": "https://example.com/party.png",
+        "title": ":party:"
+      }
+    ]
+    `, recorder.Body.String())
+}
+
+func TestTransport_AddCustomEmoji(t *testing.T) {
+	body := `{"title": "party", "url": "https://example.com/party.png"}`
+
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantAddCalls   int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/emojis",
+			body:           body,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid voxsphere id :NEG",
+			url:            "/voxspheres/foo/emojis",
+			body:           body,
+			user:           &moderator,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid title :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/emojis",
+			body:           `{"title": "party time", "url": "https://example.com/party.png"}`,
+			user:           &moderator,
+			serviceErr:     customemojisvc.ErrCustomEmojiInvalidTitle,
+			wantStatusCode: http.StatusBadRequest,
+			wantAddCalls:   1,
+		},
+		{
+			name:           "not a moderator :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/emojis",
+			body:           body,
+			user:           &moderator,
+			serviceErr:     customemojisvc.ErrCustomEmojiNotModerator,
+			wantStatusCode: http.StatusForbidden,
+			wantAddCalls:   1,
+		},
+		{
+			name:           "duplicate title :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/emojis",
+			body:           body,
+			user:           &moderator,
+			serviceErr:     customemojirepo.ErrCustomEmojiDuplicateTitle,
+			wantStatusCode: http.StatusConflict,
+			wantAddCalls:   1,
+		},
+		{
+			name:           "add emoji :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/emojis",
+			body:           body,
+			user:           &moderator,
+			wantStatusCode: http.StatusCreated,
+			wantAddCalls:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCustomEmojiService := custom_emojifakes.FakeCustomEmojiService{}
+			fakeCustomEmojiService.AddCustomEmojiReturns(models.CustomEmoji{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeCustomEmojiService, "POST", tt.url, tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantAddCalls, fakeCustomEmojiService.AddCustomEmojiCallCount(), "expect add call count to match")
+		})
+	}
+}
+
+func TestTransport_RenameCustomEmoji(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		serviceErr     error
+		wantStatusCode int
+		wantTitle      string
+	}{
+		{
+			name:           "invalid custom emoji id :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/emojis/foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "custom emoji not found :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/emojis/00000000-0000-0000-0000-000000000009",
+			serviceErr:     customemojirepo.ErrCustomEmojiNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantTitle:      "dance",
+		},
+		{
+			name:           "rename :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/emojis/00000000-0000-0000-0000-000000000001",
+			wantStatusCode: http.StatusOK,
+			wantTitle:      "dance",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCustomEmojiService := custom_emojifakes.FakeCustomEmojiService{}
+			fakeCustomEmojiService.RenameCustomEmojiReturns(models.CustomEmoji{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeCustomEmojiService, "PATCH", tt.url, `{"title": "dance"}`, &moderator)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if len(tt.wantTitle) != 0 {
+				_, _, _, _, gotTitle := fakeCustomEmojiService.RenameCustomEmojiArgsForCall(0)
+				assert.Equal(t, tt.wantTitle, gotTitle, "expect title to match")
+			}
+		})
+	}
+}
+
+func TestTransport_DeleteCustomEmoji(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "not a moderator :NEG",
+			user:           &moderator,
+			serviceErr:     customemojisvc.ErrCustomEmojiNotModerator,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "delete emoji :POS",
+			user:           &moderator,
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCustomEmojiService := custom_emojifakes.FakeCustomEmojiService{}
+			fakeCustomEmojiService.DeleteCustomEmojiReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakeCustomEmojiService, "DELETE", "/voxspheres/00000000-0000-0000-0000-000000000001/emojis/00000000-0000-0000-0000-000000000001", "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	moderationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/moderation"
+	moderationsvc "github.com/glowfi/voxpopuli/backend/pkg/service/moderation"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . ModerationService
+type ModerationService interface {
+	ModeratePost(ctx context.Context, postID, moderatorID uuid.UUID, action models.ModAction) (models.ModLog, error)
+	ModerateComment(ctx context.Context, commentID, moderatorID uuid.UUID, action models.ModAction) (models.ModLog, error)
+	BanUser(ctx context.Context, voxsphereID, userID, moderatorID uuid.UUID, submission models.BanSubmission) (models.VoxsphereBan, error)
+	UnbanUser(ctx context.Context, voxsphereID, userID, moderatorID uuid.UUID) error
+	ModLogs(ctx context.Context, voxsphereID, moderatorID uuid.UUID, skip, limit int) ([]models.ModLog, error)
+}
+
+type Transport struct {
+	service ModerationService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service ModerationService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+// ModeratePost returns the handler taking action on the post of the id path
+// value. It answers with the mod log entry of the action.
+func (t *Transport) ModeratePost(action models.ModAction) http.HandlerFunc {
+	return t.moderate(action, "post", ModerationService.ModeratePost)
+}
+
+// ModerateComment returns the handler taking action on the comment of the id
+// path value. It answers with the mod log entry of the action.
+func (t *Transport) ModerateComment(action models.ModAction) http.HandlerFunc {
+	return t.moderate(action, "comment", ModerationService.ModerateComment)
+}
+
+func (t *Transport) moderate(action models.ModAction, target string, take func(service ModerationService, ctx context.Context, ID, moderatorID uuid.UUID, action models.ModAction) (models.ModLog, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.Context().Err(); err != nil {
+			writeResponseError(w, http.StatusInternalServerError, "request context error")
+			return
+		}
+
+		user, ok := middleware.UserFromContext(r.Context())
+		if !ok {
+			writeResponseError(w, http.StatusUnauthorized, "login required")
+			return
+		}
+
+		ID, err := uuid.Parse(r.PathValue("id"))
+		if err != nil {
+			writeResponseError(w, http.StatusBadRequest, fmt.Sprintf("add a valid %s id", target))
+			return
+		}
+
+		modLog, err := take(t.service, r.Context(), ID, user.ID, action)
+		if err != nil {
+			writeModerationError(w, err, fmt.Sprintf("failed to moderate %s", target))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(modLog); err != nil {
+			log.Printf("json encode error while moderating %s: %v", target, err)
+		}
+	}
+}
+
+func (t *Transport) BanUser(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, userID, ok := banPathValues(w, r)
+	if !ok {
+		return
+	}
+
+	var submission models.BanSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid ban")
+		return
+	}
+
+	ban, err := t.service.BanUser(r.Context(), voxsphereID, userID, user.ID, submission)
+	if err != nil {
+		writeModerationError(w, err, "failed to ban user")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ban); err != nil {
+		log.Println("json encode error while banning user:", err)
+	}
+}
+
+func (t *Transport) UnbanUser(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, userID, ok := banPathValues(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.service.UnbanUser(r.Context(), voxsphereID, userID, user.ID); err != nil {
+		writeModerationError(w, err, "failed to unban user")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (t *Transport) ModLogs(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	skipStr := r.URL.Query().Get("skip")
+	if len(skipStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid skip")
+		return
+	}
+	skip, err := parseIntParam(skipStr, "skip")
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if len(limitStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid limit")
+		return
+	}
+	limit, err := parseLimitParam(limitStr)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	modLogs, err := t.service.ModLogs(r.Context(), voxsphereID, user.ID, skip, limit)
+	if err != nil {
+		writeModerationError(w, err, "failed to fetch mod log")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(modLogs); err != nil {
+		log.Println("json encode error while fetching mod log:", err)
+	}
+}
+
+// banPathValues parses the voxsphere and user ids of a ban path, answering
+// with a bad request when either is invalid.
+func banPathValues(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return uuid.Nil, uuid.Nil, false
+	}
+	userID, err := uuid.Parse(r.PathValue("user_id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid user id")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return voxsphereID, userID, true
+}
+
+// writeModerationError answers a failed moderation action, falling back to an
+// internal server error with fallbackMsg.
+func writeModerationError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, moderationsvc.ErrModInvalidBanReason),
+		errors.Is(err, moderationsvc.ErrModInvalidBanExpiry),
+		errors.Is(err, moderationsvc.ErrModCannotBanYourself),
+		errors.Is(err, moderationrepo.ErrModInvalidAction):
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, moderationsvc.ErrModNotModerator):
+		writeResponseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, moderationrepo.ErrModPostNotFound),
+		errors.Is(err, moderationrepo.ErrModCommentNotFound),
+		errors.Is(err, moderationrepo.ErrModUserNotFound),
+		errors.Is(err, moderationrepo.ErrModVoxsphereNotFound),
+		errors.Is(err, moderationrepo.ErrModBanNotFound):
+		writeResponseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, moderationrepo.ErrModPinLimit):
+		writeResponseError(w, http.StatusConflict, err.Error())
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
+
+func parseIntParam(param string, paramName string) (int, error) {
+	value, err := strconv.Atoi(param)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", paramName, err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("invalid %s: value must be non-negative", paramName)
+	}
+	return value, nil
+}
+
+// maxLimit is the largest page size a request may ask for.
+const maxLimit = 100
+
+func parseLimitParam(param string) (int, error) {
+	limit, err := parseIntParam(param, "limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit > maxLimit {
+		return 0, fmt.Errorf("invalid limit: value must be at most %d", maxLimit)
+	}
+	return limit, nil
+}
@@ -0,0 +1,405 @@
+package moderation_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	moderationrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/moderation"
+	moderationsvc "github.com/glowfi/voxpopuli/backend/pkg/service/moderation"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/moderation/moderationfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var moderator = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+// serveAs sends a request of method to url with body through a server backed
+// by fakeModerationService, as user when one is given.
+func serveAs(t *testing.T, fakeModerationService *moderationfakes.FakeModerationService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Moderation: fakeModerationService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_ModeratePost(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		url            string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantAction     models.ModAction
+		wantResponse   string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/lock",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid post id :NEG",
+			method:         "PUT",
+			url:            "/posts/foo/lock",
+			user:           &moderator,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "not a moderator :NEG",
+			method:         "POST",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/remove",
+			user:           &moderator,
+			serviceErr:     moderationsvc.ErrModNotModerator,
+			wantStatusCode: http.StatusForbidden,
+			wantAction:     models.ModActionRemovePost,
+		},
+		{
+			name:           "post not found :NEG",
+			method:         "POST",
+			url:            "/posts/00000000-0000-0000-0000-000000000009/approve",
+			user:           &moderator,
+			serviceErr:     moderationrepo.ErrModPostNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantAction:     models.ModActionApprovePost,
+		},
+		{
+			name:           "pin limit reached :NEG",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/pin",
+			user:           &moderator,
+			serviceErr:     moderationrepo.ErrModPinLimit,
+			wantStatusCode: http.StatusConflict,
+			wantAction:     models.ModActionPinPost,
+		},
+		{
+			name:           "internal server error :NEG",
+			method:         "DELETE",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/pin",
+			user:           &moderator,
+			serviceErr:     errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantAction:     models.ModActionUnpinPost,
+		},
+		{
+			name:           "unmark spoiler :POS",
+			method:         "DELETE",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/spoiler",
+			user:           &moderator,
+			wantStatusCode: http.StatusOK,
+			wantAction:     models.ModActionUnmarkSpoiler,
+		},
+		{
+			name:           "dismiss reports :POS",
+			method:         "DELETE",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/reports",
+			user:           &moderator,
+			wantStatusCode: http.StatusOK,
+			wantAction:     models.ModActionDismissPostReports,
+		},
+		{
+			name:           "mark nsfw :POS",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/nsfw",
+			user:           &moderator,
+			wantStatusCode: http.StatusOK,
+			wantAction:     models.ModActionMarkNSFW,
+			wantResponse: `
+            {
+              "id": "00000000-0000-0000-0000-000000000001",
+              "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+              "moderator_id": "00000000-0000-0000-0000-000000000001",
+              "moderator": "",
+              "action": "mark_nsfw",
+              "target_id": "00000000-0000-0000-0000-000000000001",
+              "details": "",
+              "created_at": "2024-10-10T10:10:10Z",
+              "created_at_unix": 1725091100
+            }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationService := moderationfakes.FakeModerationService{}
+			fakeModerationService.ModeratePostStub = func(_ context.Context, postID, moderatorID uuid.UUID, action models.ModAction) (models.ModLog, error) {
+				if tt.serviceErr != nil {
+					return models.ModLog{}, tt.serviceErr
+				}
+				return models.ModLog{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ModeratorID:   moderatorID,
+					Action:        action,
+					TargetID:      postID,
+					CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					CreatedAtUnix: 1725091100,
+				}, nil
+			}
+
+			recorder := serveAs(t, &fakeModerationService, tt.method, tt.url, "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if len(tt.wantAction) != 0 {
+				_, _, gotModeratorID, gotAction := fakeModerationService.ModeratePostArgsForCall(0)
+				assert.Equal(t, moderator.ID, gotModeratorID, "expect the authenticated user to moderate")
+				assert.Equal(t, tt.wantAction, gotAction, "expect action to match")
+			} else {
+				assert.Equal(t, 0, fakeModerationService.ModeratePostCallCount(), "expect no moderation")
+			}
+			if len(tt.wantResponse) != 0 {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransport_ModerateComment(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		serviceErr     error
+		wantStatusCode int
+		wantAction     models.ModAction
+	}{
+		{
+			name:           "invalid comment id :NEG",
+			url:            "/comments/foo/remove",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "comment not found :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000009/remove",
+			serviceErr:     moderationrepo.ErrModCommentNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantAction:     models.ModActionRemoveComment,
+		},
+		{
+			name:           "remove comment :POS",
+			url:            "/comments/00000000-0000-0000-0000-000000000001/remove",
+			wantStatusCode: http.StatusOK,
+			wantAction:     models.ModActionRemoveComment,
+		},
+		{
+			name:           "approve comment :POS",
+			url:            "/comments/00000000-0000-0000-0000-000000000001/approve",
+			wantStatusCode: http.StatusOK,
+			wantAction:     models.ModActionApproveComment,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationService := moderationfakes.FakeModerationService{}
+			fakeModerationService.ModerateCommentReturns(models.ModLog{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeModerationService, "POST", tt.url, "", &moderator)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if len(tt.wantAction) != 0 {
+				_, _, _, gotAction := fakeModerationService.ModerateCommentArgsForCall(0)
+				assert.Equal(t, tt.wantAction, gotAction, "expect action to match")
+			}
+		})
+	}
+}
+
+func TestTransport_BanUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantBanCalls   int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/bans/00000000-0000-0000-0000-000000000002",
+			body:           `{}`,
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid user id :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/bans/foo",
+			body:           `{}`,
+			user:           &moderator,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid body :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/bans/00000000-0000-0000-0000-000000000002",
+			body:           `{"expires_at": "tomorrow"}`,
+			user:           &moderator,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "expiry in the past :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/bans/00000000-0000-0000-0000-000000000002",
+			body:           `{"expires_at": "2020-01-01T00:00:00Z"}`,
+			user:           &moderator,
+			serviceErr:     moderationsvc.ErrModInvalidBanExpiry,
+			wantStatusCode: http.StatusBadRequest,
+			wantBanCalls:   1,
+		},
+		{
+			name:           "not a moderator :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/bans/00000000-0000-0000-0000-000000000002",
+			body:           `{"reason": "spam"}`,
+			user:           &moderator,
+			serviceErr:     moderationsvc.ErrModNotModerator,
+			wantStatusCode: http.StatusForbidden,
+			wantBanCalls:   1,
+		},
+		{
+			name:           "ban user :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/bans/00000000-0000-0000-0000-000000000002",
+			body:           `{"reason": "spam", "expires_at": "2124-10-10T10:10:10Z"}`,
+			user:           &moderator,
+			wantStatusCode: http.StatusOK,
+			wantBanCalls:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationService := moderationfakes.FakeModerationService{}
+			fakeModerationService.BanUserReturns(models.VoxsphereBan{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeModerationService, "PUT", tt.url, tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantBanCalls, fakeModerationService.BanUserCallCount(), "expect ban call count to match")
+
+			if tt.wantStatusCode == http.StatusOK {
+				_, gotVoxsphereID, gotUserID, gotModeratorID, gotSubmission := fakeModerationService.BanUserArgsForCall(0)
+				expiresAt := time.Date(2124, 10, 10, 10, 10, 10, 0, time.UTC)
+				assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000001"), gotVoxsphereID, "expect voxsphere id to match")
+				assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000002"), gotUserID, "expect user id to match")
+				assert.Equal(t, moderator.ID, gotModeratorID, "expect the authenticated user to ban")
+				assert.Equal(t, models.BanSubmission{Reason: "spam", ExpiresAt: &expiresAt}, gotSubmission, "expect submission to match")
+			}
+		})
+	}
+}
+
+func TestTransport_UnbanUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		serviceErr     error
+		wantStatusCode int
+	}{
+		{
+			name:           "ban not found :NEG",
+			serviceErr:     moderationrepo.ErrModBanNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "unban user :POS",
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationService := moderationfakes.FakeModerationService{}
+			fakeModerationService.UnbanUserReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakeModerationService, "DELETE", "/voxspheres/00000000-0000-0000-0000-000000000001/bans/00000000-0000-0000-0000-000000000002", "", &moderator)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, 1, fakeModerationService.UnbanUserCallCount(), "expect unban to be called")
+		})
+	}
+}
+
+func TestTransport_ModLogs(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		serviceErr     error
+		wantStatusCode int
+		wantLogCalls   int
+	}{
+		{
+			name:           "missing skip :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/modlog?limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid limit :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/modlog?skip=0&limit=-1",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "limit above maximum :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/modlog?skip=0&limit=101",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "not a moderator :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/modlog?skip=0&limit=10",
+			serviceErr:     moderationsvc.ErrModNotModerator,
+			wantStatusCode: http.StatusForbidden,
+			wantLogCalls:   1,
+		},
+		{
+			name:           "mod log :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/modlog?skip=0&limit=10",
+			wantStatusCode: http.StatusOK,
+			wantLogCalls:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeModerationService := moderationfakes.FakeModerationService{}
+			fakeModerationService.ModLogsReturns([]models.ModLog{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeModerationService, "GET", tt.url, "", &moderator)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantLogCalls, fakeModerationService.ModLogsCallCount(), "expect mod log call count to match")
+
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, `[]`, recorder.Body.String())
+				_, _, gotModeratorID, gotSkip, gotLimit := fakeModerationService.ModLogsArgsForCall(0)
+				assert.Equal(t, moderator.ID, gotModeratorID, "expect the authenticated user to read the mod log")
+				assert.Equal(t, 0, gotSkip, "expect skip to match")
+				assert.Equal(t, 10, gotLimit, "expect limit to match")
+			}
+		})
+	}
+}
@@ -7,15 +7,96 @@ import (
 
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
 	"github.com/glowfi/voxpopuli/backend/pkg/transport/post"
+	"github.com/google/uuid"
 )
 
 type FakePostService struct {
-	PostsPaginatedStub        func(context.Context, int, int) ([]models.PostPaginated, error)
+	CreatePostStub        func(context.Context, uuid.UUID, models.PostSubmission) (models.Post, error)
+	createPostMutex       sync.RWMutex
+	createPostArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 models.PostSubmission
+	}
+	createPostReturns struct {
+		result1 models.Post
+		result2 error
+	}
+	createPostReturnsOnCall map[int]struct {
+		result1 models.Post
+		result2 error
+	}
+	DeletePostStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deletePostMutex       sync.RWMutex
+	deletePostArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deletePostReturns struct {
+		result1 error
+	}
+	deletePostReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EditPostStub        func(context.Context, uuid.UUID, uuid.UUID, models.PostEdit) (models.Post, error)
+	editPostMutex       sync.RWMutex
+	editPostArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 models.PostEdit
+	}
+	editPostReturns struct {
+		result1 models.Post
+		result2 error
+	}
+	editPostReturnsOnCall map[int]struct {
+		result1 models.Post
+		result2 error
+	}
+	PostDetailByIDStub        func(context.Context, uuid.UUID, uuid.UUID) (models.PostDetail, error)
+	postDetailByIDMutex       sync.RWMutex
+	postDetailByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	postDetailByIDReturns struct {
+		result1 models.PostDetail
+		result2 error
+	}
+	postDetailByIDReturnsOnCall map[int]struct {
+		result1 models.PostDetail
+		result2 error
+	}
+	PostsAfterStub        func(context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, *models.PostCursor, int) (models.PostFeed, error)
+	postsAfterMutex       sync.RWMutex
+	postsAfterArgsForCall []struct {
+		arg1 context.Context
+		arg2 models.PostSort
+		arg3 models.PostSortWindow
+		arg4 models.PostFilter
+		arg5 *models.PostCursor
+		arg6 int
+	}
+	postsAfterReturns struct {
+		result1 models.PostFeed
+		result2 error
+	}
+	postsAfterReturnsOnCall map[int]struct {
+		result1 models.PostFeed
+		result2 error
+	}
+	PostsPaginatedStub        func(context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, int, int) ([]models.PostPaginated, error)
 	postsPaginatedMutex       sync.RWMutex
 	postsPaginatedArgsForCall []struct {
 		arg1 context.Context
-		arg2 int
-		arg3 int
+		arg2 models.PostSort
+		arg3 models.PostSortWindow
+		arg4 models.PostFilter
+		arg5 int
+		arg6 int
 	}
 	postsPaginatedReturns struct {
 		result1 []models.PostPaginated
@@ -29,20 +110,354 @@ type FakePostService struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakePostService) PostsPaginated(arg1 context.Context, arg2 int, arg3 int) ([]models.PostPaginated, error) {
+func (fake *FakePostService) CreatePost(arg1 context.Context, arg2 uuid.UUID, arg3 models.PostSubmission) (models.Post, error) {
+	fake.createPostMutex.Lock()
+	ret, specificReturn := fake.createPostReturnsOnCall[len(fake.createPostArgsForCall)]
+	fake.createPostArgsForCall = append(fake.createPostArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 models.PostSubmission
+	}{arg1, arg2, arg3})
+	stub := fake.CreatePostStub
+	fakeReturns := fake.createPostReturns
+	fake.recordInvocation("CreatePost", []interface{}{arg1, arg2, arg3})
+	fake.createPostMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostService) CreatePostCallCount() int {
+	fake.createPostMutex.RLock()
+	defer fake.createPostMutex.RUnlock()
+	return len(fake.createPostArgsForCall)
+}
+
+func (fake *FakePostService) CreatePostCalls(stub func(context.Context, uuid.UUID, models.PostSubmission) (models.Post, error)) {
+	fake.createPostMutex.Lock()
+	defer fake.createPostMutex.Unlock()
+	fake.CreatePostStub = stub
+}
+
+func (fake *FakePostService) CreatePostArgsForCall(i int) (context.Context, uuid.UUID, models.PostSubmission) {
+	fake.createPostMutex.RLock()
+	defer fake.createPostMutex.RUnlock()
+	argsForCall := fake.createPostArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostService) CreatePostReturns(result1 models.Post, result2 error) {
+	fake.createPostMutex.Lock()
+	defer fake.createPostMutex.Unlock()
+	fake.CreatePostStub = nil
+	fake.createPostReturns = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostService) CreatePostReturnsOnCall(i int, result1 models.Post, result2 error) {
+	fake.createPostMutex.Lock()
+	defer fake.createPostMutex.Unlock()
+	fake.CreatePostStub = nil
+	if fake.createPostReturnsOnCall == nil {
+		fake.createPostReturnsOnCall = make(map[int]struct {
+			result1 models.Post
+			result2 error
+		})
+	}
+	fake.createPostReturnsOnCall[i] = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostService) DeletePost(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deletePostMutex.Lock()
+	ret, specificReturn := fake.deletePostReturnsOnCall[len(fake.deletePostArgsForCall)]
+	fake.deletePostArgsForCall = append(fake.deletePostArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeletePostStub
+	fakeReturns := fake.deletePostReturns
+	fake.recordInvocation("DeletePost", []interface{}{arg1, arg2, arg3})
+	fake.deletePostMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePostService) DeletePostCallCount() int {
+	fake.deletePostMutex.RLock()
+	defer fake.deletePostMutex.RUnlock()
+	return len(fake.deletePostArgsForCall)
+}
+
+func (fake *FakePostService) DeletePostCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deletePostMutex.Lock()
+	defer fake.deletePostMutex.Unlock()
+	fake.DeletePostStub = stub
+}
+
+func (fake *FakePostService) DeletePostArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deletePostMutex.RLock()
+	defer fake.deletePostMutex.RUnlock()
+	argsForCall := fake.deletePostArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostService) DeletePostReturns(result1 error) {
+	fake.deletePostMutex.Lock()
+	defer fake.deletePostMutex.Unlock()
+	fake.DeletePostStub = nil
+	fake.deletePostReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePostService) DeletePostReturnsOnCall(i int, result1 error) {
+	fake.deletePostMutex.Lock()
+	defer fake.deletePostMutex.Unlock()
+	fake.DeletePostStub = nil
+	if fake.deletePostReturnsOnCall == nil {
+		fake.deletePostReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deletePostReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePostService) EditPost(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 models.PostEdit) (models.Post, error) {
+	fake.editPostMutex.Lock()
+	ret, specificReturn := fake.editPostReturnsOnCall[len(fake.editPostArgsForCall)]
+	fake.editPostArgsForCall = append(fake.editPostArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 models.PostEdit
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EditPostStub
+	fakeReturns := fake.editPostReturns
+	fake.recordInvocation("EditPost", []interface{}{arg1, arg2, arg3, arg4})
+	fake.editPostMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostService) EditPostCallCount() int {
+	fake.editPostMutex.RLock()
+	defer fake.editPostMutex.RUnlock()
+	return len(fake.editPostArgsForCall)
+}
+
+func (fake *FakePostService) EditPostCalls(stub func(context.Context, uuid.UUID, uuid.UUID, models.PostEdit) (models.Post, error)) {
+	fake.editPostMutex.Lock()
+	defer fake.editPostMutex.Unlock()
+	fake.EditPostStub = stub
+}
+
+func (fake *FakePostService) EditPostArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, models.PostEdit) {
+	fake.editPostMutex.RLock()
+	defer fake.editPostMutex.RUnlock()
+	argsForCall := fake.editPostArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePostService) EditPostReturns(result1 models.Post, result2 error) {
+	fake.editPostMutex.Lock()
+	defer fake.editPostMutex.Unlock()
+	fake.EditPostStub = nil
+	fake.editPostReturns = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostService) EditPostReturnsOnCall(i int, result1 models.Post, result2 error) {
+	fake.editPostMutex.Lock()
+	defer fake.editPostMutex.Unlock()
+	fake.EditPostStub = nil
+	if fake.editPostReturnsOnCall == nil {
+		fake.editPostReturnsOnCall = make(map[int]struct {
+			result1 models.Post
+			result2 error
+		})
+	}
+	fake.editPostReturnsOnCall[i] = struct {
+		result1 models.Post
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostService) PostDetailByID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (models.PostDetail, error) {
+	fake.postDetailByIDMutex.Lock()
+	ret, specificReturn := fake.postDetailByIDReturnsOnCall[len(fake.postDetailByIDArgsForCall)]
+	fake.postDetailByIDArgsForCall = append(fake.postDetailByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.PostDetailByIDStub
+	fakeReturns := fake.postDetailByIDReturns
+	fake.recordInvocation("PostDetailByID", []interface{}{arg1, arg2, arg3})
+	fake.postDetailByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostService) PostDetailByIDCallCount() int {
+	fake.postDetailByIDMutex.RLock()
+	defer fake.postDetailByIDMutex.RUnlock()
+	return len(fake.postDetailByIDArgsForCall)
+}
+
+func (fake *FakePostService) PostDetailByIDCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (models.PostDetail, error)) {
+	fake.postDetailByIDMutex.Lock()
+	defer fake.postDetailByIDMutex.Unlock()
+	fake.PostDetailByIDStub = stub
+}
+
+func (fake *FakePostService) PostDetailByIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.postDetailByIDMutex.RLock()
+	defer fake.postDetailByIDMutex.RUnlock()
+	argsForCall := fake.postDetailByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostService) PostDetailByIDReturns(result1 models.PostDetail, result2 error) {
+	fake.postDetailByIDMutex.Lock()
+	defer fake.postDetailByIDMutex.Unlock()
+	fake.PostDetailByIDStub = nil
+	fake.postDetailByIDReturns = struct {
+		result1 models.PostDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostService) PostDetailByIDReturnsOnCall(i int, result1 models.PostDetail, result2 error) {
+	fake.postDetailByIDMutex.Lock()
+	defer fake.postDetailByIDMutex.Unlock()
+	fake.PostDetailByIDStub = nil
+	if fake.postDetailByIDReturnsOnCall == nil {
+		fake.postDetailByIDReturnsOnCall = make(map[int]struct {
+			result1 models.PostDetail
+			result2 error
+		})
+	}
+	fake.postDetailByIDReturnsOnCall[i] = struct {
+		result1 models.PostDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostService) PostsAfter(arg1 context.Context, arg2 models.PostSort, arg3 models.PostSortWindow, arg4 models.PostFilter, arg5 *models.PostCursor, arg6 int) (models.PostFeed, error) {
+	fake.postsAfterMutex.Lock()
+	ret, specificReturn := fake.postsAfterReturnsOnCall[len(fake.postsAfterArgsForCall)]
+	fake.postsAfterArgsForCall = append(fake.postsAfterArgsForCall, struct {
+		arg1 context.Context
+		arg2 models.PostSort
+		arg3 models.PostSortWindow
+		arg4 models.PostFilter
+		arg5 *models.PostCursor
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.PostsAfterStub
+	fakeReturns := fake.postsAfterReturns
+	fake.recordInvocation("PostsAfter", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.postsAfterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostService) PostsAfterCallCount() int {
+	fake.postsAfterMutex.RLock()
+	defer fake.postsAfterMutex.RUnlock()
+	return len(fake.postsAfterArgsForCall)
+}
+
+func (fake *FakePostService) PostsAfterCalls(stub func(context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, *models.PostCursor, int) (models.PostFeed, error)) {
+	fake.postsAfterMutex.Lock()
+	defer fake.postsAfterMutex.Unlock()
+	fake.PostsAfterStub = stub
+}
+
+func (fake *FakePostService) PostsAfterArgsForCall(i int) (context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, *models.PostCursor, int) {
+	fake.postsAfterMutex.RLock()
+	defer fake.postsAfterMutex.RUnlock()
+	argsForCall := fake.postsAfterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakePostService) PostsAfterReturns(result1 models.PostFeed, result2 error) {
+	fake.postsAfterMutex.Lock()
+	defer fake.postsAfterMutex.Unlock()
+	fake.PostsAfterStub = nil
+	fake.postsAfterReturns = struct {
+		result1 models.PostFeed
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostService) PostsAfterReturnsOnCall(i int, result1 models.PostFeed, result2 error) {
+	fake.postsAfterMutex.Lock()
+	defer fake.postsAfterMutex.Unlock()
+	fake.PostsAfterStub = nil
+	if fake.postsAfterReturnsOnCall == nil {
+		fake.postsAfterReturnsOnCall = make(map[int]struct {
+			result1 models.PostFeed
+			result2 error
+		})
+	}
+	fake.postsAfterReturnsOnCall[i] = struct {
+		result1 models.PostFeed
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostService) PostsPaginated(arg1 context.Context, arg2 models.PostSort, arg3 models.PostSortWindow, arg4 models.PostFilter, arg5 int, arg6 int) ([]models.PostPaginated, error) {
 	fake.postsPaginatedMutex.Lock()
 	ret, specificReturn := fake.postsPaginatedReturnsOnCall[len(fake.postsPaginatedArgsForCall)]
 	fake.postsPaginatedArgsForCall = append(fake.postsPaginatedArgsForCall, struct {
 		arg1 context.Context
-		arg2 int
-		arg3 int
-	}{arg1, arg2, arg3})
+		arg2 models.PostSort
+		arg3 models.PostSortWindow
+		arg4 models.PostFilter
+		arg5 int
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.PostsPaginatedStub
 	fakeReturns := fake.postsPaginatedReturns
-	fake.recordInvocation("PostsPaginated", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("PostsPaginated", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.postsPaginatedMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -56,17 +471,17 @@ func (fake *FakePostService) PostsPaginatedCallCount() int {
 	return len(fake.postsPaginatedArgsForCall)
 }
 
-func (fake *FakePostService) PostsPaginatedCalls(stub func(context.Context, int, int) ([]models.PostPaginated, error)) {
+func (fake *FakePostService) PostsPaginatedCalls(stub func(context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, int, int) ([]models.PostPaginated, error)) {
 	fake.postsPaginatedMutex.Lock()
 	defer fake.postsPaginatedMutex.Unlock()
 	fake.PostsPaginatedStub = stub
 }
 
-func (fake *FakePostService) PostsPaginatedArgsForCall(i int) (context.Context, int, int) {
+func (fake *FakePostService) PostsPaginatedArgsForCall(i int) (context.Context, models.PostSort, models.PostSortWindow, models.PostFilter, int, int) {
 	fake.postsPaginatedMutex.RLock()
 	defer fake.postsPaginatedMutex.RUnlock()
 	argsForCall := fake.postsPaginatedArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakePostService) PostsPaginatedReturns(result1 []models.PostPaginated, result2 error) {
@@ -98,6 +513,16 @@ func (fake *FakePostService) PostsPaginatedReturnsOnCall(i int, result1 []models
 func (fake *FakePostService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.createPostMutex.RLock()
+	defer fake.createPostMutex.RUnlock()
+	fake.deletePostMutex.RLock()
+	defer fake.deletePostMutex.RUnlock()
+	fake.editPostMutex.RLock()
+	defer fake.editPostMutex.RUnlock()
+	fake.postDetailByIDMutex.RLock()
+	defer fake.postDetailByIDMutex.RUnlock()
+	fake.postsAfterMutex.RLock()
+	defer fake.postsAfterMutex.RUnlock()
 	fake.postsPaginatedMutex.RLock()
 	defer fake.postsPaginatedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -3,17 +3,28 @@ package post
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	postrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post"
+	postsvc "github.com/glowfi/voxpopuli/backend/pkg/service/post"
+	"github.com/google/uuid"
 )
 
 //counterfeiter:generate . PostService
 type PostService interface {
-	PostsPaginated(ctx context.Context, skip, limit int) ([]models.PostPaginated, error)
+	PostsPaginated(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, skip, limit int) ([]models.PostPaginated, error)
+	PostsAfter(ctx context.Context, sort models.PostSort, window models.PostSortWindow, filter models.PostFilter, after *models.PostCursor, limit int) (models.PostFeed, error)
+	PostDetailByID(ctx context.Context, ID, viewerID uuid.UUID) (models.PostDetail, error)
+	CreatePost(ctx context.Context, authorID uuid.UUID, submission models.PostSubmission) (models.Post, error)
+	EditPost(ctx context.Context, ID, userID uuid.UUID, edit models.PostEdit) (models.Post, error)
+	DeletePost(ctx context.Context, ID, userID uuid.UUID) error
 }
 
 type Transport struct {
@@ -36,9 +47,68 @@ func (t *Transport) PostsPaginated(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	t.feed(w, r, models.PostFilter{})
+}
+
+func (t *Transport) VoxspherePosts(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	t.feed(w, r, models.PostFilter{VoxsphereID: voxsphereID})
+}
+
+func (t *Transport) UserPosts(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	t.feed(w, r, models.PostFilter{AuthorName: r.PathValue("name")})
+}
+
+// HomeFeed serves the posts of the voxspheres joined by the caller. Anonymous
+// callers get the posts of every voxsphere instead, which with the default hot
+// sort is the popular feed.
+func (t *Transport) HomeFeed(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	var filter models.PostFilter
+	if user, ok := middleware.UserFromContext(r.Context()); ok {
+		filter.MemberID = user.ID
+	}
+
+	t.feed(w, r, filter)
+}
+
+// feed serves the posts matching filter. Requests with a skip parameter are
+// paged by offset, every other request is paged by the after cursor and
+// answered with a feed envelope holding the cursor of the next page. Logged in
+// callers do not get the posts they hid.
+func (t *Transport) feed(w http.ResponseWriter, r *http.Request, filter models.PostFilter) {
+	if user, ok := middleware.UserFromContext(r.Context()); ok {
+		filter.ViewerID = user.ID
+	}
+
+	filter, err := parseFilterParams(r, filter)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	skipStr := r.URL.Query().Get("skip")
 	if len(skipStr) == 0 {
-		writeResponseError(w, http.StatusBadRequest, "add a valid skip number")
+		t.feedAfter(w, r, filter)
 		return
 	}
 	skip, err := parseIntParam(skipStr, "skip")
@@ -52,15 +122,21 @@ func (t *Transport) PostsPaginated(w http.ResponseWriter, r *http.Request) {
 		writeResponseError(w, http.StatusBadRequest, "add a valid limit")
 		return
 	}
-	limit, err := parseIntParam(limitStr, "limit")
+	limit, err := parseLimitParam(limitStr)
 	if err != nil {
 		writeResponseError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	posts, err := t.service.PostsPaginated(r.Context(), skip, limit)
+	sort, window, err := parseSortParams(r)
 	if err != nil {
-		writeResponseError(w, http.StatusInternalServerError, "failed to fetch posts")
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	posts, err := t.service.PostsPaginated(r.Context(), sort, window, filter, skip, limit)
+	if err != nil {
+		writeFeedError(w, err)
 		return
 	}
 
@@ -70,6 +146,212 @@ func (t *Transport) PostsPaginated(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (t *Transport) feedAfter(w http.ResponseWriter, r *http.Request, filter models.PostFilter) {
+	limitStr := r.URL.Query().Get("limit")
+	if len(limitStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid limit")
+		return
+	}
+	limit, err := parseLimitParam(limitStr)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if limit == 0 {
+		writeResponseError(w, http.StatusBadRequest, "invalid limit: value must be positive")
+		return
+	}
+
+	sort, window, err := parseSortParams(r)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var after *models.PostCursor
+	if afterStr := r.URL.Query().Get("after"); len(afterStr) != 0 {
+		cursor, err := models.DecodePostCursor(afterStr)
+		if err != nil {
+			writeResponseError(w, http.StatusBadRequest, "add a valid after cursor")
+			return
+		}
+		after = &cursor
+	}
+
+	feed, err := t.service.PostsAfter(r.Context(), sort, window, filter, after, limit)
+	if err != nil {
+		writeFeedError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(feed); err != nil {
+		log.Println("json encode error while fetching posts:", err)
+	}
+}
+
+// writeFeedError answers a failed feed request.
+func writeFeedError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, models.ErrInvalidPostCursor):
+		writeResponseError(w, http.StatusBadRequest, "after cursor does not match the sort")
+	case errors.Is(err, postrepo.ErrPostAuthorNotFound):
+		writeResponseError(w, http.StatusNotFound, "user not found")
+	case errors.Is(err, postrepo.ErrPostVoxsphereNotFound):
+		writeResponseError(w, http.StatusNotFound, "voxsphere not found")
+	default:
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch posts")
+	}
+}
+
+func (t *Transport) PostByID(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	var viewerID uuid.UUID
+	if user, ok := middleware.UserFromContext(r.Context()); ok {
+		viewerID = user.ID
+	}
+
+	post, err := t.service.PostDetailByID(r.Context(), ID, viewerID)
+	if err != nil {
+		if errors.Is(err, postrepo.ErrPostNotFound) {
+			writeResponseError(w, http.StatusNotFound, "post not found")
+			return
+		}
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch post")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(post); err != nil {
+		log.Println("json encode error while fetching post:", err)
+	}
+}
+
+func (t *Transport) CreatePost(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	var submission models.PostSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post")
+		return
+	}
+
+	post, err := t.service.CreatePost(r.Context(), user.ID, submission)
+	if err != nil {
+		writePostWriteError(w, err, "failed to create post")
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(post); err != nil {
+		log.Println("json encode error while creating post:", err)
+	}
+}
+
+func (t *Transport) EditPost(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	var edit models.PostEdit
+	if err := json.NewDecoder(r.Body).Decode(&edit); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post edit")
+		return
+	}
+
+	post, err := t.service.EditPost(r.Context(), ID, user.ID, edit)
+	if err != nil {
+		writePostWriteError(w, err, "failed to edit post")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(post); err != nil {
+		log.Println("json encode error while editing post:", err)
+	}
+}
+
+func (t *Transport) DeletePost(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	if err := t.service.DeletePost(r.Context(), ID, user.ID); err != nil {
+		writePostWriteError(w, err, "failed to delete post")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writePostWriteError answers a failed post creation, edit or deletion,
+// falling back to an internal server error with fallbackMsg.
+func writePostWriteError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, postsvc.ErrPostInvalidTitle),
+		errors.Is(err, postsvc.ErrPostInvalidText),
+		errors.Is(err, postsvc.ErrPostInvalidLink):
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, postsvc.ErrPostNotMember),
+		errors.Is(err, postsvc.ErrPostBanned),
+		errors.Is(err, postsvc.ErrPostNotAuthor):
+		writeResponseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, postrepo.ErrPostNotFound):
+		writeResponseError(w, http.StatusNotFound, "post not found")
+	case errors.Is(err, postrepo.ErrPostDuplicateID):
+		writeResponseError(w, http.StatusConflict, "post already exists")
+	case errors.Is(err, postrepo.ErrPostParentTableRecordNotFound):
+		writeResponseError(w, http.StatusUnprocessableEntity, "voxsphere or author does not exist")
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
 func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -81,6 +363,87 @@ func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string
 	}
 }
 
+// parseFilterParams adds the media_type, nsfw, spoiler, flair and voxsphere
+// query parameters of a feed request to filter. media_type and voxsphere take
+// comma separated lists.
+func parseFilterParams(r *http.Request, filter models.PostFilter) (models.PostFilter, error) {
+	query := r.URL.Query()
+
+	if mediaTypesStr := query.Get("media_type"); len(mediaTypesStr) != 0 {
+		for _, mediaTypeStr := range strings.Split(mediaTypesStr, ",") {
+			mediaType := models.MediaType(strings.TrimSpace(mediaTypeStr))
+			switch mediaType {
+			case models.MediaTypeImage, models.MediaTypeGif, models.MediaTypeVideo, models.MediaTypeGallery,
+				models.MediaTypeLink, models.MediaTypeMulti, models.MediaTypeText:
+			default:
+				return models.PostFilter{}, fmt.Errorf("invalid media_type: %q", mediaType)
+			}
+			filter.MediaTypes = append(filter.MediaTypes, mediaType)
+		}
+	}
+
+	nsfw := models.PostNSFW(query.Get("nsfw"))
+	switch nsfw {
+	case "", models.PostNSFWInclude, models.PostNSFWExclude, models.PostNSFWOnly:
+		filter.NSFW = nsfw
+	default:
+		return models.PostFilter{}, fmt.Errorf("invalid nsfw: %q", nsfw)
+	}
+
+	switch spoiler := query.Get("spoiler"); spoiler {
+	case "", "include":
+	case "exclude":
+		filter.ExcludeSpoilers = true
+	default:
+		return models.PostFilter{}, fmt.Errorf("invalid spoiler: %q", spoiler)
+	}
+
+	if flairStr := query.Get("flair"); len(flairStr) != 0 {
+		flairID, err := uuid.Parse(flairStr)
+		if err != nil {
+			return models.PostFilter{}, fmt.Errorf("invalid flair: %q", flairStr)
+		}
+		filter.FlairID = flairID
+	}
+
+	if voxspheresStr := query.Get("voxsphere"); len(voxspheresStr) != 0 {
+		for _, voxsphereStr := range strings.Split(voxspheresStr, ",") {
+			voxsphereID, err := uuid.Parse(strings.TrimSpace(voxsphereStr))
+			if err != nil {
+				return models.PostFilter{}, fmt.Errorf("invalid voxsphere: %q", voxsphereStr)
+			}
+			filter.VoxsphereIDs = append(filter.VoxsphereIDs, voxsphereID)
+		}
+	}
+
+	return filter, nil
+}
+
+// parseSortParams reads the sort and t query parameters of a feed request,
+// defaulting to the hot sort and, for the windowed sorts, all time, so that a
+// bare sort=top ranks every post.
+func parseSortParams(r *http.Request) (models.PostSort, models.PostSortWindow, error) {
+	sort := models.PostSort(r.URL.Query().Get("sort"))
+	switch sort {
+	case "":
+		sort = models.PostSortHot
+	case models.PostSortHot, models.PostSortTop, models.PostSortNew, models.PostSortRising, models.PostSortControversial:
+	default:
+		return "", "", fmt.Errorf("invalid sort: %q", sort)
+	}
+
+	window := models.PostSortWindow(r.URL.Query().Get("t"))
+	switch window {
+	case "":
+		window = models.PostSortWindowAll
+	case models.PostSortWindowDay, models.PostSortWindowWeek, models.PostSortWindowMonth, models.PostSortWindowYear, models.PostSortWindowAll:
+	default:
+		return "", "", fmt.Errorf("invalid t: %q", window)
+	}
+
+	return sort, window, nil
+}
+
 func parseIntParam(param string, paramName string) (int, error) {
 	value, err := strconv.Atoi(param)
 	if err != nil {
@@ -91,3 +454,17 @@ func parseIntParam(param string, paramName string) (int, error) {
 	}
 	return value, nil
 }
+
+// maxLimit is the largest page size a request may ask for.
+const maxLimit = 100
+
+func parseLimitParam(param string) (int, error) {
+	limit, err := parseIntParam(param, "limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit > maxLimit {
+		return 0, fmt.Errorf("invalid limit: value must be at most %d", maxLimit)
+	}
+	return limit, nil
+}
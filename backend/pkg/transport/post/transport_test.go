@@ -2,13 +2,18 @@ package post_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
 	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	postrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post"
+	postsvc "github.com/glowfi/voxpopuli/backend/pkg/service/post"
 	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
 	"github.com/glowfi/voxpopuli/backend/pkg/transport/post/postfakes"
 	"github.com/google/uuid"
@@ -61,7 +66,7 @@ func TestTransport_PostsPaginated(t *testing.T) {
 						Text:        "This is an example post text 4.",
 						TextHtml:    "This is an example post text 4 in HTML.",
 						MediaType:   models.MediaTypeVideo,
-						Medias: []any{
+						Medias: models.Medias{
 							models.Video{
 								ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 								MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
@@ -92,7 +97,7 @@ func TestTransport_PostsPaginated(t *testing.T) {
 						Text:        "This is an example post text 5.",
 						TextHtml:    "This is an example post text 5 in HTML.",
 						MediaType:   models.MediaTypeLink,
-						Medias: []any{
+						Medias: models.Medias{
 							models.Link{
 								ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 								MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000005"),
@@ -129,6 +134,7 @@ func TestTransport_PostsPaginated(t *testing.T) {
                     "media_type": "video",
                     "medias": [
                       {
+                        "media_type": "video",
                         "id": "00000000-0000-0000-0000-000000000001",
                         "media_id": "00000000-0000-0000-0000-000000000004",
                         "url": "https://example.com/video.mp4",
@@ -142,8 +148,14 @@ func TestTransport_PostsPaginated(t *testing.T) {
                     "ups": 40,
                     "num_comments":10,
                     "num_awards":10,
+                    "award_counts": null,
                     "over18": true,
                     "spoiler": false,
+                    "locked": false,
+                    "pinned": false,
+                    "saved": false,
+                    "author_flair": null,
+                    "post_flairs": null,
                     "created_at": "2024-10-10T10:10:40Z",
                     "created_at_unix": 1725091160,
                     "updated_at": "2024-10-10T10:10:40Z"
@@ -160,6 +172,7 @@ func TestTransport_PostsPaginated(t *testing.T) {
                     "media_type": "link",
                     "medias": [
                       {
+                        "media_type": "link",
                         "id": "00000000-0000-0000-0000-000000000001",
                         "media_id": "00000000-0000-0000-0000-000000000005",
                         "link": "https://example.com/video.mp4",
@@ -172,8 +185,14 @@ func TestTransport_PostsPaginated(t *testing.T) {
                     "ups": 50,
                     "num_comments":20,
                     "num_awards":10,
+                    "award_counts": null,
                     "over18": false,
                     "spoiler": true,
+                    "locked": false,
+                    "pinned": false,
+                    "saved": false,
+                    "author_flair": null,
+                    "post_flairs": null,
                     "created_at": "2024-10-10T10:10:50Z",
                     "created_at_unix": 1725091180,
                     "updated_at": "2024-10-10T10:10:50Z"
@@ -220,3 +239,1216 @@ func TestTransport_PostsPaginated(t *testing.T) {
 		})
 	}
 }
+
+func TestTransport_PostsPaginatedSort(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		wantStatusCode int
+		wantSort       models.PostSort
+		wantWindow     models.PostSortWindow
+	}{
+		{
+			name:           "limit above maximum :NEG",
+			url:            "/posts?skip=0&limit=101",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid sort :NEG",
+			url:            "/posts?skip=0&limit=10&sort=best",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid sort window :NEG",
+			url:            "/posts?skip=0&limit=10&sort=top&t=decade",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "default sort :POS",
+			url:            "/posts?skip=0&limit=10",
+			wantStatusCode: http.StatusOK,
+			wantSort:       models.PostSortHot,
+			wantWindow:     models.PostSortWindowAll,
+		},
+		{
+			name:           "new sort :POS",
+			url:            "/posts?skip=0&limit=10&sort=new",
+			wantStatusCode: http.StatusOK,
+			wantSort:       models.PostSortNew,
+			wantWindow:     models.PostSortWindowAll,
+		},
+		{
+			name:           "top sort of all time by default :POS",
+			url:            "/posts?skip=0&limit=10&sort=top",
+			wantStatusCode: http.StatusOK,
+			wantSort:       models.PostSortTop,
+			wantWindow:     models.PostSortWindowAll,
+		},
+		{
+			name:           "rising sort :POS",
+			url:            "/posts?skip=0&limit=10&sort=rising",
+			wantStatusCode: http.StatusOK,
+			wantSort:       models.PostSortRising,
+			wantWindow:     models.PostSortWindowAll,
+		},
+		{
+			name:           "top sort of the year :POS",
+			url:            "/posts?skip=0&limit=10&sort=top&t=year",
+			wantStatusCode: http.StatusOK,
+			wantSort:       models.PostSortTop,
+			wantWindow:     models.PostSortWindowYear,
+		},
+		{
+			name:           "controversial sort of all time :POS",
+			url:            "/posts?skip=0&limit=10&sort=controversial&t=all",
+			wantStatusCode: http.StatusOK,
+			wantSort:       models.PostSortControversial,
+			wantWindow:     models.PostSortWindowAll,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			fakePostService.PostsPaginatedReturns([]models.PostPaginated{}, nil)
+
+			server, err := tr.NewServer(tr.Services{
+				Post: &fakePostService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				_, gotSort, gotWindow, _, _, _ := fakePostService.PostsPaginatedArgsForCall(0)
+				assert.Equal(t, tt.wantSort, gotSort, "expect sort to match")
+				assert.Equal(t, tt.wantWindow, gotWindow, "expect window to match")
+			} else {
+				assert.Equal(t, 0, fakePostService.PostsPaginatedCallCount(), "expect service not to be called")
+			}
+		})
+	}
+}
+
+func TestTransport_PostsAfter(t *testing.T) {
+	cursor := models.PostCursor{
+		Sort:     models.PostSortTop,
+		Window:   models.PostSortWindowAll,
+		Filter:   models.PostFilter{}.Key(),
+		RankedAt: 1725091200,
+		SortKey:  []string{"40", "1725091160"},
+		ID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+	}
+
+	type mockReturns struct {
+		feed      models.PostFeed
+		postError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantAfter      *models.PostCursor
+		wantLimit      int
+		wantResponse   string
+	}{
+		{
+			name:           "no limit :NEG",
+			url:            "/posts?sort=top&t=all",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "zero limit :NEG",
+			url:            "/posts?sort=top&t=all&limit=0",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "limit above maximum :NEG",
+			url:            "/posts?sort=top&t=all&limit=101",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "malformed cursor :NEG",
+			url:            "/posts?sort=top&t=all&limit=2&after=foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "tampered sort key :NEG",
+			url: "/posts?sort=top&t=all&limit=2&after=" + func() string {
+				c := cursor
+				c.SortKey = []string{"1/2", "1725091160"}
+				return c.Encode()
+			}(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "cursor does not match sort :NEG",
+			url:  "/posts?sort=new&limit=2&after=" + cursor.Encode(),
+			mockReturns: mockReturns{
+				postError: models.ErrInvalidPostCursor,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "service error :NEG",
+			url:  "/posts?sort=top&t=all&limit=2",
+			mockReturns: mockReturns{
+				postError: errors.New("db error"),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "first page :POS",
+			url:  "/posts?sort=top&t=all&limit=1",
+			mockReturns: mockReturns{
+				feed: models.PostFeed{
+					Posts: []models.PostPaginated{
+						{
+							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+							Author:        "Jane Doe",
+							AuthorID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							Voxsphere:     "v/bar",
+							VoxsphereID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							Title:         "Example Post Title 5",
+							Text:          "This is an example post text 5.",
+							TextHtml:      "This is an example post text 5 in HTML.",
+							MediaType:     models.MediaTypeText,
+							Ups:           50,
+							CreatedAt:     time.Date(2024, 10, 10, 10, 10, 50, 0, time.UTC),
+							CreatedAtUnix: 1725091180,
+							UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 50, 0, time.UTC),
+						},
+					},
+					NextCursor: ptrof(cursor.Encode()),
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantAfter:      nil,
+			wantLimit:      1,
+			wantResponse: `
+                {
+                  "posts": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000005",
+                      "author":"Jane Doe",
+                      "author_id": "00000000-0000-0000-0000-000000000002",
+                      "voxsphere":"v/bar",
+                      "voxsphere_id": "00000000-0000-0000-0000-000000000002",
+                      "title": "Example Post Title 5",
+                      "text": "This is an example post text 5.",
+                      "text_html": "This is an example post text 5 in HTML.",
+                      "media_type": "text",
+                      "medias": null,
+                      "ups": 50,
+                      "num_comments": 0,
+                      "num_awards": 0,
+                      "award_counts": null,
+                      "over18": false,
+                      "spoiler": false,
+                      "locked": false,
+                      "pinned": false,
+                      "saved": false,
+                      "author_flair": null,
+                      "post_flairs": null,
+                      "created_at": "2024-10-10T10:10:50Z",
+                      "created_at_unix": 1725091180,
+                      "updated_at": "2024-10-10T10:10:50Z"
+                    }
+                  ],
+                  "next_cursor": "` + cursor.Encode() + `"
+                }
+            `,
+		},
+		{
+			name: "last page :POS",
+			url:  "/posts?sort=top&t=all&limit=2&after=" + cursor.Encode(),
+			mockReturns: mockReturns{
+				feed: models.PostFeed{
+					Posts:      []models.PostPaginated{},
+					NextCursor: nil,
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantAfter:      &cursor,
+			wantLimit:      2,
+			wantResponse: `
+                {
+                  "posts": [],
+                  "next_cursor": null
+                }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			fakePostService.PostsAfterReturns(tt.mockReturns.feed, tt.mockReturns.postError)
+
+			server, err := tr.NewServer(tr.Services{
+				Post: &fakePostService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				_, _, _, _, gotAfter, gotLimit := fakePostService.PostsAfterArgsForCall(0)
+				assert.Equal(t, tt.wantAfter, gotAfter, "expect after cursor to match")
+				assert.Equal(t, tt.wantLimit, gotLimit, "expect limit to match")
+				assert.Equal(t, 0, fakePostService.PostsPaginatedCallCount(), "expect offset pagination not to be called")
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
+
+func TestTransport_VoxspherePosts(t *testing.T) {
+	tests := []struct {
+		name               string
+		url                string
+		wantStatusCode     int
+		wantPaginatedCalls int
+		wantAfterCalls     int
+		wantFilter         models.PostFilter
+		serviceErr         error
+	}{
+		{
+			name:           "invalid voxsphere id :NEG",
+			url:            "/voxspheres/foo/posts?limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid sort :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/posts?limit=10&sort=best",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "unknown voxsphere by offset :NEG",
+			url:                "/voxspheres/00000000-0000-0000-0000-000000000009/posts?skip=0&limit=10",
+			wantStatusCode:     http.StatusNotFound,
+			wantPaginatedCalls: 1,
+			wantFilter:         models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009")},
+			serviceErr:         postrepo.ErrPostVoxsphereNotFound,
+		},
+		{
+			name:           "unknown voxsphere by cursor :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000009/posts?limit=10",
+			wantStatusCode: http.StatusNotFound,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000009")},
+			serviceErr:     postrepo.ErrPostVoxsphereNotFound,
+		},
+		{
+			name:               "voxsphere posts by offset :POS",
+			url:                "/voxspheres/00000000-0000-0000-0000-000000000001/posts?skip=0&limit=10&sort=new",
+			wantStatusCode:     http.StatusOK,
+			wantPaginatedCalls: 1,
+			wantFilter:         models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		},
+		{
+			name:           "voxsphere posts by cursor :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000002/posts?limit=10&sort=top&t=all",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			fakePostService.PostsPaginatedReturns([]models.PostPaginated{}, tt.serviceErr)
+			fakePostService.PostsAfterReturns(models.PostFeed{Posts: []models.PostPaginated{}}, tt.serviceErr)
+
+			server, err := tr.NewServer(tr.Services{
+				Post: &fakePostService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+			assert.Equal(t, tt.wantPaginatedCalls, fakePostService.PostsPaginatedCallCount(), "expect offset pagination calls to match")
+			assert.Equal(t, tt.wantAfterCalls, fakePostService.PostsAfterCallCount(), "expect cursor pagination calls to match")
+
+			if tt.wantPaginatedCalls == 1 {
+				_, _, _, gotFilter, _, _ := fakePostService.PostsPaginatedArgsForCall(0)
+				assert.Equal(t, tt.wantFilter, gotFilter, "expect filter to match")
+			}
+			if tt.wantAfterCalls == 1 {
+				_, _, _, gotFilter, _, _ := fakePostService.PostsAfterArgsForCall(0)
+				assert.Equal(t, tt.wantFilter, gotFilter, "expect filter to match")
+			}
+		})
+	}
+}
+
+func TestTransport_PostsFilter(t *testing.T) {
+	tests := []struct {
+		name               string
+		url                string
+		wantStatusCode     int
+		wantPaginatedCalls int
+		wantAfterCalls     int
+		wantFilter         models.PostFilter
+	}{
+		{
+			name:           "invalid media type :NEG",
+			url:            "/posts?limit=10&media_type=image,poll",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid nsfw :NEG",
+			url:            "/posts?limit=10&nsfw=sometimes",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid spoiler :NEG",
+			url:            "/posts?limit=10&spoiler=only",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid flair :NEG",
+			url:            "/posts?limit=10&flair=foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid voxsphere :NEG",
+			url:            "/posts?limit=10&voxsphere=00000000-0000-0000-0000-000000000001,foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "sql in media type :NEG",
+			url:            "/posts?limit=10&media_type=image')%20OR%20TRUE%20--",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "no filter :POS",
+			url:            "/posts?limit=10",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{},
+		},
+		{
+			name:           "media types :POS",
+			url:            "/posts?limit=10&media_type=image,gallery",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{MediaTypes: []models.MediaType{models.MediaTypeImage, models.MediaTypeGallery}},
+		},
+		{
+			name:           "nsfw include :POS",
+			url:            "/posts?limit=10&nsfw=include",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{NSFW: models.PostNSFWInclude},
+		},
+		{
+			name:           "nsfw exclude :POS",
+			url:            "/posts?limit=10&nsfw=exclude",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{NSFW: models.PostNSFWExclude},
+		},
+		{
+			name:           "nsfw only :POS",
+			url:            "/posts?limit=10&nsfw=only",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{NSFW: models.PostNSFWOnly},
+		},
+		{
+			name:           "spoiler exclude :POS",
+			url:            "/posts?limit=10&spoiler=exclude",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{ExcludeSpoilers: true},
+		},
+		{
+			name:           "flair :POS",
+			url:            "/posts?limit=10&flair=00000000-0000-0000-0000-000000000001",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{FlairID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		},
+		{
+			name:           "voxspheres :POS",
+			url:            "/posts?limit=10&voxsphere=00000000-0000-0000-0000-000000000001,00000000-0000-0000-0000-000000000002",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{VoxsphereIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001"), uuid.MustParse("00000000-0000-0000-0000-000000000002")}},
+		},
+		{
+			name:               "combined filters by offset :POS",
+			url:                "/posts?skip=0&limit=10&media_type=video&nsfw=exclude&spoiler=exclude&voxsphere=00000000-0000-0000-0000-000000000002",
+			wantStatusCode:     http.StatusOK,
+			wantPaginatedCalls: 1,
+			wantFilter: models.PostFilter{
+				VoxsphereIDs:    []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+				MediaTypes:      []models.MediaType{models.MediaTypeVideo},
+				NSFW:            models.PostNSFWExclude,
+				ExcludeSpoilers: true,
+			},
+		},
+		{
+			name:           "filters within a voxsphere :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/posts?limit=10&nsfw=only",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), NSFW: models.PostNSFWOnly},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			fakePostService.PostsPaginatedReturns([]models.PostPaginated{}, nil)
+			fakePostService.PostsAfterReturns(models.PostFeed{Posts: []models.PostPaginated{}}, nil)
+
+			server, err := tr.NewServer(tr.Services{
+				Post: &fakePostService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+			assert.Equal(t, tt.wantPaginatedCalls, fakePostService.PostsPaginatedCallCount(), "expect offset pagination calls to match")
+			assert.Equal(t, tt.wantAfterCalls, fakePostService.PostsAfterCallCount(), "expect cursor pagination calls to match")
+
+			if tt.wantPaginatedCalls == 1 {
+				_, _, _, gotFilter, _, _ := fakePostService.PostsPaginatedArgsForCall(0)
+				assert.Equal(t, tt.wantFilter, gotFilter, "expect filter to match")
+			}
+			if tt.wantAfterCalls == 1 {
+				_, _, _, gotFilter, _, _ := fakePostService.PostsAfterArgsForCall(0)
+				assert.Equal(t, tt.wantFilter, gotFilter, "expect filter to match")
+			}
+		})
+	}
+}
+
+func TestTransport_UserPosts(t *testing.T) {
+	tests := []struct {
+		name               string
+		url                string
+		wantStatusCode     int
+		wantPaginatedCalls int
+		wantAfterCalls     int
+		wantFilter         models.PostFilter
+		serviceErr         error
+	}{
+		{
+			name:           "invalid limit :NEG",
+			url:            "/users/John%20Doe/posts?limit=foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "unknown user by offset :NEG",
+			url:                "/users/Nobody/posts?skip=0&limit=10",
+			wantStatusCode:     http.StatusNotFound,
+			wantPaginatedCalls: 1,
+			wantFilter:         models.PostFilter{AuthorName: "Nobody"},
+			serviceErr:         postrepo.ErrPostAuthorNotFound,
+		},
+		{
+			name:           "unknown user by cursor :NEG",
+			url:            "/users/Nobody/posts?limit=10",
+			wantStatusCode: http.StatusNotFound,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{AuthorName: "Nobody"},
+			serviceErr:     postrepo.ErrPostAuthorNotFound,
+		},
+		{
+			name:               "user posts by offset :POS",
+			url:                "/users/John%20Doe/posts?skip=0&limit=10&sort=new",
+			wantStatusCode:     http.StatusOK,
+			wantPaginatedCalls: 1,
+			wantFilter:         models.PostFilter{AuthorName: "John Doe"},
+		},
+		{
+			name:           "user posts by cursor :POS",
+			url:            "/users/Jane%20Doe/posts?limit=10&sort=top&t=all",
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{AuthorName: "Jane Doe"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			fakePostService.PostsPaginatedReturns([]models.PostPaginated{}, tt.serviceErr)
+			fakePostService.PostsAfterReturns(models.PostFeed{Posts: []models.PostPaginated{}}, tt.serviceErr)
+
+			server, err := tr.NewServer(tr.Services{
+				Post: &fakePostService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+			assert.Equal(t, tt.wantPaginatedCalls, fakePostService.PostsPaginatedCallCount(), "expect offset pagination calls to match")
+			assert.Equal(t, tt.wantAfterCalls, fakePostService.PostsAfterCallCount(), "expect cursor pagination calls to match")
+
+			if tt.wantPaginatedCalls == 1 {
+				_, _, _, gotFilter, _, _ := fakePostService.PostsPaginatedArgsForCall(0)
+				assert.Equal(t, tt.wantFilter, gotFilter, "expect filter to match")
+			}
+			if tt.wantAfterCalls == 1 {
+				_, _, _, gotFilter, _, _ := fakePostService.PostsAfterArgsForCall(0)
+				assert.Equal(t, tt.wantFilter, gotFilter, "expect filter to match")
+			}
+		})
+	}
+}
+
+func TestTransport_HomeFeed(t *testing.T) {
+	tests := []struct {
+		name               string
+		url                string
+		user               *models.User
+		wantStatusCode     int
+		wantPaginatedCalls int
+		wantAfterCalls     int
+		wantFilter         models.PostFilter
+	}{
+		{
+			name:           "invalid sort :NEG",
+			url:            "/feed/home?sort=foo",
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "anonymous home feed :POS",
+			url:                "/feed/home?skip=0&limit=10",
+			user:               nil,
+			wantStatusCode:     http.StatusOK,
+			wantPaginatedCalls: 1,
+			wantFilter:         models.PostFilter{},
+		},
+		{
+			name:               "home feed by offset :POS",
+			url:                "/feed/home?skip=0&limit=10&sort=new",
+			user:               &author,
+			wantStatusCode:     http.StatusOK,
+			wantPaginatedCalls: 1,
+			wantFilter:         models.PostFilter{MemberID: author.ID, ViewerID: author.ID},
+		},
+		{
+			name:           "home feed by cursor :POS",
+			url:            "/feed/home?limit=10&sort=top&t=all",
+			user:           &author,
+			wantStatusCode: http.StatusOK,
+			wantAfterCalls: 1,
+			wantFilter:     models.PostFilter{MemberID: author.ID, ViewerID: author.ID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			fakePostService.PostsPaginatedReturns([]models.PostPaginated{}, nil)
+			fakePostService.PostsAfterReturns(models.PostFeed{Posts: []models.PostPaginated{}}, nil)
+
+			recorder := serveAs(t, &fakePostService, "GET", tt.url, "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantPaginatedCalls, fakePostService.PostsPaginatedCallCount(), "expect offset pagination calls to match")
+			assert.Equal(t, tt.wantAfterCalls, fakePostService.PostsAfterCallCount(), "expect cursor pagination calls to match")
+
+			if tt.wantPaginatedCalls == 1 {
+				_, _, _, gotFilter, _, _ := fakePostService.PostsPaginatedArgsForCall(0)
+				assert.Equal(t, tt.wantFilter, gotFilter, "expect filter to match")
+			}
+			if tt.wantAfterCalls == 1 {
+				_, _, _, gotFilter, _, _ := fakePostService.PostsAfterArgsForCall(0)
+				assert.Equal(t, tt.wantFilter, gotFilter, "expect filter to match")
+			}
+		})
+	}
+}
+
+func TestTransport_PostByID(t *testing.T) {
+	type mockReturns struct {
+		post      models.PostDetail
+		postError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "invalid post id :NEG",
+			url:            "/posts/foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "post not found :NEG",
+			url:  "/posts/00000000-0000-0000-0000-000000000009",
+			mockReturns: mockReturns{
+				post:      models.PostDetail{},
+				postError: postrepo.ErrPostNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "service error :NEG",
+			url:  "/posts/00000000-0000-0000-0000-000000000001",
+			mockReturns: mockReturns{
+				post:      models.PostDetail{},
+				postError: errors.New("db down"),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "post found :POS",
+			url:  "/posts/00000000-0000-0000-0000-000000000001",
+			mockReturns: mockReturns{
+				post: models.PostDetail{
+					PostPaginated: models.PostPaginated{
+						ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Author:      "John Doe",
+						AuthorID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Voxsphere:   "v/foo",
+						VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Title:       "Example Post Title 1",
+						Text:        "This is an example post text 1.",
+						TextHtml:    "This is an example post text 1 in HTML.",
+						MediaType:   models.MediaTypeVideo,
+						Medias: models.Medias{
+							models.Video{
+								ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								MediaID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								Url:           "https://example.com/video.mp4",
+								Height:        1080,
+								Width:         1920,
+								CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+								CreatedAtUnix: 1725091100,
+								UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+							},
+						},
+						Ups:           10,
+						NumComments:   2,
+						NumAwards:     1,
+						Over18:        false,
+						Spoiler:       false,
+						CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix: 1725091100,
+						UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						PostFlairs: []models.PostFlairRendered{
+							{
+								ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+								FullText:        "desc1 :ce1:",
+								BackgroundColor: "#FFFFFF",
+								Richtext: []models.FlairRichtext{
+									{
+										Type:       models.FlairRichtextTypeText,
+										OrderIndex: 0,
+										Text:       "desc1 ",
+									},
+									{
+										Type:       models.FlairRichtextTypeEmoji,
+										OrderIndex: 1,
+										Text:       "😀",
+									},
+								},
+							},
+						},
+					},
+					Awards: []models.Award{
+						{
+							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Title:     "award_foo",
+							ImageLink: "https://example.com/award_foo.png",
+						},
+					},
+				},
+				postError: nil,
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+                {
+                  "id": "00000000-0000-0000-0000-000000000001",
+                  "author": "John Doe",
+                  "author_id": "00000000-0000-0000-0000-000000000001",
+                  "voxsphere": "v/foo",
+                  "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+                  "title": "Example Post Title 1",
+                  "text": "This is an example post text 1.",
+                  "text_html": "This is an example post text 1 in HTML.",
+                  "media_type": "video",
+                  "medias": [
+                    {
+                      "media_type": "video",
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "media_id": "00000000-0000-0000-0000-000000000001",
+                      "url": "https://example.com/video.mp4",
+                      "height": 1080,
+                      "width": 1920,
+                      "created_at": "2024-10-10T10:10:10Z",
+                      "created_at_unix": 1725091100,
+                      "updated_at": "2024-10-10T10:10:10Z"
+                    }
+                  ],
+                  "ups": 10,
+                  "num_comments": 2,
+                  "num_awards": 1,
+                  "award_counts": null,
+                  "over18": false,
+                  "spoiler": false,
+                  "locked": false,
+                  "pinned": false,
+                  "saved": false,
+                  "author_flair": null,
+                  "created_at": "2024-10-10T10:10:10Z",
+                  "created_at_unix": 1725091100,
+                  "updated_at": "2024-10-10T10:10:10Z",
+                  "post_flairs": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+                      "full_text": "desc1 :ce1:",
+                      "background_color": "#FFFFFF",
+                      "richtext": [
+                        {
+                          "type": "text",
+                          "order_index": 0,
+                          "text": "desc1 ",
+                          "url": null
+                        },
+                        {
+                          "type": "emoji",
+                          "order_index": 1,
+                          "text": "😀",
+                          "url": null
+                        }
+                      ]
+                    }
+                  ],
+                  "awards": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "title": "award_foo",
+                      "image_link": "https://example.com/award_foo.png",
+                      "price": 0
+                    }
+                  ]
+                }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			fakePostService.PostDetailByIDReturns(tt.mockReturns.post, tt.mockReturns.postError)
+
+			server, err := tr.NewServer(tr.Services{
+				Post: &fakePostService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+var author = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+var createdPost = models.Post{
+	ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	AuthorID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	VoxsphereID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Title:         "Example Post Title 1",
+	Text:          "This is an example post text 1.",
+	TextHtml:      "<p>This is an example post text 1.</p>",
+	CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+	CreatedAtUnix: 1728555010,
+	UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+}
+
+const createdPostResponse = `
+    {
+      "id": "00000000-0000-0000-0000-000000000001",
+      "author_id": "00000000-0000-0000-0000-000000000001",
+      "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+      "title": "Example Post Title 1",
+      "text": "This is an example post text 1.",
+      "text_html": "<p>This is an example post text 1.</p>",
+      "ups": 0,
+      "over18": false,
+      "spoiler": false,
+      "created_at": "2024-10-10T10:10:10Z",
+      "created_at_unix": 1728555010,
+      "updated_at": "2024-10-10T10:10:10Z"
+    }
+    `
+
+// serveAs sends a request of method to url with body through a server backed
+// by fakePostService, as user when one is given.
+func serveAs(t *testing.T, fakePostService *postfakes.FakePostService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Post: fakePostService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_CreatePost(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			body:           `{"voxsphere_id": "00000000-0000-0000-0000-000000000001", "title": "Example Post Title 1"}`,
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "malformed body :NEG",
+			body:           `{"title":`,
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid title :NEG",
+			body:           `{"voxsphere_id": "00000000-0000-0000-0000-000000000001", "title": ""}`,
+			user:           &author,
+			serviceErr:     postsvc.ErrPostInvalidTitle,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "not a member :NEG",
+			body:           `{"voxsphere_id": "00000000-0000-0000-0000-000000000001", "title": "Example Post Title 1"}`,
+			user:           &author,
+			serviceErr:     postsvc.ErrPostNotMember,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "banned member :NEG",
+			body:           `{"voxsphere_id": "00000000-0000-0000-0000-000000000001", "title": "Example Post Title 1"}`,
+			user:           &author,
+			serviceErr:     postsvc.ErrPostBanned,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "duplicate post id :NEG",
+			body:           `{"voxsphere_id": "00000000-0000-0000-0000-000000000001", "title": "Example Post Title 1"}`,
+			user:           &author,
+			serviceErr:     postrepo.ErrPostDuplicateID,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "voxsphere does not exist :NEG",
+			body:           `{"voxsphere_id": "00000000-0000-0000-0000-000000000009", "title": "Example Post Title 1"}`,
+			user:           &author,
+			serviceErr:     postrepo.ErrPostParentTableRecordNotFound,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "text post :POS",
+			body:           `{"voxsphere_id": "00000000-0000-0000-0000-000000000001", "title": "Example Post Title 1", "text": "This is an example post text 1."}`,
+			user:           &author,
+			wantStatusCode: http.StatusCreated,
+			wantResponse:   createdPostResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			if tt.serviceErr != nil {
+				fakePostService.CreatePostReturns(models.Post{}, tt.serviceErr)
+			} else {
+				fakePostService.CreatePostReturns(createdPost, nil)
+			}
+
+			recorder := serveAs(t, &fakePostService, "POST", "/posts", tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusCreated {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+
+				_, gotAuthorID, gotSubmission := fakePostService.CreatePostArgsForCall(0)
+				assert.Equal(t, author.ID, gotAuthorID, "expect post to be authored by the authenticated user")
+				assert.Equal(t, models.PostSubmission{
+					VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Title:       "Example Post Title 1",
+					Text:        "This is an example post text 1.",
+				}, gotSubmission, "expect submission to match")
+			}
+		})
+	}
+}
+
+func TestTransport_EditPost(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001",
+			body:           `{"spoiler": true}`,
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid post id :NEG",
+			url:            "/posts/foo",
+			body:           `{"spoiler": true}`,
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "not the author :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001",
+			body:           `{"spoiler": true}`,
+			user:           &author,
+			serviceErr:     postsvc.ErrPostNotAuthor,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "post not found :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000009",
+			body:           `{"spoiler": true}`,
+			user:           &author,
+			serviceErr:     postrepo.ErrPostNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "edit text :POS",
+			url:            "/posts/00000000-0000-0000-0000-000000000001",
+			body:           `{"text": "This is an example post text 1."}`,
+			user:           &author,
+			wantStatusCode: http.StatusOK,
+			wantResponse:   createdPostResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			if tt.serviceErr != nil {
+				fakePostService.EditPostReturns(models.Post{}, tt.serviceErr)
+			} else {
+				fakePostService.EditPostReturns(createdPost, nil)
+			}
+
+			recorder := serveAs(t, &fakePostService, "PATCH", tt.url, tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+
+				_, gotID, gotUserID, gotEdit := fakePostService.EditPostArgsForCall(0)
+				assert.Equal(t, createdPost.ID, gotID, "expect post id to match")
+				assert.Equal(t, author.ID, gotUserID, "expect post to be edited by the authenticated user")
+				assert.Equal(t, models.PostEdit{Text: ptrof("This is an example post text 1.")}, gotEdit, "expect edit to match")
+			}
+		})
+	}
+}
+
+func TestTransport_DeletePost(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "not the author :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001",
+			user:           &author,
+			serviceErr:     postsvc.ErrPostNotAuthor,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "internal server error :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001",
+			user:           &author,
+			serviceErr:     errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:           "author deletes post :POS",
+			url:            "/posts/00000000-0000-0000-0000-000000000001",
+			user:           &author,
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostService := postfakes.FakePostService{}
+			fakePostService.DeletePostReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakePostService, "DELETE", tt.url, "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package postflair
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	postrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post"
+	postflairrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post_flair"
+	postflairsvc "github.com/glowfi/voxpopuli/backend/pkg/service/post_flair"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . PostFlairService
+type PostFlairService interface {
+	VoxspherePostFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.PostFlairRendered, error)
+	SetPostFlair(ctx context.Context, postID, userID, postFlairID uuid.UUID) error
+	ClearPostFlair(ctx context.Context, postID, userID uuid.UUID) error
+}
+
+type Transport struct {
+	service PostFlairService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service PostFlairService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) VoxspherePostFlairs(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	flairs, err := t.service.VoxspherePostFlairs(r.Context(), voxsphereID)
+	if err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch post flairs")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(flairs); err != nil {
+		log.Println("json encode error while fetching post flairs:", err)
+	}
+}
+
+func (t *Transport) SetPostFlair(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	postID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	var submission models.PostFlairSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil || submission.PostFlairID == uuid.Nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post flair id")
+		return
+	}
+
+	if err := t.service.SetPostFlair(r.Context(), postID, user.ID, submission.PostFlairID); err != nil {
+		writePostFlairError(w, err, "failed to set post flair")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (t *Transport) ClearPostFlair(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	postID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	if err := t.service.ClearPostFlair(r.Context(), postID, user.ID); err != nil {
+		writePostFlairError(w, err, "failed to clear post flair")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writePostFlairError answers a failed post flair change, falling back to an
+// internal server error with fallbackMsg.
+func writePostFlairError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, postflairsvc.ErrPostFlairNotAllowed):
+		writeResponseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, postrepo.ErrPostNotFound),
+		errors.Is(err, postflairrepo.ErrPostFlairPostNotFound),
+		errors.Is(err, postflairrepo.ErrPostFlairNotFound):
+		writeResponseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, postflairrepo.ErrPostFlairNotInVoxsphere):
+		writeResponseError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
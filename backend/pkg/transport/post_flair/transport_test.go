@@ -0,0 +1,245 @@
+package postflair_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	postrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post"
+	postflairrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/post_flair"
+	postflairsvc "github.com/glowfi/voxpopuli/backend/pkg/service/post_flair"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/post_flair/post_flairfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var author = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+// serveAs sends a request of method to url with body through a server backed
+// by fakePostFlairService, as user when one is given.
+func serveAs(t *testing.T, fakePostFlairService *post_flairfakes.FakePostFlairService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		PostFlair: fakePostFlairService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_VoxspherePostFlairs(t *testing.T) {
+	emoji := "https://example.com/ce1.png"
+
+	tests := []struct {
+		name           string
+		url            string
+		flairs         []models.PostFlairRendered
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "invalid voxsphere id :NEG",
+			url:            "/voxspheres/foo/flairs",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "no post flairs :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/flairs",
+			flairs:         []models.PostFlairRendered{},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   `[]`,
+		},
+		{
+			name: "post flairs :POS",
+			url:  "/voxspheres/00000000-0000-0000-0000-000000000001/flairs",
+			flairs: []models.PostFlairRendered{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					FullText:        "desc1 :1f600::ce1:",
+					BackgroundColor: "#FFFFFF",
+					Richtext: []models.FlairRichtext{
+						{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc1 "},
+						{Type: models.FlairRichtextTypeEmoji, OrderIndex: 1, Text: "😀"},
+						{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 2, Text: ":ce1:", Url: &emoji},
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+            [
+              {
+                "id": "00000000-0000-0000-0000-000000000001",
+                "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+                "full_text": "desc1 :1f600::ce1:",
+                "background_color": "#FFFFFF",
+                "richtext": [
+                  {"type": "text", "order_index": 0, "text": "desc1 ", "url": null},
+                  {"type": "emoji", "order_index": 1, "text": "😀", "url": null},
+                  {"type": "custom_emoji", "order_index": 2, "text": ":ce1:", "url": "https://example.com/ce1.png"}
+                ]
+              }
+            ]
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostFlairService := post_flairfakes.FakePostFlairService{}
+			fakePostFlairService.VoxspherePostFlairsReturns(tt.flairs, nil)
+
+			recorder := serveAs(t, &fakePostFlairService, "GET", tt.url, "", nil)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if len(tt.wantResponse) != 0 {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransport_SetPostFlair(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantSetCalls   int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/flair",
+			body:           `{"post_flair_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid post id :NEG",
+			url:            "/posts/foo/flair",
+			body:           `{"post_flair_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "missing post flair id :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/flair",
+			body:           `{}`,
+			user:           &author,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "post not found :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000009/flair",
+			body:           `{"post_flair_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &author,
+			serviceErr:     postrepo.ErrPostNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantSetCalls:   1,
+		},
+		{
+			name:           "not allowed :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/flair",
+			body:           `{"post_flair_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &author,
+			serviceErr:     postflairsvc.ErrPostFlairNotAllowed,
+			wantStatusCode: http.StatusForbidden,
+			wantSetCalls:   1,
+		},
+		{
+			name:           "flair of another voxsphere :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/flair",
+			body:           `{"post_flair_id": "00000000-0000-0000-0000-000000000002"}`,
+			user:           &author,
+			serviceErr:     postflairrepo.ErrPostFlairNotInVoxsphere,
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantSetCalls:   1,
+		},
+		{
+			name:           "set flair :POS",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/flair",
+			body:           `{"post_flair_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &author,
+			wantStatusCode: http.StatusNoContent,
+			wantSetCalls:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostFlairService := post_flairfakes.FakePostFlairService{}
+			fakePostFlairService.SetPostFlairReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakePostFlairService, "PUT", tt.url, tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantSetCalls, fakePostFlairService.SetPostFlairCallCount(), "expect set call count to match")
+		})
+	}
+}
+
+func TestTransport_ClearPostFlair(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/flair",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "not allowed :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/flair",
+			user:           &author,
+			serviceErr:     postflairsvc.ErrPostFlairNotAllowed,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "clear flair :POS",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/flair",
+			user:           &author,
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakePostFlairService := post_flairfakes.FakePostFlairService{}
+			fakePostFlairService.ClearPostFlairReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakePostFlairService, "DELETE", tt.url, "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+		})
+	}
+}
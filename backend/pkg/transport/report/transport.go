@@ -0,0 +1,191 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	reportrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/report"
+	reportsvc "github.com/glowfi/voxpopuli/backend/pkg/service/report"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . ReportService
+type ReportService interface {
+	ReportPost(ctx context.Context, postID, reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error)
+	ReportComment(ctx context.Context, commentID, reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error)
+	ReportQueue(ctx context.Context, voxsphereID, moderatorID uuid.UUID, skip, limit int) ([]models.ReportQueueItem, error)
+}
+
+type Transport struct {
+	service ReportService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service ReportService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) ReportPost(w http.ResponseWriter, r *http.Request) {
+	t.report(w, r, "post", ReportService.ReportPost)
+}
+
+func (t *Transport) ReportComment(w http.ResponseWriter, r *http.Request) {
+	t.report(w, r, "comment", ReportService.ReportComment)
+}
+
+func (t *Transport) report(w http.ResponseWriter, r *http.Request, target string, file func(service ReportService, ctx context.Context, ID, reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error)) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, fmt.Sprintf("add a valid %s id", target))
+		return
+	}
+
+	var submission models.ReportSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid report")
+		return
+	}
+
+	report, err := file(t.service, r.Context(), ID, user.ID, submission)
+	if err != nil {
+		writeReportError(w, err, fmt.Sprintf("failed to report %s", target))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Printf("json encode error while reporting %s: %v", target, err)
+	}
+}
+
+func (t *Transport) ReportQueue(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	skipStr := r.URL.Query().Get("skip")
+	if len(skipStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid skip")
+		return
+	}
+	skip, err := parseIntParam(skipStr, "skip")
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if len(limitStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid limit")
+		return
+	}
+	limit, err := parseLimitParam(limitStr)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	queue, err := t.service.ReportQueue(r.Context(), voxsphereID, user.ID, skip, limit)
+	if err != nil {
+		writeReportError(w, err, "failed to fetch report queue")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(queue); err != nil {
+		log.Println("json encode error while fetching report queue:", err)
+	}
+}
+
+// writeReportError answers a failed report request, falling back to an
+// internal server error with fallbackMsg.
+func writeReportError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, reportsvc.ErrReportInvalid):
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, reportsvc.ErrReportNotModerator):
+		writeResponseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, reportrepo.ErrReportPostNotFound),
+		errors.Is(err, reportrepo.ErrReportCommentNotFound):
+		writeResponseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, reportrepo.ErrReportDuplicate):
+		writeResponseError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, reportrepo.ErrReportRuleNotInVoxsphere),
+		errors.Is(err, reportrepo.ErrReportReporterNotFound):
+		writeResponseError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
+
+func parseIntParam(param string, paramName string) (int, error) {
+	value, err := strconv.Atoi(param)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", paramName, err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("invalid %s: value must be non-negative", paramName)
+	}
+	return value, nil
+}
+
+// maxLimit is the largest page size a request may ask for.
+const maxLimit = 100
+
+func parseLimitParam(param string) (int, error) {
+	limit, err := parseIntParam(param, "limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit > maxLimit {
+		return 0, fmt.Errorf("invalid limit: value must be at most %d", maxLimit)
+	}
+	return limit, nil
+}
@@ -0,0 +1,313 @@
+package report_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	reportrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/report"
+	reportsvc "github.com/glowfi/voxpopuli/backend/pkg/service/report"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/report/reportfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var reporter = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+	Name: "Jane Doe",
+}
+
+// serveAs sends a request of method to url with body through a server backed
+// by fakeReportService, as user when one is given.
+func serveAs(t *testing.T, fakeReportService *reportfakes.FakeReportService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Report: fakeReportService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_ReportPost(t *testing.T) {
+	tests := []struct {
+		name            string
+		url             string
+		body            string
+		user            *models.User
+		serviceErr      error
+		wantStatusCode  int
+		wantReportCalls int
+		wantResponse    string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/report",
+			body:           `{"reason": "spam"}`,
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid post id :NEG",
+			url:            "/posts/foo/report",
+			body:           `{"reason": "spam"}`,
+			user:           &reporter,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid body :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/report",
+			body:           `{"rule_id": "foo"}`,
+			user:           &reporter,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:            "invalid report :NEG",
+			url:             "/posts/00000000-0000-0000-0000-000000000001/report",
+			body:            `{}`,
+			user:            &reporter,
+			serviceErr:      reportsvc.ErrReportInvalid,
+			wantStatusCode:  http.StatusBadRequest,
+			wantReportCalls: 1,
+		},
+		{
+			name:            "post not found :NEG",
+			url:             "/posts/00000000-0000-0000-0000-000000000009/report",
+			body:            `{"reason": "spam"}`,
+			user:            &reporter,
+			serviceErr:      reportrepo.ErrReportPostNotFound,
+			wantStatusCode:  http.StatusNotFound,
+			wantReportCalls: 1,
+		},
+		{
+			name:            "already reported :NEG",
+			url:             "/posts/00000000-0000-0000-0000-000000000001/report",
+			body:            `{"reason": "spam"}`,
+			user:            &reporter,
+			serviceErr:      reportrepo.ErrReportDuplicate,
+			wantStatusCode:  http.StatusConflict,
+			wantReportCalls: 1,
+		},
+		{
+			name:            "rule of another voxsphere :NEG",
+			url:             "/posts/00000000-0000-0000-0000-000000000001/report",
+			body:            `{"rule_id": "00000000-0000-0000-0000-000000000002"}`,
+			user:            &reporter,
+			serviceErr:      reportrepo.ErrReportRuleNotInVoxsphere,
+			wantStatusCode:  http.StatusUnprocessableEntity,
+			wantReportCalls: 1,
+		},
+		{
+			name:            "internal server error :NEG",
+			url:             "/posts/00000000-0000-0000-0000-000000000001/report",
+			body:            `{"reason": "spam"}`,
+			user:            &reporter,
+			serviceErr:      errors.New("some error"),
+			wantStatusCode:  http.StatusInternalServerError,
+			wantReportCalls: 1,
+		},
+		{
+			name:            "report post :POS",
+			url:             "/posts/00000000-0000-0000-0000-000000000001/report",
+			body:            `{"rule_id": "00000000-0000-0000-0000-000000000001", "reason": "spam"}`,
+			user:            &reporter,
+			wantStatusCode:  http.StatusCreated,
+			wantReportCalls: 1,
+			wantResponse: `
+            {
+              "id": "00000000-0000-0000-0000-000000000001",
+              "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+              "reporter_id": "00000000-0000-0000-0000-000000000002",
+              "post_id": "00000000-0000-0000-0000-000000000001",
+              "comment_id": "00000000-0000-0000-0000-000000000000",
+              "rule_id": "00000000-0000-0000-0000-000000000001",
+              "reason": "spam",
+              "resolved_at": null,
+              "mod_log_id": "00000000-0000-0000-0000-000000000000",
+              "created_at": "2024-10-10T10:10:10Z",
+              "created_at_unix": 1725091100
+            }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeReportService := reportfakes.FakeReportService{}
+			fakeReportService.ReportPostStub = func(_ context.Context, postID, reporterID uuid.UUID, submission models.ReportSubmission) (models.Report, error) {
+				if tt.serviceErr != nil {
+					return models.Report{}, tt.serviceErr
+				}
+				return models.Report{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ReporterID:    reporterID,
+					PostID:        postID,
+					RuleID:        submission.RuleID,
+					Reason:        submission.Reason,
+					CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					CreatedAtUnix: 1725091100,
+				}, nil
+			}
+
+			recorder := serveAs(t, &fakeReportService, "POST", tt.url, tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantReportCalls, fakeReportService.ReportPostCallCount(), "expect report call count to match")
+			if tt.wantReportCalls != 0 {
+				_, _, gotReporterID, _ := fakeReportService.ReportPostArgsForCall(0)
+				assert.Equal(t, reporter.ID, gotReporterID, "expect the authenticated user to report")
+			}
+			if len(tt.wantResponse) != 0 {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransport_ReportComment(t *testing.T) {
+	tests := []struct {
+		name            string
+		url             string
+		serviceErr      error
+		wantStatusCode  int
+		wantReportCalls int
+	}{
+		{
+			name:           "invalid comment id :NEG",
+			url:            "/comments/foo/report",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:            "comment not found :NEG",
+			url:             "/comments/00000000-0000-0000-0000-000000000009/report",
+			serviceErr:      reportrepo.ErrReportCommentNotFound,
+			wantStatusCode:  http.StatusNotFound,
+			wantReportCalls: 1,
+		},
+		{
+			name:            "report comment :POS",
+			url:             "/comments/00000000-0000-0000-0000-000000000001/report",
+			wantStatusCode:  http.StatusCreated,
+			wantReportCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeReportService := reportfakes.FakeReportService{}
+			fakeReportService.ReportCommentReturns(models.Report{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeReportService, "POST", tt.url, `{"reason": "rude"}`, &reporter)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantReportCalls, fakeReportService.ReportCommentCallCount(), "expect report call count to match")
+		})
+	}
+}
+
+func TestTransport_ReportQueue(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		serviceErr     error
+		wantStatusCode int
+		wantQueueCalls int
+	}{
+		{
+			name:           "invalid voxsphere id :NEG",
+			url:            "/voxspheres/foo/reports?skip=0&limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "missing limit :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/reports?skip=0",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "limit above maximum :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/reports?skip=0&limit=101",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "not a moderator :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/reports?skip=0&limit=10",
+			serviceErr:     reportsvc.ErrReportNotModerator,
+			wantStatusCode: http.StatusForbidden,
+			wantQueueCalls: 1,
+		},
+		{
+			name:           "report queue :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/reports?skip=0&limit=10",
+			wantStatusCode: http.StatusOK,
+			wantQueueCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeReportService := reportfakes.FakeReportService{}
+			fakeReportService.ReportQueueReturns([]models.ReportQueueItem{
+				{
+					TargetType:  models.ReportTargetPost,
+					TargetID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ReportCount: 2,
+					Reasons: []models.ReportReason{
+						{Reason: "spam", Count: 2},
+					},
+					FirstReportedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					LastReportedAt:  time.Date(2024, 10, 10, 10, 10, 20, 0, time.UTC),
+				},
+			}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeReportService, "GET", tt.url, "", &reporter)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantQueueCalls, fakeReportService.ReportQueueCallCount(), "expect queue call count to match")
+
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, `
+                [
+                  {
+                    "target_type": "post",
+                    "target_id": "00000000-0000-0000-0000-000000000001",
+                    "report_count": 2,
+                    "reasons": [
+                      {
+                        "rule_id": "00000000-0000-0000-0000-000000000000",
+                        "rule": "",
+                        "reason": "spam",
+                        "count": 2
+                      }
+                    ],
+                    "first_reported_at": "2024-10-10T10:10:10Z",
+                    "last_reported_at": "2024-10-10T10:10:20Z"
+                  }
+                ]
+                `, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package saved
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	savedrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/saved"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . SavedService
+type SavedService interface {
+	SavePost(ctx context.Context, userID, postID uuid.UUID) error
+	UnsavePost(ctx context.Context, userID, postID uuid.UUID) error
+	SaveComment(ctx context.Context, userID, commentID uuid.UUID) error
+	UnsaveComment(ctx context.Context, userID, commentID uuid.UUID) error
+	HidePost(ctx context.Context, userID, postID uuid.UUID) error
+	UnhidePost(ctx context.Context, userID, postID uuid.UUID) error
+	Saved(ctx context.Context, userID uuid.UUID, skip, limit int) ([]models.SavedItem, error)
+}
+
+type Transport struct {
+	service SavedService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service SavedService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) SavePost(w http.ResponseWriter, r *http.Request) {
+	t.change(w, r, "post", t.service.SavePost, "failed to save post")
+}
+
+func (t *Transport) UnsavePost(w http.ResponseWriter, r *http.Request) {
+	t.change(w, r, "post", t.service.UnsavePost, "failed to unsave post")
+}
+
+func (t *Transport) SaveComment(w http.ResponseWriter, r *http.Request) {
+	t.change(w, r, "comment", t.service.SaveComment, "failed to save comment")
+}
+
+func (t *Transport) UnsaveComment(w http.ResponseWriter, r *http.Request) {
+	t.change(w, r, "comment", t.service.UnsaveComment, "failed to unsave comment")
+}
+
+func (t *Transport) HidePost(w http.ResponseWriter, r *http.Request) {
+	t.change(w, r, "post", t.service.HidePost, "failed to hide post")
+}
+
+func (t *Transport) UnhidePost(w http.ResponseWriter, r *http.Request) {
+	t.change(w, r, "post", t.service.UnhidePost, "failed to unhide post")
+}
+
+// change applies change for the logged in user to the post or comment of
+// the id path value, where kind names what the id is of.
+func (t *Transport) change(w http.ResponseWriter, r *http.Request, kind string, change func(ctx context.Context, userID, ID uuid.UUID) error, fallbackMsg string) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, fmt.Sprintf("add a valid %s id", kind))
+		return
+	}
+
+	if err := change(r.Context(), user.ID, ID); err != nil {
+		writeSavedError(w, err, fallbackMsg)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (t *Transport) Saved(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	skipStr := r.URL.Query().Get("skip")
+	if len(skipStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid skip")
+		return
+	}
+	skip, err := parseIntParam(skipStr, "skip")
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if len(limitStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid limit")
+		return
+	}
+	limit, err := parseLimitParam(limitStr)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	items, err := t.service.Saved(r.Context(), user.ID, skip, limit)
+	if err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch saved items")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(items); err != nil {
+		log.Println("json encode error while fetching saved items:", err)
+	}
+}
+
+// writeSavedError answers a failed save or hide, falling back to an internal
+// server error with fallbackMsg.
+func writeSavedError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, savedrepo.ErrSavedPostNotFound),
+		errors.Is(err, savedrepo.ErrHiddenPostNotFound),
+		errors.Is(err, savedrepo.ErrSavedCommentNotFound),
+		errors.Is(err, savedrepo.ErrSavedUserNotFound):
+		writeResponseError(w, http.StatusNotFound, err.Error())
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
+
+func parseIntParam(param string, paramName string) (int, error) {
+	value, err := strconv.Atoi(param)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", paramName, err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("invalid %s: value must be non-negative", paramName)
+	}
+	return value, nil
+}
+
+// maxLimit is the largest page size a request may ask for.
+const maxLimit = 100
+
+func parseLimitParam(param string) (int, error) {
+	limit, err := parseIntParam(param, "limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit > maxLimit {
+		return 0, fmt.Errorf("invalid limit: value must be at most %d", maxLimit)
+	}
+	return limit, nil
+}
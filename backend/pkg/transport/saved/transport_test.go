@@ -0,0 +1,287 @@
+package saved_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	savedrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/saved"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/saved/savedfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var viewer = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+// serveAs sends a request of method to url through a server backed by
+// fakeSavedService, as user when one is given.
+func serveAs(t *testing.T, fakeSavedService *savedfakes.FakeSavedService, method, url string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Saved: fakeSavedService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		nil,
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_Change(t *testing.T) {
+	postID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	tests := []struct {
+		name           string
+		method         string
+		url            string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantCalls      func(fake *savedfakes.FakeSavedService) int
+	}{
+		{
+			name:           "save post not logged in :NEG",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000002/save",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+			wantCalls:      (*savedfakes.FakeSavedService).SavePostCallCount,
+		},
+		{
+			name:           "save post invalid id :NEG",
+			method:         "PUT",
+			url:            "/posts/foo/save",
+			user:           &viewer,
+			wantStatusCode: http.StatusBadRequest,
+			wantCalls:      (*savedfakes.FakeSavedService).SavePostCallCount,
+		},
+		{
+			name:           "save post not found :NEG",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000002/save",
+			user:           &viewer,
+			serviceErr:     savedrepo.ErrSavedPostNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantCalls:      (*savedfakes.FakeSavedService).SavePostCallCount,
+		},
+		{
+			name:           "save post service error :NEG",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000002/save",
+			user:           &viewer,
+			serviceErr:     errors.New("db error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantCalls:      (*savedfakes.FakeSavedService).SavePostCallCount,
+		},
+		{
+			name:           "save post :POS",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000002/save",
+			user:           &viewer,
+			wantStatusCode: http.StatusNoContent,
+			wantCalls:      (*savedfakes.FakeSavedService).SavePostCallCount,
+		},
+		{
+			name:           "unsave post :POS",
+			method:         "DELETE",
+			url:            "/posts/00000000-0000-0000-0000-000000000002/save",
+			user:           &viewer,
+			wantStatusCode: http.StatusNoContent,
+			wantCalls:      (*savedfakes.FakeSavedService).UnsavePostCallCount,
+		},
+		{
+			name:           "save comment not found :NEG",
+			method:         "PUT",
+			url:            "/comments/00000000-0000-0000-0000-000000000002/save",
+			user:           &viewer,
+			serviceErr:     savedrepo.ErrSavedCommentNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantCalls:      (*savedfakes.FakeSavedService).SaveCommentCallCount,
+		},
+		{
+			name:           "save comment :POS",
+			method:         "PUT",
+			url:            "/comments/00000000-0000-0000-0000-000000000002/save",
+			user:           &viewer,
+			wantStatusCode: http.StatusNoContent,
+			wantCalls:      (*savedfakes.FakeSavedService).SaveCommentCallCount,
+		},
+		{
+			name:           "unsave comment :POS",
+			method:         "DELETE",
+			url:            "/comments/00000000-0000-0000-0000-000000000002/save",
+			user:           &viewer,
+			wantStatusCode: http.StatusNoContent,
+			wantCalls:      (*savedfakes.FakeSavedService).UnsaveCommentCallCount,
+		},
+		{
+			name:           "hide post not found :NEG",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000002/hide",
+			user:           &viewer,
+			serviceErr:     savedrepo.ErrHiddenPostNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantCalls:      (*savedfakes.FakeSavedService).HidePostCallCount,
+		},
+		{
+			name:           "hide post :POS",
+			method:         "PUT",
+			url:            "/posts/00000000-0000-0000-0000-000000000002/hide",
+			user:           &viewer,
+			wantStatusCode: http.StatusNoContent,
+			wantCalls:      (*savedfakes.FakeSavedService).HidePostCallCount,
+		},
+		{
+			name:           "unhide post :POS",
+			method:         "DELETE",
+			url:            "/posts/00000000-0000-0000-0000-000000000002/hide",
+			user:           &viewer,
+			wantStatusCode: http.StatusNoContent,
+			wantCalls:      (*savedfakes.FakeSavedService).UnhidePostCallCount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSavedService := savedfakes.FakeSavedService{}
+			fakeSavedService.SavePostReturns(tt.serviceErr)
+			fakeSavedService.UnsavePostReturns(tt.serviceErr)
+			fakeSavedService.SaveCommentReturns(tt.serviceErr)
+			fakeSavedService.UnsaveCommentReturns(tt.serviceErr)
+			fakeSavedService.HidePostReturns(tt.serviceErr)
+			fakeSavedService.UnhidePostReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakeSavedService, tt.method, tt.url, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+
+			wantCalls := 1
+			if tt.user == nil || tt.wantStatusCode == http.StatusBadRequest {
+				wantCalls = 0
+			}
+			assert.Equal(t, wantCalls, tt.wantCalls(&fakeSavedService), "expect service calls to match")
+		})
+	}
+
+	t.Run("save post of caller :POS", func(t *testing.T) {
+		fakeSavedService := savedfakes.FakeSavedService{}
+
+		serveAs(t, &fakeSavedService, "PUT", "/posts/00000000-0000-0000-0000-000000000002/save", &viewer)
+
+		_, gotUserID, gotPostID := fakeSavedService.SavePostArgsForCall(0)
+		assert.Equal(t, viewer.ID, gotUserID, "expect user id to match")
+		assert.Equal(t, postID, gotPostID, "expect post id to match")
+	})
+}
+
+func TestTransport_Saved(t *testing.T) {
+	savedAt := time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		url            string
+		user           *models.User
+		items          []models.SavedItem
+		serviceErr     error
+		wantStatusCode int
+	}{
+		{
+			name:           "not logged in :NEG",
+			url:            "/me/saved?skip=0&limit=10",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing skip :NEG",
+			url:            "/me/saved?limit=10",
+			user:           &viewer,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid limit :NEG",
+			url:            "/me/saved?skip=0&limit=-1",
+			user:           &viewer,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "limit above maximum :NEG",
+			url:            "/me/saved?skip=0&limit=101",
+			user:           &viewer,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "service error :NEG",
+			url:            "/me/saved?skip=0&limit=10",
+			user:           &viewer,
+			serviceErr:     errors.New("db error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "saved items :POS",
+			url:  "/me/saved?skip=0&limit=10",
+			user: &viewer,
+			items: []models.SavedItem{
+				{
+					Type:    models.SavedItemTypeComment,
+					SavedAt: savedAt,
+					Comment: &models.UserComment{
+						CommentAuthor: models.CommentAuthor{
+							Comment: models.Comment{
+								ID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+								Body: "This is a comment",
+							},
+						},
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSavedService := savedfakes.FakeSavedService{}
+			fakeSavedService.SavedReturns(tt.items, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeSavedService, "GET", tt.url, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusOK {
+				_, gotUserID, gotSkip, gotLimit := fakeSavedService.SavedArgsForCall(0)
+				assert.Equal(t, viewer.ID, gotUserID, "expect user id to match")
+				assert.Equal(t, 0, gotSkip, "expect skip to match")
+				assert.Equal(t, 10, gotLimit, "expect limit to match")
+
+				var gotItems []models.SavedItem
+				if err := json.NewDecoder(recorder.Body).Decode(&gotItems); err != nil {
+					t.Fatalf("error decoding response: %+v", err)
+				}
+				assert.Equal(t, tt.items, gotItems, "expect saved items to match")
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+)
+
+//counterfeiter:generate . SearchService
+type SearchService interface {
+	Search(ctx context.Context, query string, searchType models.SearchType, skip, limit int) ([]models.SearchResult, error)
+}
+
+type Transport struct {
+	service SearchService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service SearchService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) Search(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if len(query) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid search query")
+		return
+	}
+
+	searchType := models.SearchType(r.URL.Query().Get("type"))
+	switch searchType {
+	case "":
+		searchType = models.SearchTypePost
+	case models.SearchTypePost, models.SearchTypeComment, models.SearchTypeVoxsphere, models.SearchTypeUser:
+	default:
+		writeResponseError(w, http.StatusBadRequest, fmt.Sprintf("invalid type: %q", searchType))
+		return
+	}
+
+	skipStr := r.URL.Query().Get("skip")
+	if len(skipStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid skip")
+		return
+	}
+	skip, err := parseIntParam(skipStr, "skip")
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if len(limitStr) == 0 {
+		writeResponseError(w, http.StatusBadRequest, "add a valid limit")
+		return
+	}
+	limit, err := parseLimitParam(limitStr)
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	results, err := t.service.Search(r.Context(), query, searchType, skip, limit)
+	if err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "failed to search")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		log.Println("json encode error while searching:", err)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
+
+func parseIntParam(param string, paramName string) (int, error) {
+	value, err := strconv.Atoi(param)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", paramName, err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("invalid %s: value must be non-negative", paramName)
+	}
+	return value, nil
+}
+
+// maxLimit is the largest page size a request may ask for.
+const maxLimit = 100
+
+func parseLimitParam(param string) (int, error) {
+	limit, err := parseIntParam(param, "limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit > maxLimit {
+		return 0, fmt.Errorf("invalid limit: value must be at most %d", maxLimit)
+	}
+	return limit, nil
+}
@@ -0,0 +1,172 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/search/searchfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport_Search(t *testing.T) {
+	type mockReturns struct {
+		results     []models.SearchResult
+		searchError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantQuery      string
+		wantType       models.SearchType
+		wantSkip       int
+		wantLimit      int
+		wantResponse   string
+	}{
+		{
+			name:           "missing query :NEG",
+			url:            "/search?skip=0&limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "blank query :NEG",
+			url:            "/search?q=%20%20&skip=0&limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid type :NEG",
+			url:            "/search?q=generics&type=award&skip=0&limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "missing limit :NEG",
+			url:            "/search?q=generics&skip=0",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "limit above maximum :NEG",
+			url:            "/search?q=generics&skip=0&limit=101",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid skip :NEG",
+			url:            "/search?q=generics&skip=-1&limit=10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "service error :NEG",
+			url:  "/search?q=generics&skip=0&limit=10",
+			mockReturns: mockReturns{
+				searchError: errors.New("db error"),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "search defaults to posts :POS",
+			url:  "/search?q=go+generics&skip=0&limit=10",
+			mockReturns: mockReturns{
+				results: []models.SearchResult{},
+			},
+			wantStatusCode: http.StatusOK,
+			wantQuery:      "go generics",
+			wantType:       models.SearchTypePost,
+			wantSkip:       0,
+			wantLimit:      10,
+			wantResponse:   `[]`,
+		},
+		{
+			name: "search comments :POS",
+			url:  "/search?q=containers&type=comment&skip=5&limit=5",
+			mockReturns: mockReturns{
+				results: []models.SearchResult{
+					{
+						Type:      models.SearchTypeComment,
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Title:     "Learning Go generics",
+						Snippet:   "Generics are great for <mark>containers</mark>",
+						Rank:      0.5,
+						PostID:    ptrof(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Voxsphere: ptrof("v/foo"),
+						Author:    ptrof("Jane Doe"),
+						CreatedAt: time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantQuery:      "containers",
+			wantType:       models.SearchTypeComment,
+			wantSkip:       5,
+			wantLimit:      5,
+			wantResponse: `
+                [
+                  {
+                    "type": "comment",
+                    "id": "00000000-0000-0000-0000-000000000001",
+                    "title": "Learning Go generics",
+                    "snippet": "Generics are great for <mark>containers</mark>",
+                    "rank": 0.5,
+                    "post_id": "00000000-0000-0000-0000-000000000001",
+                    "voxsphere": "v/foo",
+                    "author": "Jane Doe",
+                    "created_at": "2024-10-10T10:10:10Z"
+                  }
+                ]
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSearchService := searchfakes.FakeSearchService{}
+			fakeSearchService.SearchReturns(tt.mockReturns.results, tt.mockReturns.searchError)
+
+			server, err := tr.NewServer(tr.Services{
+				Search: &fakeSearchService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				_, gotQuery, gotType, gotSkip, gotLimit := fakeSearchService.SearchArgsForCall(0)
+				assert.Equal(t, tt.wantQuery, gotQuery, "expect query to match")
+				assert.Equal(t, tt.wantType, gotType, "expect search type to match")
+				assert.Equal(t, tt.wantSkip, gotSkip, "expect skip to match")
+				assert.Equal(t, tt.wantLimit, gotLimit, "expect limit to match")
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
@@ -6,7 +6,23 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/auth"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/automod"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/award"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/comment"
+	customemoji "github.com/glowfi/voxpopuli/backend/pkg/transport/custom_emoji"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/moderation"
 	"github.com/glowfi/voxpopuli/backend/pkg/transport/post"
+	postflair "github.com/glowfi/voxpopuli/backend/pkg/transport/post_flair"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/report"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/saved"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/search"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/user"
+	userflair "github.com/glowfi/voxpopuli/backend/pkg/transport/user_flair"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/vote"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/voxsphere"
 )
 
 // Supported HTTP methods.
@@ -32,7 +48,21 @@ type Route struct {
 
 // Services represents the services used by the server.
 type Services struct {
-	Post post.PostService
+	Post        post.PostService
+	Comment     comment.CommentService
+	Voxsphere   voxsphere.VoxsphereService
+	User        user.UserService
+	Search      search.SearchService
+	Vote        vote.VoteService
+	Auth        auth.AuthService
+	Moderation  moderation.ModerationService
+	Report      report.ReportService
+	Automod     automod.AutomodService
+	PostFlair   postflair.PostFlairService
+	UserFlair   userflair.UserFlairService
+	CustomEmoji customemoji.CustomEmojiService
+	Award       award.AwardService
+	Saved       saved.SavedService
 }
 
 // Server represents the HTTP server.
@@ -44,6 +74,20 @@ type Server struct {
 // NewServer creates a new server.
 func NewServer(services Services) (*Server, error) {
 	postsTransport := post.NewTransport(services.Post)
+	commentsTransport := comment.NewTransport(services.Comment)
+	voxspheresTransport := voxsphere.NewTransport(services.Voxsphere)
+	usersTransport := user.NewTransport(services.User)
+	searchTransport := search.NewTransport(services.Search)
+	votesTransport := vote.NewTransport(services.Vote)
+	authTransport := auth.NewTransport(services.Auth)
+	moderationTransport := moderation.NewTransport(services.Moderation)
+	reportsTransport := report.NewTransport(services.Report)
+	automodTransport := automod.NewTransport(services.Automod)
+	postFlairTransport := postflair.NewTransport(services.PostFlair)
+	userFlairTransport := userflair.NewTransport(services.UserFlair)
+	customEmojiTransport := customemoji.NewTransport(services.CustomEmoji)
+	awardTransport := award.NewTransport(services.Award)
+	savedTransport := saved.NewTransport(services.Saved)
 
 	routes := []Route{
 		// posts api
@@ -53,6 +97,440 @@ func NewServer(services Services) (*Server, error) {
 			HttpPath:    "/posts",
 			HttpHandler: http.HandlerFunc(postsTransport.PostsPaginated),
 		},
+		{
+			Name:        "HomeFeed",
+			HttpMethod:  GET,
+			HttpPath:    "/feed/home",
+			HttpHandler: http.HandlerFunc(postsTransport.HomeFeed),
+		},
+		{
+			Name:        "PostByID",
+			HttpMethod:  GET,
+			HttpPath:    "/posts/{id}",
+			HttpHandler: http.HandlerFunc(postsTransport.PostByID),
+		},
+		{
+			Name:        "CreatePost",
+			HttpMethod:  POST,
+			HttpPath:    "/posts",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(postsTransport.CreatePost)),
+		},
+		{
+			Name:        "EditPost",
+			HttpMethod:  PATCH,
+			HttpPath:    "/posts/{id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(postsTransport.EditPost)),
+		},
+		{
+			Name:        "DeletePost",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(postsTransport.DeletePost)),
+		},
+		{
+			Name:        "VotePost",
+			HttpMethod:  POST,
+			HttpPath:    "/posts/{id}/vote",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(votesTransport.VotePost)),
+		},
+		{
+			Name:        "RemovePost",
+			HttpMethod:  POST,
+			HttpPath:    "/posts/{id}/remove",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionRemovePost)),
+		},
+		{
+			Name:        "ApprovePost",
+			HttpMethod:  POST,
+			HttpPath:    "/posts/{id}/approve",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionApprovePost)),
+		},
+		{
+			Name:        "LockPost",
+			HttpMethod:  PUT,
+			HttpPath:    "/posts/{id}/lock",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionLockPost)),
+		},
+		{
+			Name:        "UnlockPost",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}/lock",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionUnlockPost)),
+		},
+		{
+			Name:        "PinPost",
+			HttpMethod:  PUT,
+			HttpPath:    "/posts/{id}/pin",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionPinPost)),
+		},
+		{
+			Name:        "UnpinPost",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}/pin",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionUnpinPost)),
+		},
+		{
+			Name:        "MarkNSFW",
+			HttpMethod:  PUT,
+			HttpPath:    "/posts/{id}/nsfw",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionMarkNSFW)),
+		},
+		{
+			Name:        "UnmarkNSFW",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}/nsfw",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionUnmarkNSFW)),
+		},
+		{
+			Name:        "MarkSpoiler",
+			HttpMethod:  PUT,
+			HttpPath:    "/posts/{id}/spoiler",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionMarkSpoiler)),
+		},
+		{
+			Name:        "UnmarkSpoiler",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}/spoiler",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionUnmarkSpoiler)),
+		},
+		{
+			Name:        "ReportPost",
+			HttpMethod:  POST,
+			HttpPath:    "/posts/{id}/report",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(reportsTransport.ReportPost)),
+		},
+		{
+			Name:        "DismissPostReports",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}/reports",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModeratePost(models.ModActionDismissPostReports)),
+		},
+		{
+			Name:        "SetPostFlair",
+			HttpMethod:  PUT,
+			HttpPath:    "/posts/{id}/flair",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(postFlairTransport.SetPostFlair)),
+		},
+		{
+			Name:        "ClearPostFlair",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}/flair",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(postFlairTransport.ClearPostFlair)),
+		},
+
+		// comments api
+		{
+			Name:        "CommentTree",
+			HttpMethod:  GET,
+			HttpPath:    "/posts/{id}/comments",
+			HttpHandler: http.HandlerFunc(commentsTransport.CommentTree),
+		},
+		{
+			Name:        "CreateComment",
+			HttpMethod:  POST,
+			HttpPath:    "/posts/{id}/comments",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(commentsTransport.CreateComment)),
+		},
+		{
+			Name:        "EditComment",
+			HttpMethod:  PATCH,
+			HttpPath:    "/comments/{id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(commentsTransport.EditComment)),
+		},
+		{
+			Name:        "DeleteComment",
+			HttpMethod:  DELETE,
+			HttpPath:    "/comments/{id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(commentsTransport.DeleteComment)),
+		},
+		{
+			Name:        "VoteComment",
+			HttpMethod:  POST,
+			HttpPath:    "/comments/{id}/vote",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(votesTransport.VoteComment)),
+		},
+		{
+			Name:        "RemoveComment",
+			HttpMethod:  POST,
+			HttpPath:    "/comments/{id}/remove",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModerateComment(models.ModActionRemoveComment)),
+		},
+		{
+			Name:        "ApproveComment",
+			HttpMethod:  POST,
+			HttpPath:    "/comments/{id}/approve",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModerateComment(models.ModActionApproveComment)),
+		},
+		{
+			Name:        "ReportComment",
+			HttpMethod:  POST,
+			HttpPath:    "/comments/{id}/report",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(reportsTransport.ReportComment)),
+		},
+		{
+			Name:        "DismissCommentReports",
+			HttpMethod:  DELETE,
+			HttpPath:    "/comments/{id}/reports",
+			HttpHandler: middleware.RequireAuthentication(moderationTransport.ModerateComment(models.ModActionDismissCommentReports)),
+		},
+
+		// voxspheres api
+		{
+			Name:        "VoxsphereByID",
+			HttpMethod:  GET,
+			HttpPath:    "/voxspheres/{id}",
+			HttpHandler: http.HandlerFunc(voxspheresTransport.VoxsphereByID),
+		},
+		{
+			Name:        "VoxspherePosts",
+			HttpMethod:  GET,
+			HttpPath:    "/voxspheres/{id}/posts",
+			HttpHandler: http.HandlerFunc(postsTransport.VoxspherePosts),
+		},
+		{
+			Name:        "JoinVoxsphere",
+			HttpMethod:  POST,
+			HttpPath:    "/voxspheres/{id}/membership",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(voxspheresTransport.JoinVoxsphere)),
+		},
+		{
+			Name:        "LeaveVoxsphere",
+			HttpMethod:  DELETE,
+			HttpPath:    "/voxspheres/{id}/membership",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(voxspheresTransport.LeaveVoxsphere)),
+		},
+		{
+			Name:        "BanUser",
+			HttpMethod:  PUT,
+			HttpPath:    "/voxspheres/{id}/bans/{user_id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(moderationTransport.BanUser)),
+		},
+		{
+			Name:        "UnbanUser",
+			HttpMethod:  DELETE,
+			HttpPath:    "/voxspheres/{id}/bans/{user_id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(moderationTransport.UnbanUser)),
+		},
+		{
+			Name:        "ModLogs",
+			HttpMethod:  GET,
+			HttpPath:    "/voxspheres/{id}/modlog",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(moderationTransport.ModLogs)),
+		},
+		{
+			Name:        "ReportQueue",
+			HttpMethod:  GET,
+			HttpPath:    "/voxspheres/{id}/reports",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(reportsTransport.ReportQueue)),
+		},
+		{
+			Name:        "AutomodConfig",
+			HttpMethod:  GET,
+			HttpPath:    "/voxspheres/{id}/automod",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(automodTransport.AutomodConfig)),
+		},
+		{
+			Name:        "UpdateAutomodConfig",
+			HttpMethod:  PUT,
+			HttpPath:    "/voxspheres/{id}/automod",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(automodTransport.UpdateAutomodConfig)),
+		},
+		{
+			Name:        "VoxspherePostFlairs",
+			HttpMethod:  GET,
+			HttpPath:    "/voxspheres/{id}/flairs",
+			HttpHandler: http.HandlerFunc(postFlairTransport.VoxspherePostFlairs),
+		},
+		{
+			Name:        "VoxsphereUserFlairs",
+			HttpMethod:  GET,
+			HttpPath:    "/voxspheres/{id}/user-flairs",
+			HttpHandler: http.HandlerFunc(userFlairTransport.VoxsphereUserFlairs),
+		},
+		{
+			Name:        "CreateUserFlair",
+			HttpMethod:  POST,
+			HttpPath:    "/voxspheres/{id}/user-flairs",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(userFlairTransport.CreateUserFlair)),
+		},
+		{
+			Name:        "EditUserFlair",
+			HttpMethod:  PUT,
+			HttpPath:    "/voxspheres/{id}/user-flairs/{flair_id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(userFlairTransport.EditUserFlair)),
+		},
+		{
+			Name:        "SetUserFlair",
+			HttpMethod:  PUT,
+			HttpPath:    "/voxspheres/{id}/user-flair",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(userFlairTransport.SetUserFlair)),
+		},
+		{
+			Name:        "ClearUserFlair",
+			HttpMethod:  DELETE,
+			HttpPath:    "/voxspheres/{id}/user-flair",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(userFlairTransport.ClearUserFlair)),
+		},
+		{
+			Name:        "VoxsphereCustomEmojis",
+			HttpMethod:  GET,
+			HttpPath:    "/voxspheres/{id}/emojis",
+			HttpHandler: http.HandlerFunc(customEmojiTransport.VoxsphereCustomEmojis),
+		},
+		{
+			Name:        "AddCustomEmoji",
+			HttpMethod:  POST,
+			HttpPath:    "/voxspheres/{id}/emojis",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(customEmojiTransport.AddCustomEmoji)),
+		},
+		{
+			Name:        "RenameCustomEmoji",
+			HttpMethod:  PATCH,
+			HttpPath:    "/voxspheres/{id}/emojis/{emoji_id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(customEmojiTransport.RenameCustomEmoji)),
+		},
+		{
+			Name:        "DeleteCustomEmoji",
+			HttpMethod:  DELETE,
+			HttpPath:    "/voxspheres/{id}/emojis/{emoji_id}",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(customEmojiTransport.DeleteCustomEmoji)),
+		},
+
+		// users api
+		{
+			Name:        "UserByName",
+			HttpMethod:  GET,
+			HttpPath:    "/users/{name}",
+			HttpHandler: http.HandlerFunc(usersTransport.UserByName),
+		},
+		{
+			Name:        "UserPosts",
+			HttpMethod:  GET,
+			HttpPath:    "/users/{name}/posts",
+			HttpHandler: http.HandlerFunc(postsTransport.UserPosts),
+		},
+		{
+			Name:        "UserComments",
+			HttpMethod:  GET,
+			HttpPath:    "/users/{name}/comments",
+			HttpHandler: http.HandlerFunc(commentsTransport.UserComments),
+		},
+
+		// search api
+		{
+			Name:        "Search",
+			HttpMethod:  GET,
+			HttpPath:    "/search",
+			HttpHandler: http.HandlerFunc(searchTransport.Search),
+		},
+
+		// auth api
+		{
+			Name:        "Register",
+			HttpMethod:  POST,
+			HttpPath:    "/auth/register",
+			HttpHandler: http.HandlerFunc(authTransport.Register),
+		},
+		{
+			Name:        "Login",
+			HttpMethod:  POST,
+			HttpPath:    "/auth/login",
+			HttpHandler: http.HandlerFunc(authTransport.Login),
+		},
+		{
+			Name:        "Refresh",
+			HttpMethod:  POST,
+			HttpPath:    "/auth/refresh",
+			HttpHandler: http.HandlerFunc(authTransport.Refresh),
+		},
+		{
+			Name:        "Logout",
+			HttpMethod:  POST,
+			HttpPath:    "/auth/logout",
+			HttpHandler: http.HandlerFunc(authTransport.Logout),
+		},
+		{
+			Name:        "Me",
+			HttpMethod:  GET,
+			HttpPath:    "/me",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(authTransport.Me)),
+		},
+		{
+			Name:        "MyVoxspheres",
+			HttpMethod:  GET,
+			HttpPath:    "/me/voxspheres",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(voxspheresTransport.MyVoxspheres)),
+		},
+		{
+			Name:        "MyCoinWallet",
+			HttpMethod:  GET,
+			HttpPath:    "/me/coins",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(awardTransport.CoinWallet)),
+		},
+		{
+			Name:        "MySaved",
+			HttpMethod:  GET,
+			HttpPath:    "/me/saved",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(savedTransport.Saved)),
+		},
+
+		// awards api
+		{
+			Name:        "Awards",
+			HttpMethod:  GET,
+			HttpPath:    "/awards",
+			HttpHandler: http.HandlerFunc(awardTransport.Awards),
+		},
+		{
+			Name:        "GrantPostAward",
+			HttpMethod:  POST,
+			HttpPath:    "/posts/{id}/awards",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(awardTransport.GrantPostAward)),
+		},
+		{
+			Name:        "GrantCommentAward",
+			HttpMethod:  POST,
+			HttpPath:    "/comments/{id}/awards",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(awardTransport.GrantCommentAward)),
+		},
+
+		// saved api
+		{
+			Name:        "SavePost",
+			HttpMethod:  PUT,
+			HttpPath:    "/posts/{id}/save",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(savedTransport.SavePost)),
+		},
+		{
+			Name:        "UnsavePost",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}/save",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(savedTransport.UnsavePost)),
+		},
+		{
+			Name:        "SaveComment",
+			HttpMethod:  PUT,
+			HttpPath:    "/comments/{id}/save",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(savedTransport.SaveComment)),
+		},
+		{
+			Name:        "UnsaveComment",
+			HttpMethod:  DELETE,
+			HttpPath:    "/comments/{id}/save",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(savedTransport.UnsaveComment)),
+		},
+		{
+			Name:        "HidePost",
+			HttpMethod:  PUT,
+			HttpPath:    "/posts/{id}/hide",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(savedTransport.HidePost)),
+		},
+		{
+			Name:        "UnhidePost",
+			HttpMethod:  DELETE,
+			HttpPath:    "/posts/{id}/hide",
+			HttpHandler: middleware.RequireAuthentication(http.HandlerFunc(savedTransport.UnhidePost)),
+		},
 	}
 
 	return &Server{
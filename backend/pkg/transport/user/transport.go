@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	userrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user"
+)
+
+//counterfeiter:generate . UserService
+type UserService interface {
+	UserProfileByName(ctx context.Context, name string) (models.UserProfile, error)
+}
+
+type Transport struct {
+	service UserService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service UserService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) UserByName(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	profile, err := t.service.UserProfileByName(r.Context(), r.PathValue("name"))
+	if err != nil {
+		if errors.Is(err, userrepo.ErrUserNotFound) {
+			writeResponseError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch user")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(profile); err != nil {
+		log.Println("json encode error while fetching user:", err)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
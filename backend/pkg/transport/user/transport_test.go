@@ -0,0 +1,195 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	userrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/user/userfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport_UserByName(t *testing.T) {
+	type mockReturns struct {
+		profile   models.UserProfile
+		userError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantName       string
+		wantResponse   string
+	}{
+		{
+			name: "user not found :NEG",
+			url:  "/users/Nobody",
+			mockReturns: mockReturns{
+				userError: userrepo.ErrUserNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "service error :NEG",
+			url:  "/users/John%20Doe",
+			mockReturns: mockReturns{
+				userError: errors.New("db error"),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "user profile :POS",
+			url:  "/users/John%20Doe",
+			mockReturns: mockReturns{
+				profile: models.UserProfile{
+					User: models.User{
+						ID:                uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Name:              "John Doe",
+						PublicDescription: ptrof("This is a public description"),
+						AvatarImg:         ptrof("https://example.com/avatar1.jpg"),
+						BannerImg:         ptrof("https://example.com/banner1.jpg"),
+						Iconcolor:         ptrof("#FF0000"),
+						Keycolor:          ptrof("#00FF00"),
+						Primarycolor:      ptrof("#0000FF"),
+						Over18:            true,
+						Suspended:         false,
+						CreatedAt:         time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:     1725091100,
+						UpdatedAt:         time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Trophies: []models.Trophy{
+						{
+							ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Title:       "Verified Email",
+							Description: "Verified the email address of the account",
+							ImageLink:   "https://example.com/trophy1.png",
+						},
+					},
+					Flairs: []models.UserFlairRendered{
+						{
+							ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Voxsphere:       "v/foo",
+							FullText:        ":1f600: foo fan",
+							BackgroundColor: "#ff0000",
+							Richtext: []models.FlairRichtext{
+								{
+									Type:       models.FlairRichtextTypeEmoji,
+									OrderIndex: 1,
+									Text:       "😀",
+								},
+								{
+									Type:       models.FlairRichtextTypeText,
+									OrderIndex: 2,
+									Text:       " foo fan",
+								},
+							},
+						},
+					},
+					Karma: models.UserKarma{
+						PostKarma:    5,
+						CommentKarma: 2,
+						Total:        7,
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantName:       "John Doe",
+			wantResponse: `
+                {
+                  "id": "00000000-0000-0000-0000-000000000001",
+                  "name": "John Doe",
+                  "public_description": "This is a public description",
+                  "avatar_img": "https://example.com/avatar1.jpg",
+                  "banner_img": "https://example.com/banner1.jpg",
+                  "iconcolor": "#FF0000",
+                  "keycolor": "#00FF00",
+                  "primarycolor": "#0000FF",
+                  "over18": true,
+                  "suspended": false,
+                  "created_at": "2024-10-10T10:10:10Z",
+                  "created_at_unix": 1725091100,
+                  "updated_at": "2024-10-10T10:10:10Z",
+                  "trophies": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "title": "Verified Email",
+                      "description": "Verified the email address of the account",
+                      "image_link": "https://example.com/trophy1.png"
+                    }
+                  ],
+                  "flairs": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+                      "voxsphere": "v/foo",
+                      "full_text": ":1f600: foo fan",
+                      "background_color": "#ff0000",
+                      "richtext": [
+                        {"type": "emoji", "order_index": 1, "text": "😀", "url": null},
+                        {"type": "text", "order_index": 2, "text": " foo fan", "url": null}
+                      ]
+                    }
+                  ],
+                  "karma": {
+                    "post_karma": 5,
+                    "comment_karma": 2,
+                    "total": 7
+                  }
+                }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserService := userfakes.FakeUserService{}
+			fakeUserService.UserProfileByNameReturns(tt.mockReturns.profile, tt.mockReturns.userError)
+
+			server, err := tr.NewServer(tr.Services{
+				User: &fakeUserService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				_, gotName := fakeUserService.UserProfileByNameArgsForCall(0)
+				assert.Equal(t, tt.wantName, gotName, "expect user name to match")
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,233 @@
+package userflair
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	userflairrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user_flair"
+	userflairsvc "github.com/glowfi/voxpopuli/backend/pkg/service/user_flair"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . UserFlairService
+type UserFlairService interface {
+	VoxsphereUserFlairs(ctx context.Context, voxsphereID uuid.UUID) ([]models.UserFlairRendered, error)
+	CreateUserFlair(ctx context.Context, voxsphereID, moderatorID uuid.UUID, template models.UserFlairTemplate) (models.UserFlairRendered, error)
+	EditUserFlair(ctx context.Context, voxsphereID, userFlairID, moderatorID uuid.UUID, template models.UserFlairTemplate) (models.UserFlairRendered, error)
+	SetUserFlair(ctx context.Context, userID, voxsphereID, userFlairID uuid.UUID) error
+	ClearUserFlair(ctx context.Context, userID, voxsphereID uuid.UUID) error
+}
+
+type Transport struct {
+	service UserFlairService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service UserFlairService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) VoxsphereUserFlairs(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	flairs, err := t.service.VoxsphereUserFlairs(r.Context(), voxsphereID)
+	if err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch user flairs")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(flairs); err != nil {
+		log.Println("json encode error while fetching user flairs:", err)
+	}
+}
+
+func (t *Transport) CreateUserFlair(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	var template models.UserFlairTemplate
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid user flair")
+		return
+	}
+
+	flair, err := t.service.CreateUserFlair(r.Context(), voxsphereID, user.ID, template)
+	if err != nil {
+		writeUserFlairError(w, err, "failed to create user flair")
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(flair); err != nil {
+		log.Println("json encode error while creating user flair:", err)
+	}
+}
+
+func (t *Transport) EditUserFlair(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	userFlairID, err := uuid.Parse(r.PathValue("flair_id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid user flair id")
+		return
+	}
+
+	var template models.UserFlairTemplate
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid user flair")
+		return
+	}
+
+	flair, err := t.service.EditUserFlair(r.Context(), voxsphereID, userFlairID, user.ID, template)
+	if err != nil {
+		writeUserFlairError(w, err, "failed to edit user flair")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(flair); err != nil {
+		log.Println("json encode error while editing user flair:", err)
+	}
+}
+
+func (t *Transport) SetUserFlair(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	var submission models.UserFlairSubmission
+	if err := json.NewDecoder(r.Body).Decode(&submission); err != nil || submission.UserFlairID == uuid.Nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid user flair id")
+		return
+	}
+
+	if err := t.service.SetUserFlair(r.Context(), user.ID, voxsphereID, submission.UserFlairID); err != nil {
+		writeUserFlairError(w, err, "failed to set user flair")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (t *Transport) ClearUserFlair(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxsphereID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	if err := t.service.ClearUserFlair(r.Context(), user.ID, voxsphereID); err != nil {
+		writeUserFlairError(w, err, "failed to clear user flair")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeUserFlairError answers a failed user flair request, falling back to an
+// internal server error with fallbackMsg.
+func writeUserFlairError(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, userflairsvc.ErrUserFlairInvalidPieces),
+		errors.Is(err, userflairsvc.ErrUserFlairInvalidText),
+		errors.Is(err, userflairsvc.ErrUserFlairInvalidEmoji),
+		errors.Is(err, userflairsvc.ErrUserFlairInvalidType),
+		errors.Is(err, userflairsvc.ErrUserFlairInvalidColor):
+		writeResponseError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, userflairsvc.ErrUserFlairNotModerator),
+		errors.Is(err, userflairsvc.ErrUserFlairNotMember):
+		writeResponseError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, userflairrepo.ErrUserFlairNotFound),
+		errors.Is(err, userflairrepo.ErrUserFlairVoxsphereNotFound):
+		writeResponseError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, userflairrepo.ErrUserFlairNotInVoxsphere),
+		errors.Is(err, userflairrepo.ErrUserFlairEmojiNotFound):
+		writeResponseError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+	}
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
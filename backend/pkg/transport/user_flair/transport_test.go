@@ -0,0 +1,318 @@
+package userflair_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	userflairrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/user_flair"
+	userflairsvc "github.com/glowfi/voxpopuli/backend/pkg/service/user_flair"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/user_flair/user_flairfakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var member = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+// serveAs sends a request of method to url with body through a server backed
+// by fakeUserFlairService, as user when one is given.
+func serveAs(t *testing.T, fakeUserFlairService *user_flairfakes.FakeUserFlairService, method, url, body string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		UserFlair: fakeUserFlairService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(
+		method,
+		url,
+		strings.NewReader(body),
+	)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_VoxsphereUserFlairs(t *testing.T) {
+	emoji := "https://example.com/ce1.png"
+
+	tests := []struct {
+		name           string
+		url            string
+		flairs         []models.UserFlairRendered
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "invalid voxsphere id :NEG",
+			url:            "/voxspheres/foo/user-flairs",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "user flairs :POS",
+			url:  "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs",
+			flairs: []models.UserFlairRendered{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					VoxsphereID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Voxsphere:       "v/foo",
+					FullText:        "desc1 :ce1:",
+					BackgroundColor: "#FFFFFF",
+					Richtext: []models.FlairRichtext{
+						{Type: models.FlairRichtextTypeText, OrderIndex: 0, Text: "desc1 "},
+						{Type: models.FlairRichtextTypeCustomEmoji, OrderIndex: 1, Text: ":ce1:", Url: &emoji},
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+            [
+              {
+                "id": "00000000-0000-0000-0000-000000000001",
+                "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+                "voxsphere": "v/foo",
+                "full_text": "desc1 :ce1:",
+                "background_color": "#FFFFFF",
+                "richtext": [
+                  {"type": "text", "order_index": 0, "text": "desc1 ", "url": null},
+                  {"type": "custom_emoji", "order_index": 1, "text": ":ce1:", "url": "https://example.com/ce1.png"}
+                ]
+              }
+            ]
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserFlairService := user_flairfakes.FakeUserFlairService{}
+			fakeUserFlairService.VoxsphereUserFlairsReturns(tt.flairs, nil)
+
+			recorder := serveAs(t, &fakeUserFlairService, "GET", tt.url, "", nil)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if len(tt.wantResponse) != 0 {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransport_CreateUserFlair(t *testing.T) {
+	body := `{"background_color": "#FFFFFF", "richtext": [{"type": "text", "text": "desc1"}]}`
+
+	tests := []struct {
+		name            string
+		url             string
+		body            string
+		user            *models.User
+		serviceErr      error
+		wantStatusCode  int
+		wantCreateCalls int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs",
+			body:           body,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid body :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs",
+			body:           `{"richtext": "desc1"}`,
+			user:           &member,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:            "invalid flair :NEG",
+			url:             "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs",
+			body:            `{"richtext": []}`,
+			user:            &member,
+			serviceErr:      userflairsvc.ErrUserFlairInvalidPieces,
+			wantStatusCode:  http.StatusBadRequest,
+			wantCreateCalls: 1,
+		},
+		{
+			name:            "not a moderator :NEG",
+			url:             "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs",
+			body:            body,
+			user:            &member,
+			serviceErr:      userflairsvc.ErrUserFlairNotModerator,
+			wantStatusCode:  http.StatusForbidden,
+			wantCreateCalls: 1,
+		},
+		{
+			name:            "custom emoji of another voxsphere :NEG",
+			url:             "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs",
+			body:            `{"richtext": [{"type": "custom_emoji", "custom_emoji_id": "00000000-0000-0000-0000-000000000002"}]}`,
+			user:            &member,
+			serviceErr:      userflairrepo.ErrUserFlairEmojiNotFound,
+			wantStatusCode:  http.StatusUnprocessableEntity,
+			wantCreateCalls: 1,
+		},
+		{
+			name:            "create flair :POS",
+			url:             "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs",
+			body:            body,
+			user:            &member,
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserFlairService := user_flairfakes.FakeUserFlairService{}
+			fakeUserFlairService.CreateUserFlairReturns(models.UserFlairRendered{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeUserFlairService, "POST", tt.url, tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantCreateCalls, fakeUserFlairService.CreateUserFlairCallCount(), "expect create call count to match")
+		})
+	}
+}
+
+func TestTransport_EditUserFlair(t *testing.T) {
+	body := `{"richtext": [{"type": "text", "text": "desc1"}]}`
+
+	tests := []struct {
+		name           string
+		url            string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+	}{
+		{
+			name:           "invalid user flair id :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs/foo",
+			user:           &member,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "user flair not found :NEG",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs/00000000-0000-0000-0000-000000000009",
+			user:           &member,
+			serviceErr:     userflairrepo.ErrUserFlairNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "edit flair :POS",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/user-flairs/00000000-0000-0000-0000-000000000001",
+			user:           &member,
+			wantStatusCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserFlairService := user_flairfakes.FakeUserFlairService{}
+			fakeUserFlairService.EditUserFlairReturns(models.UserFlairRendered{}, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeUserFlairService, "PUT", tt.url, body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+		})
+	}
+}
+
+func TestTransport_SetUserFlair(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantSetCalls   int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			body:           `{"user_flair_id": "00000000-0000-0000-0000-000000000001"}`,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing user flair id :NEG",
+			body:           `{}`,
+			user:           &member,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "not a member :NEG",
+			body:           `{"user_flair_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &member,
+			serviceErr:     userflairsvc.ErrUserFlairNotMember,
+			wantStatusCode: http.StatusForbidden,
+			wantSetCalls:   1,
+		},
+		{
+			name:           "flair of another voxsphere :NEG",
+			body:           `{"user_flair_id": "00000000-0000-0000-0000-000000000002"}`,
+			user:           &member,
+			serviceErr:     userflairrepo.ErrUserFlairNotInVoxsphere,
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantSetCalls:   1,
+		},
+		{
+			name:           "set flair :POS",
+			body:           `{"user_flair_id": "00000000-0000-0000-0000-000000000001"}`,
+			user:           &member,
+			wantStatusCode: http.StatusNoContent,
+			wantSetCalls:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserFlairService := user_flairfakes.FakeUserFlairService{}
+			fakeUserFlairService.SetUserFlairReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakeUserFlairService, "PUT", "/voxspheres/00000000-0000-0000-0000-000000000001/user-flair", tt.body, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantSetCalls, fakeUserFlairService.SetUserFlairCallCount(), "expect set call count to match")
+		})
+	}
+}
+
+func TestTransport_ClearUserFlair(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *models.User
+		wantStatusCode int
+	}{
+		{
+			name:           "anonymous request :NEG",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "clear flair :POS",
+			user:           &member,
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeUserFlairService := user_flairfakes.FakeUserFlairService{}
+
+			recorder := serveAs(t, &fakeUserFlairService, "DELETE", "/voxspheres/00000000-0000-0000-0000-000000000001/user-flair", "", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+		})
+	}
+}
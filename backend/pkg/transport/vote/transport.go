@@ -0,0 +1,153 @@
+package vote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	voterepo "github.com/glowfi/voxpopuli/backend/pkg/repo/vote"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . VoteService
+type VoteService interface {
+	VotePost(ctx context.Context, postID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error)
+	VoteComment(ctx context.Context, commentID, userID uuid.UUID, direction models.VoteDirection) (models.Vote, error)
+}
+
+type Transport struct {
+	service VoteService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+// voteRequest is the body of a vote request.
+type voteRequest struct {
+	Direction models.VoteDirection `json:"direction"`
+}
+
+func NewTransport(service VoteService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) VotePost(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	postID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid post id")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	req, ok := decodeVoteRequest(w, r)
+	if !ok {
+		return
+	}
+
+	vote, err := t.service.VotePost(r.Context(), postID, user.ID, req.Direction)
+	if err != nil {
+		switch {
+		case errors.Is(err, voterepo.ErrVotePostNotFound):
+			writeResponseError(w, http.StatusNotFound, "post not found")
+		case errors.Is(err, voterepo.ErrVoteUserNotFound):
+			writeResponseError(w, http.StatusNotFound, "user not found")
+		default:
+			writeResponseError(w, http.StatusInternalServerError, "failed to vote on post")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(vote); err != nil {
+		log.Println("json encode error while voting on post:", err)
+	}
+}
+
+func (t *Transport) VoteComment(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	commentID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid comment id")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	req, ok := decodeVoteRequest(w, r)
+	if !ok {
+		return
+	}
+
+	vote, err := t.service.VoteComment(r.Context(), commentID, user.ID, req.Direction)
+	if err != nil {
+		switch {
+		case errors.Is(err, voterepo.ErrVoteCommentNotFound):
+			writeResponseError(w, http.StatusNotFound, "comment not found")
+		case errors.Is(err, voterepo.ErrVoteUserNotFound):
+			writeResponseError(w, http.StatusNotFound, "user not found")
+		default:
+			writeResponseError(w, http.StatusInternalServerError, "failed to vote on comment")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(vote); err != nil {
+		log.Println("json encode error while voting on comment:", err)
+	}
+}
+
+// decodeVoteRequest reads the vote request of r, answering with a bad request
+// when it is malformed.
+func decodeVoteRequest(w http.ResponseWriter, r *http.Request) (voteRequest, bool) {
+	var req voteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid vote")
+		return voteRequest{}, false
+	}
+
+	switch req.Direction {
+	case models.VoteDirectionUp, models.VoteDirectionDown, models.VoteDirectionClear:
+	default:
+		writeResponseError(w, http.StatusBadRequest, "add a valid direction: up, down or clear")
+		return voteRequest{}, false
+	}
+
+	return req, true
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
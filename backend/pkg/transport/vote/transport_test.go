@@ -0,0 +1,268 @@
+package vote_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	voterepo "github.com/glowfi/voxpopuli/backend/pkg/repo/vote"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/vote/votefakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var voter = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+func TestTransport_VotePost(t *testing.T) {
+	type mockReturns struct {
+		vote      models.Vote
+		voteError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		anonymous      bool
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "invalid post id :NEG",
+			url:            "/posts/foo/vote",
+			body:           `{"direction": "up"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "malformed body :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/vote",
+			body:           `{"direction":`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "anonymous request :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/vote",
+			body:           `{"direction": "up"}`,
+			anonymous:      true,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid direction :NEG",
+			url:            "/posts/00000000-0000-0000-0000-000000000001/vote",
+			body:           `{"direction": "sideways"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "post not found :NEG",
+			url:  "/posts/00000000-0000-0000-0000-000000000009/vote",
+			body: `{"direction": "up"}`,
+			mockReturns: mockReturns{
+				vote:      models.Vote{},
+				voteError: voterepo.ErrVotePostNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "user not found :NEG",
+			url:  "/posts/00000000-0000-0000-0000-000000000001/vote",
+			body: `{"direction": "up"}`,
+			mockReturns: mockReturns{
+				vote:      models.Vote{},
+				voteError: voterepo.ErrVoteUserNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal server error :NEG",
+			url:  "/posts/00000000-0000-0000-0000-000000000001/vote",
+			body: `{"direction": "up"}`,
+			mockReturns: mockReturns{
+				vote:      models.Vote{},
+				voteError: errors.New("some error"),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "upvote :POS",
+			url:  "/posts/00000000-0000-0000-0000-000000000001/vote",
+			body: `{"direction": "up"}`,
+			mockReturns: mockReturns{
+				vote: models.Vote{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Direction: models.VoteDirectionUp,
+					Score:     11,
+				},
+				voteError: nil,
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+            {
+              "id": "00000000-0000-0000-0000-000000000001",
+              "direction": "up",
+              "score": 11
+            }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeVoteService := votefakes.FakeVoteService{}
+			fakeVoteService.VotePostReturns(tt.mockReturns.vote, tt.mockReturns.voteError)
+
+			server, err := tr.NewServer(tr.Services{
+				Vote: &fakeVoteService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"POST",
+				tt.url,
+				strings.NewReader(tt.body),
+			)
+			if !tt.anonymous {
+				request = request.WithContext(middleware.ContextWithUser(request.Context(), voter))
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+
+				_, _, gotUserID, _ := fakeVoteService.VotePostArgsForCall(0)
+				assert.Equal(t, voter.ID, gotUserID, "expect vote to be cast by the authenticated user")
+			}
+		})
+	}
+}
+
+func TestTransport_VoteComment(t *testing.T) {
+	type mockReturns struct {
+		vote      models.Vote
+		voteError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		body           string
+		anonymous      bool
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "invalid comment id :NEG",
+			url:            "/comments/foo/vote",
+			body:           `{"direction": "down"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "anonymous request :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000001/vote",
+			body:           `{"direction": "down"}`,
+			anonymous:      true,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid direction :NEG",
+			url:            "/comments/00000000-0000-0000-0000-000000000001/vote",
+			body:           `{}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "comment not found :NEG",
+			url:  "/comments/00000000-0000-0000-0000-000000000009/vote",
+			body: `{"direction": "down"}`,
+			mockReturns: mockReturns{
+				vote:      models.Vote{},
+				voteError: voterepo.ErrVoteCommentNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "downvote :POS",
+			url:  "/comments/00000000-0000-0000-0000-000000000001/vote",
+			body: `{"direction": "down"}`,
+			mockReturns: mockReturns{
+				vote: models.Vote{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Direction: models.VoteDirectionDown,
+					Score:     1,
+				},
+				voteError: nil,
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+            {
+              "id": "00000000-0000-0000-0000-000000000001",
+              "direction": "down",
+              "score": 1
+            }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeVoteService := votefakes.FakeVoteService{}
+			fakeVoteService.VoteCommentReturns(tt.mockReturns.vote, tt.mockReturns.voteError)
+
+			server, err := tr.NewServer(tr.Services{
+				Vote: &fakeVoteService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"POST",
+				tt.url,
+				strings.NewReader(tt.body),
+			)
+			if !tt.anonymous {
+				request = request.WithContext(middleware.ContextWithUser(request.Context(), voter))
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
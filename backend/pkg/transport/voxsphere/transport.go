@@ -0,0 +1,139 @@
+package voxsphere
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	voxrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/voxsphere"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . VoxsphereService
+type VoxsphereService interface {
+	VoxsphereAboutByID(ctx context.Context, ID uuid.UUID) (models.VoxsphereAbout, error)
+	VoxspheresByMemberID(ctx context.Context, userID uuid.UUID) ([]models.Voxsphere, error)
+	JoinVoxsphere(ctx context.Context, ID, userID uuid.UUID) error
+	LeaveVoxsphere(ctx context.Context, ID, userID uuid.UUID) error
+}
+
+type Transport struct {
+	service VoxsphereService
+}
+
+type responseError struct {
+	Messages []string `json:"errors"`
+}
+
+func NewTransport(service VoxsphereService) *Transport {
+	return &Transport{
+		service: service,
+	}
+}
+
+func (t *Transport) VoxsphereByID(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	voxsphere, err := t.service.VoxsphereAboutByID(r.Context(), ID)
+	if err != nil {
+		if errors.Is(err, voxrepo.ErrVoxsphereNotFound) {
+			writeResponseError(w, http.StatusNotFound, "voxsphere not found")
+			return
+		}
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch voxsphere")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(voxsphere); err != nil {
+		log.Println("json encode error while fetching voxsphere:", err)
+	}
+}
+
+func (t *Transport) MyVoxspheres(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	voxspheres, err := t.service.VoxspheresByMemberID(r.Context(), user.ID)
+	if err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "failed to fetch voxspheres")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(voxspheres); err != nil {
+		log.Println("json encode error while fetching voxspheres:", err)
+	}
+}
+
+func (t *Transport) JoinVoxsphere(w http.ResponseWriter, r *http.Request) {
+	t.membership(w, r, t.service.JoinVoxsphere, "failed to join voxsphere")
+}
+
+func (t *Transport) LeaveVoxsphere(w http.ResponseWriter, r *http.Request) {
+	t.membership(w, r, t.service.LeaveVoxsphere, "failed to leave voxsphere")
+}
+
+// membership changes the membership of the caller in the voxsphere of the
+// request path with change, answering with no content on success.
+func (t *Transport) membership(w http.ResponseWriter, r *http.Request, change func(ctx context.Context, ID, userID uuid.UUID) error, fallbackMsg string) {
+	if err := r.Context().Err(); err != nil {
+		writeResponseError(w, http.StatusInternalServerError, "request context error")
+		return
+	}
+
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		writeResponseError(w, http.StatusUnauthorized, "login required")
+		return
+	}
+
+	ID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeResponseError(w, http.StatusBadRequest, "add a valid voxsphere id")
+		return
+	}
+
+	if err := change(r.Context(), ID, user.ID); err != nil {
+		if errors.Is(err, voxrepo.ErrVoxsphereNotFound) {
+			writeResponseError(w, http.StatusNotFound, "voxsphere not found")
+			return
+		}
+		writeResponseError(w, http.StatusInternalServerError, fallbackMsg)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeResponseError(w http.ResponseWriter, statusCode int, errMsgs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	errObj := responseError{Messages: errMsgs}
+
+	if err := json.NewEncoder(w).Encode(errObj); err != nil {
+		log.Println("json encode error:", err)
+	}
+}
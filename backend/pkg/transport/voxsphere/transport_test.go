@@ -0,0 +1,386 @@
+package voxsphere_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/glowfi/voxpopuli/backend/internal/middleware"
+	"github.com/glowfi/voxpopuli/backend/pkg/models"
+	voxrepo "github.com/glowfi/voxpopuli/backend/pkg/repo/voxsphere"
+	tr "github.com/glowfi/voxpopuli/backend/pkg/transport"
+	"github.com/glowfi/voxpopuli/backend/pkg/transport/voxsphere/voxspherefakes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport_VoxsphereByID(t *testing.T) {
+	type mockReturns struct {
+		about          models.VoxsphereAbout
+		voxsphereError error
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		mockReturns    mockReturns
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "invalid voxsphere id :NEG",
+			url:            "/voxspheres/foo",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "voxsphere not found :NEG",
+			url:  "/voxspheres/00000000-0000-0000-0000-000000000009",
+			mockReturns: mockReturns{
+				voxsphereError: voxrepo.ErrVoxsphereNotFound,
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "service error :NEG",
+			url:  "/voxspheres/00000000-0000-0000-0000-000000000001",
+			mockReturns: mockReturns{
+				voxsphereError: errors.New("db error"),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "voxsphere about :POS",
+			url:  "/voxspheres/00000000-0000-0000-0000-000000000001",
+			mockReturns: mockReturns{
+				about: models.VoxsphereAbout{
+					Voxsphere: models.Voxsphere{
+						ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						TopicID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Topic: models.Topic{
+							ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Name: "topic1",
+						},
+						Title:                 "v/foo",
+						PublicDescription:     ptrof("foo PublicDescription"),
+						CommunityIcon:         ptrof("foo icon"),
+						BannerBackgroundImage: nil,
+						BannerBackgroundColor: ptrof("#000000"),
+						KeyColor:              ptrof("#000000"),
+						PrimaryColor:          ptrof("#000000"),
+						Over18:                true,
+						SpoilersEnabled:       false,
+						CreatedAt:             time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						CreatedAtUnix:         1725091100,
+						UpdatedAt:             time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					},
+					Rules: []models.Rule{
+						{
+							ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							VoxsphereID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							ShortName:   "Be civil",
+							Description: "No personal attacks.",
+						},
+					},
+					Moderators: []models.User{
+						{
+							ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Name:          "John Doe",
+							AvatarImg:     ptrof("https://example.com/avatar1.jpg"),
+							Over18:        true,
+							CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+							CreatedAtUnix: 1725091100,
+							UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+						},
+					},
+					MemberCount: 42,
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+                {
+                  "id": "00000000-0000-0000-0000-000000000001",
+                  "topic_id": "00000000-0000-0000-0000-000000000001",
+                  "topic": {
+                    "id": "00000000-0000-0000-0000-000000000001",
+                    "name": "topic1",
+                    "category": ""
+                  },
+                  "title": "v/foo",
+                  "public_description": "foo PublicDescription",
+                  "community_icon": "foo icon",
+                  "banner_background_image": null,
+                  "banner_background_color": "#000000",
+                  "key_color": "#000000",
+                  "primary_color": "#000000",
+                  "over18": true,
+                  "spoilers_enabled": false,
+                  "created_at": "2024-10-10T10:10:10Z",
+                  "created_at_unix": 1725091100,
+                  "updated_at": "2024-10-10T10:10:10Z",
+                  "rules": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "voxsphere_id": "00000000-0000-0000-0000-000000000001",
+                      "short_name": "Be civil",
+                      "description": "No personal attacks."
+                    }
+                  ],
+                  "moderators": [
+                    {
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "name": "John Doe",
+                      "public_description": null,
+                      "avatar_img": "https://example.com/avatar1.jpg",
+                      "banner_img": null,
+                      "iconcolor": null,
+                      "keycolor": null,
+                      "primarycolor": null,
+                      "over18": true,
+                      "suspended": false,
+                      "created_at": "2024-10-10T10:10:10Z",
+                      "created_at_unix": 1725091100,
+                      "updated_at": "2024-10-10T10:10:10Z"
+                    }
+                  ],
+                  "member_count": 42
+                }
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeVoxsphereService := voxspherefakes.FakeVoxsphereService{}
+			fakeVoxsphereService.VoxsphereAboutByIDReturns(tt.mockReturns.about, tt.mockReturns.voxsphereError)
+
+			server, err := tr.NewServer(tr.Services{
+				Voxsphere: &fakeVoxsphereService,
+			})
+			if err != nil {
+				t.Fatalf("error setting up server: %+v", err)
+			}
+
+			handler, err := server.HTTPHandler(context.Background())
+			if err != nil {
+				t.Fatalf("error setting up http handler: %+v", err)
+			}
+
+			request := httptest.NewRequest(
+				"GET",
+				tt.url,
+				nil,
+			)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(
+				t,
+				tt.wantStatusCode,
+				recorder.Result().StatusCode,
+				"expect status code to match",
+			)
+
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+			}
+		})
+	}
+}
+
+var member = models.User{
+	ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	Name: "John Doe",
+}
+
+// serveAs sends a request of method to url through a server backed by
+// fakeVoxsphereService, as user when one is given.
+func serveAs(t *testing.T, fakeVoxsphereService *voxspherefakes.FakeVoxsphereService, method, url string, user *models.User) *httptest.ResponseRecorder {
+	t.Helper()
+
+	server, err := tr.NewServer(tr.Services{
+		Voxsphere: fakeVoxsphereService,
+	})
+	if err != nil {
+		t.Fatalf("error setting up server: %+v", err)
+	}
+
+	handler, err := server.HTTPHandler(context.Background())
+	if err != nil {
+		t.Fatalf("error setting up http handler: %+v", err)
+	}
+
+	request := httptest.NewRequest(method, url, nil)
+	if user != nil {
+		request = request.WithContext(middleware.ContextWithUser(request.Context(), *user))
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestTransport_MyVoxspheres(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *models.User
+		voxspheres     []models.Voxsphere
+		serviceErr     error
+		wantStatusCode int
+		wantResponse   string
+	}{
+		{
+			name:           "anonymous request :NEG",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "internal server error :NEG",
+			user:           &member,
+			serviceErr:     errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "joined voxspheres :POS",
+			user: &member,
+			voxspheres: []models.Voxsphere{
+				{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					TopicID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Topic:         models.Topic{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Name: "Technology"},
+					Title:         "v/foo",
+					CreatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+					CreatedAtUnix: 1725091100,
+					UpdatedAt:     time.Date(2024, 10, 10, 10, 10, 10, 0, time.UTC),
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: `
+                [
+                  {
+                    "id": "00000000-0000-0000-0000-000000000001",
+                    "topic_id": "00000000-0000-0000-0000-000000000001",
+                    "topic": {
+                      "id": "00000000-0000-0000-0000-000000000001",
+                      "name": "Technology",
+                      "category": ""
+                    },
+                    "title": "v/foo",
+                    "public_description": null,
+                    "community_icon": null,
+                    "banner_background_image": null,
+                    "banner_background_color": null,
+                    "key_color": null,
+                    "primary_color": null,
+                    "over18": false,
+                    "spoilers_enabled": false,
+                    "created_at": "2024-10-10T10:10:10Z",
+                    "created_at_unix": 1725091100,
+                    "updated_at": "2024-10-10T10:10:10Z"
+                  }
+                ]
+            `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeVoxsphereService := voxspherefakes.FakeVoxsphereService{}
+			fakeVoxsphereService.VoxspheresByMemberIDReturns(tt.voxspheres, tt.serviceErr)
+
+			recorder := serveAs(t, &fakeVoxsphereService, "GET", "/me/voxspheres", tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			if tt.wantStatusCode == http.StatusOK {
+				assert.JSONEq(t, tt.wantResponse, recorder.Body.String())
+
+				_, gotUserID := fakeVoxsphereService.VoxspheresByMemberIDArgsForCall(0)
+				assert.Equal(t, member.ID, gotUserID, "expect voxspheres of the authenticated user")
+			}
+		})
+	}
+}
+
+func TestTransport_Membership(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		url            string
+		user           *models.User
+		serviceErr     error
+		wantStatusCode int
+		wantJoinCalls  int
+		wantLeaveCalls int
+	}{
+		{
+			name:           "anonymous join :NEG",
+			method:         "POST",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/membership",
+			user:           nil,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid voxsphere id :NEG",
+			method:         "POST",
+			url:            "/voxspheres/foo/membership",
+			user:           &member,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "join unknown voxsphere :NEG",
+			method:         "POST",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000009/membership",
+			user:           &member,
+			serviceErr:     voxrepo.ErrVoxsphereNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantJoinCalls:  1,
+		},
+		{
+			name:           "leave internal server error :NEG",
+			method:         "DELETE",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/membership",
+			user:           &member,
+			serviceErr:     errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantLeaveCalls: 1,
+		},
+		{
+			name:           "join :POS",
+			method:         "POST",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/membership",
+			user:           &member,
+			wantStatusCode: http.StatusNoContent,
+			wantJoinCalls:  1,
+		},
+		{
+			name:           "leave :POS",
+			method:         "DELETE",
+			url:            "/voxspheres/00000000-0000-0000-0000-000000000001/membership",
+			user:           &member,
+			wantStatusCode: http.StatusNoContent,
+			wantLeaveCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeVoxsphereService := voxspherefakes.FakeVoxsphereService{}
+			fakeVoxsphereService.JoinVoxsphereReturns(tt.serviceErr)
+			fakeVoxsphereService.LeaveVoxsphereReturns(tt.serviceErr)
+
+			recorder := serveAs(t, &fakeVoxsphereService, tt.method, tt.url, tt.user)
+
+			assert.Equal(t, tt.wantStatusCode, recorder.Result().StatusCode, "expect status code to match")
+			assert.Equal(t, tt.wantJoinCalls, fakeVoxsphereService.JoinVoxsphereCallCount(), "expect join call count to match")
+			assert.Equal(t, tt.wantLeaveCalls, fakeVoxsphereService.LeaveVoxsphereCallCount(), "expect leave call count to match")
+
+			if tt.wantJoinCalls != 0 {
+				_, gotID, gotUserID := fakeVoxsphereService.JoinVoxsphereArgsForCall(0)
+				assert.Equal(t, uuid.MustParse(tt.url[len("/voxspheres/"):len("/voxspheres/")+36]), gotID, "expect voxsphere id to match")
+				assert.Equal(t, member.ID, gotUserID, "expect the authenticated user to join")
+			}
+		})
+	}
+}
+
+func ptrof[T any](v T) *T {
+	return &v
+}
@@ -63,12 +63,22 @@ export interface LinkType {
     updated_at: Date;
 }
 
+export interface MultiMedia {
+    id: string;
+    media_id: string;
+    child_id: string;
+    child_type: MediaType;
+    order_index: number;
+    medias: PostMedia[];
+}
+
 type PostMedia =
-    | ImageMetadata
-    | GifMetadata
-    | GalleryMetadata
-    | Video
-    | LinkType;
+    | (ImageMetadata & { media_type: MediaType.Image })
+    | (GifMetadata & { media_type: MediaType.Gif })
+    | (GalleryMetadata & { media_type: MediaType.Gallery })
+    | (Video & { media_type: MediaType.Video })
+    | (LinkType & { media_type: MediaType.Link })
+    | (MultiMedia & { media_type: MediaType.Multi });
 
 export interface Post {
     id: string;